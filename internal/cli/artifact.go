@@ -0,0 +1,243 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/colonyos/colonies/pkg/client"
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/security"
+	"github.com/colonyos/colonies/pkg/server"
+	"github.com/colonyos/colonies/pkg/utils"
+	"github.com/kataras/tablewriter"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	artifactCmd.AddCommand(addArtifactCmd)
+	artifactCmd.AddCommand(getArtifactCmd)
+	artifactCmd.AddCommand(listArtifactsCmd)
+	artifactCmd.AddCommand(deleteArtifactCmd)
+	rootCmd.AddCommand(artifactCmd)
+
+	artifactCmd.PersistentFlags().StringVarP(&ServerHost, "host", "", "localhost", "Server host")
+	artifactCmd.PersistentFlags().IntVarP(&ServerPort, "port", "", -1, "Server HTTP port")
+
+	addArtifactCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	addArtifactCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	addArtifactCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
+	addArtifactCmd.Flags().StringVarP(&ArtifactPath, "path", "", "", "Path to a file or a directory to upload, directories are uploaded as gzipped tar archives")
+	addArtifactCmd.MarkFlagRequired("path")
+	addArtifactCmd.Flags().StringVarP(&ArtifactName, "name", "", "", "Artifact name, defaults to the name of the file or directory")
+
+	getArtifactCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	getArtifactCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	getArtifactCmd.Flags().StringVarP(&ArtifactID, "artifactid", "", "", "Artifact Id")
+	getArtifactCmd.MarkFlagRequired("artifactid")
+	getArtifactCmd.Flags().StringVarP(&ArtifactDest, "dest", "", ".", "Directory where the artifact is saved, archives are extracted into the directory")
+
+	listArtifactsCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	listArtifactsCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	listArtifactsCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
+	listArtifactsCmd.Flags().IntVarP(&Count, "count", "", server.MAX_COUNT, "Number of artifacts to list")
+	listArtifactsCmd.Flags().BoolVarP(&JSON, "json", "", false, "Print JSON instead of tables")
+
+	deleteArtifactCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	deleteArtifactCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	deleteArtifactCmd.Flags().StringVarP(&ArtifactID, "artifactid", "", "", "Artifact Id")
+	deleteArtifactCmd.MarkFlagRequired("artifactid")
+}
+
+var artifactCmd = &cobra.Command{
+	Use:   "artifact",
+	Short: "Manage artifacts",
+	Long:  "Manage artifacts, e.g. large files used as process input or output",
+}
+
+func setupArtifactClient() *client.ColoniesClient {
+	keychain, err := security.CreateKeychain(KEYCHAIN_PATH)
+	CheckError(err)
+
+	if ExecutorID == "" {
+		ExecutorID = os.Getenv("COLONIES_EXECUTOR_ID")
+	}
+	if ExecutorID == "" {
+		CheckError(errors.New("Unknown Executor Id"))
+	}
+
+	if ExecutorPrvKey == "" {
+		ExecutorPrvKey, err = keychain.GetPrvKey(ExecutorID)
+		CheckError(err)
+	}
+
+	log.WithFields(log.Fields{"ServerHost": ServerHost, "ServerPort": ServerPort, "Insecure": Insecure}).Info("Starting a Colonies client")
+	return client.CreateColoniesClient(ServerHost, ServerPort, Insecure, SkipTLSVerify)
+}
+
+var addArtifactCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Upload a file or a directory as an artifact",
+	Long:  "Upload a file or a directory as an artifact",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		if ColonyID == "" {
+			ColonyID = os.Getenv("COLONIES_COLONY_ID")
+		}
+		if ColonyID == "" {
+			CheckError(errors.New("Unknown Colony Id"))
+		}
+
+		client := setupArtifactClient()
+
+		fi, err := os.Stat(ArtifactPath)
+		CheckError(err)
+
+		if ArtifactName == "" {
+			ArtifactName = filepath.Base(ArtifactPath)
+		}
+
+		file, err := os.Open(ArtifactPath)
+		CheckError(err)
+		if fi.IsDir() {
+			file.Close()
+			file, err = os.CreateTemp("", "artifact-*.tar.gz")
+			CheckError(err)
+			defer os.Remove(file.Name())
+			err = utils.Compress(filepath.Dir(filepath.Clean(ArtifactPath)), filepath.Clean(ArtifactPath), file)
+			CheckError(err)
+			_, err = file.Seek(0, 0)
+			CheckError(err)
+		}
+		defer file.Close()
+
+		artifact, err := client.AddArtifact(ColonyID, ArtifactName, file, fi.IsDir(), ExecutorPrvKey)
+		CheckError(err)
+
+		log.WithFields(log.Fields{"ArtifactId": artifact.ID, "Name": artifact.Name, "Size": artifact.Size, "Hash": artifact.Hash}).Info("Artifact added")
+		fmt.Println(core.CreateArtifactRef(artifact.ID))
+	},
+}
+
+var getArtifactCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Download an artifact",
+	Long:  "Download an artifact",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client := setupArtifactClient()
+
+		if artifactID, ok := core.ParseArtifactRef(ArtifactID); ok {
+			ArtifactID = artifactID
+		}
+
+		artifact, err := client.GetArtifact(ArtifactID, ExecutorPrvKey)
+		CheckError(err)
+
+		err = downloadArtifact(client, artifact, ArtifactDest, ExecutorPrvKey)
+		CheckError(err)
+
+		log.WithFields(log.Fields{"ArtifactId": artifact.ID, "Name": artifact.Name, "Dest": ArtifactDest}).Info("Artifact downloaded")
+	},
+}
+
+// downloadArtifact downloads an artifact to a temporary file in dest, which is only moved or extracted once the
+// content has been verified. The temporary file is always removed, CheckError exits without running deferred calls.
+func downloadArtifact(client *client.ColoniesClient, artifact *core.Artifact, dest string, prvKey string) error {
+	err := os.MkdirAll(dest, 0755)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(dest, ".artifact-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	_, err = client.DownloadArtifact(artifact.ID, file, prvKey)
+	if err != nil {
+		return err
+	}
+
+	if artifact.Archive {
+		_, err = file.Seek(0, 0)
+		if err != nil {
+			return err
+		}
+		return utils.Decompress(file, dest)
+	}
+
+	return os.Rename(file.Name(), filepath.Join(dest, filepath.Base(artifact.Name)))
+}
+
+var listArtifactsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "List artifacts of a colony, newest first",
+	Long:  "List artifacts of a colony, newest first",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		if ColonyID == "" {
+			ColonyID = os.Getenv("COLONIES_COLONY_ID")
+		}
+		if ColonyID == "" {
+			CheckError(errors.New("Unknown Colony Id"))
+		}
+
+		client := setupArtifactClient()
+
+		artifacts, err := client.GetArtifacts(ColonyID, Count, ExecutorPrvKey)
+		CheckError(err)
+
+		if len(artifacts) == 0 {
+			log.WithFields(log.Fields{"ColonyId": ColonyID}).Info("No artifacts found")
+			os.Exit(0)
+		}
+
+		if JSON {
+			jsonString, err := core.ConvertArtifactArrayToJSON(artifacts)
+			CheckError(err)
+			fmt.Println(jsonString)
+			os.Exit(0)
+		}
+
+		var data [][]string
+		for _, artifact := range artifacts {
+			data = append(data, []string{artifact.ID, artifact.Name, strconv.FormatInt(artifact.Size, 10), strconv.FormatBool(artifact.Archive), artifact.Added.Format(TimeLayout)})
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"ArtifactId", "Name", "Size", "Archive", "Added"})
+		for _, v := range data {
+			table.Append(v)
+		}
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		table.Render()
+	},
+}
+
+var deleteArtifactCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete an artifact",
+	Long:  "Delete an artifact",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client := setupArtifactClient()
+
+		if artifactID, ok := core.ParseArtifactRef(ArtifactID); ok {
+			ArtifactID = artifactID
+		}
+
+		err := client.DeleteArtifact(ArtifactID, ExecutorPrvKey)
+		CheckError(err)
+
+		log.WithFields(log.Fields{"ArtifactId": ArtifactID}).Info("Artifact deleted")
+	},
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/colonyos/colonies/pkg/client"
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/security"
+	"github.com/colonyos/colonies/pkg/server"
+	"github.com/kataras/tablewriter"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	auditCmd.AddCommand(listAuditLogCmd)
+	rootCmd.AddCommand(auditCmd)
+
+	auditCmd.PersistentFlags().StringVarP(&ServerHost, "host", "", "localhost", "Server host")
+	auditCmd.PersistentFlags().IntVarP(&ServerPort, "port", "", -1, "Server HTTP port")
+
+	listAuditLogCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
+	listAuditLogCmd.Flags().StringVarP(&ColonyPrvKey, "colonyprvkey", "", "", "Colony private key")
+	listAuditLogCmd.Flags().IntVarP(&Count, "count", "", server.MAX_COUNT, "Number of audit records to list")
+	listAuditLogCmd.Flags().BoolVarP(&JSON, "json", "", false, "Print JSON instead of tables")
+}
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Manage the audit log",
+	Long:  "Manage the audit log",
+}
+
+var listAuditLogCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "List the audit log of a colony, newest first",
+	Long:  "List the audit log of a colony, newest first",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		keychain, err := security.CreateKeychain(KEYCHAIN_PATH)
+		CheckError(err)
+
+		if ColonyID == "" {
+			ColonyID = os.Getenv("COLONIES_COLONY_ID")
+		}
+		if ColonyID == "" {
+			CheckError(errors.New("Unknown Colony Id"))
+		}
+
+		if ColonyPrvKey == "" {
+			ColonyPrvKey, err = keychain.GetPrvKey(ColonyID)
+			CheckError(err)
+		}
+
+		log.WithFields(log.Fields{"ServerHost": ServerHost, "ServerPort": ServerPort, "Insecure": Insecure}).Info("Starting a Colonies client")
+		client := client.CreateColoniesClient(ServerHost, ServerPort, Insecure, SkipTLSVerify)
+
+		auditLog, err := client.GetAuditLog(ColonyID, Count, ColonyPrvKey)
+		CheckError(err)
+
+		if len(auditLog) == 0 {
+			log.WithFields(log.Fields{"ColonyId": ColonyID}).Info("No audit records found")
+			os.Exit(0)
+		}
+
+		if JSON {
+			jsonString, err := core.ConvertAuditRecordArrayToJSON(auditLog)
+			CheckError(err)
+			fmt.Println(jsonString)
+			os.Exit(0)
+		}
+
+		var data [][]string
+		for _, auditRecord := range auditLog {
+			data = append(data, []string{auditRecord.Time.Format(TimeLayout), auditRecord.RecoveredID, auditRecord.PayloadType, strings.Join(auditRecord.TargetIDs, "\n"), strconv.Itoa(auditRecord.Status), auditRecord.Error})
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Time", "RecoveredId", "PayloadType", "TargetIds", "Status", "Error"})
+		for _, v := range data {
+			table.Append(v)
+		}
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		table.Render()
+	},
+}
@@ -22,6 +22,7 @@ func init() {
 	colonyCmd.AddCommand(renameColonyCmd)
 	colonyCmd.AddCommand(lsColoniesCmd)
 	colonyCmd.AddCommand(colonyStatsCmd)
+	colonyCmd.AddCommand(rotateColonyKeyCmd)
 	rootCmd.AddCommand(colonyCmd)
 
 	colonyCmd.PersistentFlags().StringVarP(&ServerHost, "host", "", DefaultServerHost, "Server host")
@@ -51,6 +52,9 @@ func init() {
 	colonyStatsCmd.Flags().StringVarP(&ServerID, "serverid", "", "", "Colonies server Id")
 	colonyStatsCmd.Flags().StringVarP(&ServerPrvKey, "serverprvkey", "", "", "Colonies server private key")
 	colonyStatsCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
+
+	rotateColonyKeyCmd.Flags().StringVarP(&ColonyPrvKey, "colonyprvkey", "", "", "Current colony private key")
+	rotateColonyKeyCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
 }
 
 var colonyCmd = &cobra.Command{
@@ -301,3 +305,54 @@ var colonyStatsCmd = &cobra.Command{
 		specTable.Render()
 	},
 }
+
+var rotateColonyKeyCmd = &cobra.Command{
+	Use:   "rotate-key",
+	Short: "Replace the private key of a colony",
+	Long:  "Replace the private key of a colony, the Colony Id is not changed and the new private key is stored in the keychain",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		keychain, err := security.CreateKeychain(KEYCHAIN_PATH)
+		CheckError(err)
+
+		if ColonyID == "" {
+			ColonyID = os.Getenv("COLONIES_COLONY_ID")
+		}
+		if ColonyID == "" {
+			CheckError(errors.New("Unknown Colony Id"))
+		}
+
+		if ColonyPrvKey == "" {
+			ColonyPrvKey = os.Getenv("COLONIES_COLONY_PRVKEY")
+		}
+		if ColonyPrvKey == "" {
+			ColonyPrvKey, err = keychain.GetPrvKey(ColonyID)
+			CheckError(err)
+		}
+
+		log.WithFields(log.Fields{"ServerHost": ServerHost, "ServerPort": ServerPort, "Insecure": Insecure}).Info("Starting a Colonies client")
+		client := client.CreateColoniesClient(ServerHost, ServerPort, Insecure, SkipTLSVerify)
+
+		newColonyPrvKey, err := crypto.CreateCrypto().GeneratePrivateKey()
+		CheckError(err)
+
+		// The new key is stored before the key is rotated, the identity would otherwise be locked out if the
+		// keychain cannot store it
+		err = keychain.StagePrvKey(ColonyID, newColonyPrvKey)
+		CheckError(err)
+
+		_, err = client.RotateKey(ColonyID, "", newColonyPrvKey, ColonyPrvKey)
+		if err != nil {
+			keychain.RemovePendingPrvKey(ColonyID)
+			CheckError(err)
+		}
+
+		err = keychain.CommitPrvKey(ColonyID)
+		if err != nil {
+			CheckError(errors.New("Private key rotated but failed to replace it in keychain, the new private key is stored as <" + ColonyID + ".pending>: " + err.Error()))
+		}
+
+		log.WithFields(log.Fields{"ColonyID": ColonyID, "PrvKey": newColonyPrvKey}).Info("Colony private key rotated and stored in keychain, the previous private key can no longer be used")
+	},
+}
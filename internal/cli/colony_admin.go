@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/colonyos/colonies/pkg/client"
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/security"
+	"github.com/kataras/tablewriter"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	colonyAdminCmd.AddCommand(addColonyAdminCmd)
+	colonyAdminCmd.AddCommand(listColonyAdminsCmd)
+	colonyAdminCmd.AddCommand(removeColonyAdminCmd)
+	colonyCmd.AddCommand(colonyAdminCmd)
+
+	colonyAdminCmd.PersistentFlags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
+	colonyAdminCmd.PersistentFlags().StringVarP(&ColonyPrvKey, "colonyprvkey", "", "", "Colony private key, or the private key of a colony admin")
+
+	addColonyAdminCmd.Flags().StringVarP(&AdminID, "adminid", "", "", "Id of the new admin, see keychain")
+	addColonyAdminCmd.MarkFlagRequired("adminid")
+	addColonyAdminCmd.Flags().StringVarP(&AdminName, "name", "", "", "Name of the admin, e.g. the name of a team member")
+
+	listColonyAdminsCmd.Flags().BoolVarP(&JSON, "json", "", false, "Print JSON instead of tables")
+
+	removeColonyAdminCmd.Flags().StringVarP(&AdminID, "adminid", "", "", "Id of the admin")
+	removeColonyAdminCmd.MarkFlagRequired("adminid")
+}
+
+var colonyAdminCmd = &cobra.Command{
+	Use:   "admin",
+	Short: "Manage identities that can administer a colony",
+	Long:  "Manage identities that can administer a colony, admins can use their own private key wherever the colony private key is required, except when rotating the colony key",
+}
+
+func setupColonyAdminClient() *client.ColoniesClient {
+	if ColonyID == "" {
+		ColonyID = os.Getenv("COLONIES_COLONY_ID")
+	}
+	if ColonyID == "" {
+		CheckError(errors.New("Unknown Colony Id"))
+	}
+
+	if ColonyPrvKey == "" {
+		ColonyPrvKey = os.Getenv("COLONIES_COLONY_PRVKEY")
+	}
+	if ColonyPrvKey == "" {
+		keychain, err := security.CreateKeychain(KEYCHAIN_PATH)
+		CheckError(err)
+
+		ColonyPrvKey, err = keychain.GetPrvKey(ColonyID)
+		CheckError(err)
+	}
+
+	log.WithFields(log.Fields{"ServerHost": ServerHost, "ServerPort": ServerPort, "Insecure": Insecure}).Info("Starting a Colonies client")
+	return client.CreateColoniesClient(ServerHost, ServerPort, Insecure, SkipTLSVerify)
+}
+
+var addColonyAdminCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add an admin to a colony",
+	Long:  "Add an admin to a colony",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client := setupColonyAdminClient()
+
+		addedAdmin, err := client.AddColonyAdmin(ColonyID, AdminID, AdminName, ColonyPrvKey)
+		CheckError(err)
+
+		log.WithFields(log.Fields{"ColonyId": addedAdmin.ColonyID, "AdminId": addedAdmin.AdminID, "Name": addedAdmin.Name, "AddedBy": addedAdmin.AddedBy}).Info("Colony admin added")
+	},
+}
+
+var listColonyAdminsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "List all admins of a colony",
+	Long:  "List all admins of a colony",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client := setupColonyAdminClient()
+
+		admins, err := client.GetColonyAdmins(ColonyID, ColonyPrvKey)
+		CheckError(err)
+
+		if len(admins) == 0 {
+			log.WithFields(log.Fields{"ColonyId": ColonyID}).Info("No colony admins found")
+			os.Exit(0)
+		}
+
+		if JSON {
+			jsonString, err := core.ConvertColonyAdminArrayToJSON(admins)
+			CheckError(err)
+			fmt.Println(jsonString)
+			os.Exit(0)
+		}
+
+		var data [][]string
+		for _, admin := range admins {
+			data = append(data, []string{admin.AdminID, admin.Name, admin.AddedBy, admin.Added.Format(TimeLayout)})
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"AdminId", "Name", "AddedBy", "Added"})
+		for _, v := range data {
+			table.Append(v)
+		}
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		table.Render()
+	},
+}
+
+var removeColonyAdminCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Remove an admin from a colony",
+	Long:  "Remove an admin from a colony",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client := setupColonyAdminClient()
+
+		err := client.DeleteColonyAdmin(ColonyID, AdminID, ColonyPrvKey)
+		CheckError(err)
+
+		log.WithFields(log.Fields{"ColonyId": ColonyID, "AdminId": AdminID}).Info("Colony admin removed")
+	},
+}
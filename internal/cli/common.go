@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sort"
 	"strconv"
 
 	"github.com/colonyos/colonies/pkg/build"
 	"github.com/colonyos/colonies/pkg/core"
+	"github.com/kataras/tablewriter"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -46,6 +48,45 @@ func IfArr2StringArr(ifarr []interface{}) []string {
 	return strarr
 }
 
+func NamedValues2Str(values map[string]interface{}) string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+fmt.Sprint(values[key]))
+	}
+
+	return StrArr2Str(pairs)
+}
+
+// EnvValue2Str redacts secret references so that only the name of the secret is shown
+func EnvValue2Str(value string) string {
+	if name, ok := core.ParseSecretRef(value); ok {
+		return "<secret:" + name + ">"
+	}
+
+	return value
+}
+
+func Env2Str(env map[string]string) string {
+	keys := make([]string, 0, len(env))
+	for key := range env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+EnvValue2Str(env[key]))
+	}
+
+	return StrArr2Str(pairs)
+}
+
 func State2String(state int) string {
 	var stateStr string
 	switch state {
@@ -57,6 +98,8 @@ func State2String(state int) string {
 		stateStr = "Successful"
 	case core.FAILED:
 		stateStr = "Failed"
+	case core.SKIPPED:
+		stateStr = "Skipped"
 	default:
 		stateStr = "Unkown"
 	}
@@ -64,6 +107,24 @@ func State2String(state int) string {
 	return stateStr
 }
 
+func printRunHistory(runHistory []*core.RunRecord) {
+	var data [][]string
+	for _, runRecord := range runHistory {
+		state := State2String(runRecord.State)
+		if runRecord.Skipped {
+			state = "Skipped"
+		}
+		data = append(data, []string{runRecord.ScheduledTime.Format(TimeLayout), runRecord.TriggeredTime.Format(TimeLayout), runRecord.ProcessGraphID, state, runRecord.Reason})
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Scheduled", "Triggered", "ProcessGraphId", "State", "Reason"})
+	for _, v := range data {
+		table.Append(v)
+	}
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
 func CheckError(err error) {
 	if err != nil {
 		log.WithFields(log.Fields{"Error": err, "BuildVersion": build.BuildVersion, "BuildTime": build.BuildTime}).Error(err.Error())
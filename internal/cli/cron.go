@@ -22,6 +22,10 @@ func init() {
 	cronCmd.AddCommand(getCronCmd)
 	cronCmd.AddCommand(getCronsCmd)
 	cronCmd.AddCommand(runCronCmd)
+	cronCmd.AddCommand(updateCronCmd)
+	cronCmd.AddCommand(pauseCronCmd)
+	cronCmd.AddCommand(resumeCronCmd)
+	cronCmd.AddCommand(cronHistoryCmd)
 	rootCmd.AddCommand(cronCmd)
 
 	cronCmd.PersistentFlags().StringVarP(&ServerHost, "host", "", "localhost", "Server host")
@@ -30,7 +34,11 @@ func init() {
 	addCronCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
 	addCronCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
 	addCronCmd.Flags().StringVarP(&SpecFile, "spec", "", "", "JSON specification of a Colony workflow")
-	addCronCmd.MarkFlagRequired("spec")
+	addCronCmd.Flags().StringVarP(&TemplateName, "template", "", "", "Name of a workflow template to use instead of a JSON specification")
+	addCronCmd.Flags().IntVarP(&TemplateVersion, "version", "", 0, "Workflow template version, 0 means the latest version")
+	addCronCmd.Flags().StringArrayVarP(&TemplateParams, "param", "", make([]string, 0), "Workflow template parameter, e.g. --param key1=value1 --param key2=value2")
+	addCronCmd.Flags().IntVarP(&WorkflowDeadline, "deadline", "", 0, "Max time in seconds the workflow may run before all remaining processes fail, 0 means no deadline")
+	addCronCmd.Flags().StringVarP(&FailurePolicy, "failurepolicy", "", "", "Failure policy, failfast cancels all remaining processes when a process fails, continue runs independent branches to completion")
 	addCronCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
 	addCronCmd.Flags().StringVarP(&CronName, "name", "", "", "Cron name")
 	addCronCmd.MarkFlagRequired("name")
@@ -58,6 +66,32 @@ func init() {
 	runCronCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
 	runCronCmd.Flags().StringVarP(&CronID, "cronid", "", "", "Cron Id")
 	runCronCmd.MarkFlagRequired("cronid")
+
+	updateCronCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	updateCronCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	updateCronCmd.Flags().StringVarP(&CronID, "cronid", "", "", "Cron Id")
+	updateCronCmd.MarkFlagRequired("cronid")
+	updateCronCmd.Flags().StringVarP(&SpecFile, "spec", "", "", "JSON specification of a Colony workflow")
+	updateCronCmd.Flags().StringVarP(&CronExpr, "cron", "", "", "Cron expression")
+	updateCronCmd.Flags().IntVarP(&CronInterval, "interval", "", -1, "Interval in seconds")
+	updateCronCmd.Flags().BoolVarP(&CronRandom, "random", "", false, "Schedule a random cron, interval must be specified")
+	updateCronCmd.Flags().BoolVarP(&WaitForPrevProcessGraph, "waitprevious", "", false, "Wait for previous processgrah to finish bore schedule a new workflow")
+
+	pauseCronCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	pauseCronCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	pauseCronCmd.Flags().StringVarP(&CronID, "cronid", "", "", "Cron Id")
+	pauseCronCmd.MarkFlagRequired("cronid")
+
+	resumeCronCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	resumeCronCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	resumeCronCmd.Flags().StringVarP(&CronID, "cronid", "", "", "Cron Id")
+	resumeCronCmd.MarkFlagRequired("cronid")
+
+	cronHistoryCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	cronHistoryCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	cronHistoryCmd.Flags().StringVarP(&CronID, "cronid", "", "", "Cron Id")
+	cronHistoryCmd.MarkFlagRequired("cronid")
+	cronHistoryCmd.Flags().IntVarP(&Count, "count", "", server.MAX_COUNT, "Number of runs to list")
 }
 
 var cronCmd = &cobra.Command{
@@ -73,11 +107,7 @@ var addCronCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		parseServerEnv()
 
-		jsonSpecBytes, err := ioutil.ReadFile(SpecFile)
-		CheckError(err)
-
-		jsonStr := "{\"functionspecs\":" + string(jsonSpecBytes) + "}"
-		workflowSpec, err := core.ConvertJSONToWorkflowSpec(jsonStr)
+		workflowSpec, err := readWorkflowSpec()
 		CheckError(err)
 
 		if workflowSpec.ColonyID == "" {
@@ -226,6 +256,7 @@ var getCronCmd = &cobra.Command{
 			[]string{"Cron Expression", cron.CronExpression},
 			[]string{"Interval", strconv.Itoa(cron.Interval)},
 			[]string{"Random", strconv.FormatBool(cron.Random)},
+			[]string{"Paused", strconv.FormatBool(cron.Paused)},
 			[]string{"NextRun", cron.NextRun.Format(TimeLayout)},
 			[]string{"LastRun", cron.LastRun.Format(TimeLayout)},
 			[]string{"PrevProcessGraphID", cron.PrevProcessGraphID},
@@ -292,10 +323,10 @@ var getCronsCmd = &cobra.Command{
 
 		var data [][]string
 		for _, cron := range crons {
-			data = append(data, []string{cron.ID, cron.Name})
+			data = append(data, []string{cron.ID, cron.Name, strconv.FormatBool(cron.Paused)})
 		}
 		table := tablewriter.NewWriter(os.Stdout)
-		table.SetHeader([]string{"CronId", "Name"})
+		table.SetHeader([]string{"CronId", "Name", "Paused"})
 		for _, v := range data {
 			table.Append(v)
 		}
@@ -339,3 +370,131 @@ var runCronCmd = &cobra.Command{
 		log.WithFields(log.Fields{"CronID": CronID}).Info("Running cron")
 	},
 }
+
+var updateCronCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update a cron",
+	Long:  "Update a cron, only specified flags are changed",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client, cron := resolveCron()
+
+		if cmd.Flags().Changed("spec") {
+			jsonSpecBytes, err := ioutil.ReadFile(SpecFile)
+			CheckError(err)
+
+			jsonStr := "{\"functionspecs\":" + string(jsonSpecBytes) + "}"
+			workflowSpec, err := core.ConvertJSONToWorkflowSpec(jsonStr)
+			CheckError(err)
+
+			if workflowSpec.ColonyID == "" {
+				workflowSpec.ColonyID = cron.ColonyID
+			}
+
+			workflowSpecJSON, err := workflowSpec.ToJSON()
+			CheckError(err)
+			cron.WorkflowSpec = workflowSpecJSON
+		}
+
+		if cmd.Flags().Changed("cron") {
+			cron.CronExpression = CronExpr
+			cron.Interval = -1
+		}
+		if cmd.Flags().Changed("interval") {
+			cron.Interval = CronInterval
+		}
+		if cmd.Flags().Changed("random") {
+			cron.Random = CronRandom
+		}
+		if cmd.Flags().Changed("waitprevious") {
+			cron.WaitForPrevProcessGraph = WaitForPrevProcessGraph
+		}
+
+		updatedCron, err := client.UpdateCron(cron, ExecutorPrvKey)
+		CheckError(err)
+
+		log.WithFields(log.Fields{"CronID": updatedCron.ID}).Info("Cron updated")
+	},
+}
+
+var pauseCronCmd = &cobra.Command{
+	Use:   "pause",
+	Short: "Pause a cron",
+	Long:  "Pause a cron, no new workflows are scheduled until the cron is resumed",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+		setCronPaused(true)
+		log.WithFields(log.Fields{"CronID": CronID}).Info("Cron paused")
+	},
+}
+
+var resumeCronCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resume a paused cron",
+	Long:  "Resume a paused cron",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+		setCronPaused(false)
+		log.WithFields(log.Fields{"CronID": CronID}).Info("Cron resumed")
+	},
+}
+
+var cronHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "List the run history of a cron",
+	Long:  "List the run history of a cron",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client, cron := resolveCron()
+
+		runHistory, err := client.GetRunHistory(cron.ID, Count, ExecutorPrvKey)
+		CheckError(err)
+		if len(runHistory) == 0 {
+			log.WithFields(log.Fields{"CronId": cron.ID}).Info("No runs found")
+			os.Exit(0)
+		}
+
+		printRunHistory(runHistory)
+	},
+}
+
+func resolveCron() (*client.ColoniesClient, *core.Cron) {
+	keychain, err := security.CreateKeychain(KEYCHAIN_PATH)
+	CheckError(err)
+
+	if ExecutorID == "" {
+		ExecutorID = os.Getenv("COLONIES_EXECUTOR_ID")
+	}
+	if ExecutorID == "" {
+		CheckError(errors.New("Unknown Executor Id"))
+	}
+
+	if ExecutorPrvKey == "" {
+		ExecutorPrvKey, err = keychain.GetPrvKey(ExecutorID)
+		CheckError(err)
+	}
+
+	log.WithFields(log.Fields{"ServerHost": ServerHost, "ServerPort": ServerPort, "Insecure": Insecure}).Info("Starting a Colonies client")
+	client := client.CreateColoniesClient(ServerHost, ServerPort, Insecure, SkipTLSVerify)
+
+	if CronID == "" {
+		CheckError(errors.New("Cron Id not specified"))
+	}
+
+	cron, err := client.GetCron(CronID, ExecutorPrvKey)
+	CheckError(err)
+	if cron == nil {
+		CheckError(errors.New("Cron not found"))
+	}
+
+	return client, cron
+}
+
+func setCronPaused(paused bool) {
+	client, cron := resolveCron()
+	cron.Paused = paused
+	_, err := client.UpdateCron(cron, ExecutorPrvKey)
+	CheckError(err)
+}
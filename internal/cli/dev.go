@@ -14,11 +14,12 @@ import (
 	"github.com/colonyos/colonies/pkg/core"
 	"github.com/colonyos/colonies/pkg/database/postgresql"
 	"github.com/colonyos/colonies/pkg/monitoring"
+	"github.com/colonyos/colonies/pkg/security"
 	"github.com/colonyos/colonies/pkg/server"
+	"github.com/colonyos/colonies/pkg/storage"
 	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
 	"github.com/gin-gonic/gin"
 
-	"github.com/colonyos/colonies/pkg/security"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -289,6 +290,14 @@ var devCmd = &cobra.Command{
 
 		retentionPeriod := 60000 // Run retention worker once a minute
 
+		artifactStorage, err := storage.CreateLocalStorage("/tmp/coloniesdev/dev/artifacts")
+		CheckError(err)
+
+		secretKeyStr, err := security.GenerateSecretKey()
+		CheckError(err)
+		secretKey, err := security.ParseSecretKey(secretKeyStr)
+		CheckError(err)
+
 		setupProfiler()
 
 		coloniesServer := server.CreateColoniesServer(coloniesDB,
@@ -306,7 +315,10 @@ var devCmd = &cobra.Command{
 			AllowExecutorReregister,
 			retention,
 			retentionPolicy,
-			retentionPeriod)
+			retentionPeriod,
+			artifactStorage,
+			secretKey,
+			true)
 
 		go coloniesServer.ServeForever()
 
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"runtime"
 	"strconv"
 	"time"
 
@@ -25,6 +27,7 @@ func init() {
 	executorCmd.AddCommand(approveExecutorCmd)
 	executorCmd.AddCommand(rejectExecutorCmd)
 	executorCmd.AddCommand(resolveExecutorCmd)
+	executorCmd.AddCommand(rotateExecutorKeyCmd)
 	executorCmd.AddCommand(osExecutorCmd)
 	rootCmd.AddCommand(executorCmd)
 
@@ -63,6 +66,9 @@ func init() {
 	resolveExecutorCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
 	resolveExecutorCmd.Flags().StringVarP(&TargetExecutorName, "executorname", "", "", "Executor name to resolve Id for")
 	resolveExecutorCmd.MarkFlagRequired("executorid")
+
+	rotateExecutorKeyCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	rotateExecutorKeyCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Current executor private key")
 }
 
 var executorCmd = &cobra.Command{
@@ -154,13 +160,10 @@ var addExecutorCmd = &cobra.Command{
 		err = keychain.AddPrvKey(executorID, executorPrvKey)
 		CheckError(err)
 
-		log.Info("Saving executor Id to /tmp/executorid")
-		err = os.WriteFile("/tmp/executorid", []byte(executorID), 0644)
-		CheckError(err)
-
-		err = os.WriteFile("/tmp/executorprvkey", []byte(executorPrvKey), 0644)
+		// The private key is only stored in the keychain, the Id is saved so that the executor can be removed later
+		executorIDFile, err := writeExecutorTmpFile("executorid", executorID)
 		CheckError(err)
-		log.Info("Saving executor prvKey to /tmp/executorprvkey")
+		log.WithFields(log.Fields{"File": executorIDFile}).Info("Saved executor Id")
 
 		if Approve {
 			log.WithFields(log.Fields{"ExecutorID": executorID}).Info("Approving Executor")
@@ -492,11 +495,62 @@ var rejectExecutorCmd = &cobra.Command{
 	},
 }
 
+// executorTmpDir returns a directory only accessible by the current user where executor state is saved between
+// commands, files in a shared directory such as /tmp can be read or replaced by other users
+func executorTmpDir() (string, error) {
+	dir := filepath.Join(os.TempDir(), "colonies-"+strconv.Itoa(os.Getuid()))
+	err := os.Mkdir(dir, 0700)
+	if err != nil && !os.IsExist(err) {
+		return "", err
+	}
+
+	info, err := os.Lstat(dir)
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		return "", errors.New(dir + " exists but is not a directory")
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm() != 0700 {
+		return "", errors.New("Insecure permissions " + info.Mode().Perm().String() + " on " + dir + ", it must only be accessible by its owner")
+	}
+
+	return dir, nil
+}
+
+// writeExecutorTmpFile writes a file only readable by the current user, an existing file is removed first since
+// its permissions would otherwise be kept
+func writeExecutorTmpFile(name string, content string) (string, error) {
+	dir, err := executorTmpDir()
+	if err != nil {
+		return "", err
+	}
+
+	fileName := filepath.Join(dir, name)
+	err = os.Remove(fileName)
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+
+	file, err := os.OpenFile(fileName, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	_, err = file.WriteString(content)
+	if err != nil {
+		return "", err
+	}
+
+	return fileName, nil
+}
+
 func removeExecutorFromTmp(client *client.ColoniesClient) {
-	mutex.Lock()
-	defer mutex.Unlock()
+	dir, err := executorTmpDir()
+	CheckError(err)
 
-	executorIDBytes, err := os.ReadFile("/tmp/executorid")
+	executorIDBytes, err := os.ReadFile(filepath.Join(dir, "executorid"))
 	CheckError(err)
 
 	executorID := string(executorIDBytes)
@@ -553,3 +607,61 @@ var resolveExecutorCmd = &cobra.Command{
 		log.WithFields(log.Fields{"ColonyId": ColonyID, "TargetExecutorName": TargetExecutorName}).Error("No such executor found")
 	},
 }
+
+var rotateExecutorKeyCmd = &cobra.Command{
+	Use:   "rotate-key",
+	Short: "Replace the private key of an executor",
+	Long:  "Replace the private key of an executor, the Executor Id is not changed and the new private key is stored in the keychain",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		keychain, err := security.CreateKeychain(KEYCHAIN_PATH)
+		CheckError(err)
+
+		if ColonyID == "" {
+			ColonyID = os.Getenv("COLONIES_COLONY_ID")
+		}
+		if ColonyID == "" {
+			CheckError(errors.New("Unknown Colony Id"))
+		}
+
+		if ExecutorID == "" {
+			ExecutorID = os.Getenv("COLONIES_EXECUTOR_ID")
+		}
+		if ExecutorID == "" {
+			CheckError(errors.New("Unknown Executor Id"))
+		}
+
+		if ExecutorPrvKey == "" {
+			ExecutorPrvKey = os.Getenv("COLONIES_EXECUTOR_PRVKEY")
+		}
+		if ExecutorPrvKey == "" {
+			ExecutorPrvKey, err = keychain.GetPrvKey(ExecutorID)
+			CheckError(err)
+		}
+
+		log.WithFields(log.Fields{"ServerHost": ServerHost, "ServerPort": ServerPort, "Insecure": Insecure}).Info("Starting a Colonies client")
+		client := client.CreateColoniesClient(ServerHost, ServerPort, Insecure, SkipTLSVerify)
+
+		newExecutorPrvKey, err := crypto.CreateCrypto().GeneratePrivateKey()
+		CheckError(err)
+
+		// The new key is stored before the key is rotated, the identity would otherwise be locked out if the
+		// keychain cannot store it
+		err = keychain.StagePrvKey(ExecutorID, newExecutorPrvKey)
+		CheckError(err)
+
+		_, err = client.RotateKey(ColonyID, ExecutorID, newExecutorPrvKey, ExecutorPrvKey)
+		if err != nil {
+			keychain.RemovePendingPrvKey(ExecutorID)
+			CheckError(err)
+		}
+
+		err = keychain.CommitPrvKey(ExecutorID)
+		if err != nil {
+			CheckError(errors.New("Private key rotated but failed to replace it in keychain, the new private key is stored as <" + ExecutorID + ".pending>: " + err.Error()))
+		}
+
+		log.WithFields(log.Fields{"ExecutorID": ExecutorID, "PrvKey": newExecutorPrvKey}).Info("Executor private key rotated and stored in keychain, the previous private key can no longer be used")
+	},
+}
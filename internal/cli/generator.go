@@ -22,6 +22,10 @@ func init() {
 	generatorCmd.AddCommand(delGeneratorCmd)
 	generatorCmd.AddCommand(getGeneratorCmd)
 	generatorCmd.AddCommand(getGeneratorsCmd)
+	generatorCmd.AddCommand(updateGeneratorCmd)
+	generatorCmd.AddCommand(pauseGeneratorCmd)
+	generatorCmd.AddCommand(resumeGeneratorCmd)
+	generatorCmd.AddCommand(generatorHistoryCmd)
 	rootCmd.AddCommand(generatorCmd)
 
 	generatorCmd.PersistentFlags().StringVarP(&ServerHost, "host", "", "localhost", "Server host")
@@ -30,7 +34,11 @@ func init() {
 	addGeneratorCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
 	addGeneratorCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
 	addGeneratorCmd.Flags().StringVarP(&SpecFile, "spec", "", "", "JSON specification of a Colony workflow")
-	addGeneratorCmd.MarkFlagRequired("spec")
+	addGeneratorCmd.Flags().StringVarP(&TemplateName, "template", "", "", "Name of a workflow template to use instead of a JSON specification")
+	addGeneratorCmd.Flags().IntVarP(&TemplateVersion, "version", "", 0, "Workflow template version, 0 means the latest version")
+	addGeneratorCmd.Flags().StringArrayVarP(&TemplateParams, "param", "", make([]string, 0), "Workflow template parameter, e.g. --param key1=value1 --param key2=value2")
+	addGeneratorCmd.Flags().IntVarP(&WorkflowDeadline, "deadline", "", 0, "Max time in seconds the workflow may run before all remaining processes fail, 0 means no deadline")
+	addGeneratorCmd.Flags().StringVarP(&FailurePolicy, "failurepolicy", "", "", "Failure policy, failfast cancels all remaining processes when a process fails, continue runs independent branches to completion")
 	addGeneratorCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
 	addGeneratorCmd.Flags().StringVarP(&GeneratorName, "name", "", "", "Generator name")
 	addGeneratorCmd.MarkFlagRequired("name")
@@ -59,6 +67,30 @@ func init() {
 	getGeneratorsCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
 	getGeneratorsCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
 	getGeneratorsCmd.Flags().IntVarP(&Count, "count", "", server.MAX_COUNT, "Number of generators to list")
+
+	updateGeneratorCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	updateGeneratorCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	updateGeneratorCmd.Flags().StringVarP(&GeneratorID, "generatorid", "", "", "Generator Id")
+	updateGeneratorCmd.MarkFlagRequired("generatorid")
+	updateGeneratorCmd.Flags().StringVarP(&SpecFile, "spec", "", "", "JSON specification of a Colony workflow")
+	updateGeneratorCmd.Flags().IntVarP(&GeneratorTrigger, "trigger", "", -1, "Trigger")
+	updateGeneratorCmd.Flags().IntVarP(&GeneratorTimeout, "timeout", "", -1, "Timeout")
+
+	pauseGeneratorCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	pauseGeneratorCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	pauseGeneratorCmd.Flags().StringVarP(&GeneratorID, "generatorid", "", "", "Generator Id")
+	pauseGeneratorCmd.MarkFlagRequired("generatorid")
+
+	resumeGeneratorCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	resumeGeneratorCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	resumeGeneratorCmd.Flags().StringVarP(&GeneratorID, "generatorid", "", "", "Generator Id")
+	resumeGeneratorCmd.MarkFlagRequired("generatorid")
+
+	generatorHistoryCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	generatorHistoryCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	generatorHistoryCmd.Flags().StringVarP(&GeneratorID, "generatorid", "", "", "Generator Id")
+	generatorHistoryCmd.MarkFlagRequired("generatorid")
+	generatorHistoryCmd.Flags().IntVarP(&Count, "count", "", server.MAX_COUNT, "Number of runs to list")
 }
 
 var generatorCmd = &cobra.Command{
@@ -74,11 +106,7 @@ var addGeneratorCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		parseServerEnv()
 
-		jsonSpecBytes, err := ioutil.ReadFile(SpecFile)
-		CheckError(err)
-
-		jsonStr := "{\"functionspecs\":" + string(jsonSpecBytes) + "}"
-		workflowSpec, err := core.ConvertJSONToWorkflowSpec(jsonStr)
+		workflowSpec, err := readWorkflowSpec()
 		CheckError(err)
 
 		if workflowSpec.ColonyID == "" {
@@ -253,6 +281,7 @@ var getGeneratorCmd = &cobra.Command{
 			[]string{"Name", generator.Name},
 			[]string{"Trigger", strconv.Itoa(generator.Trigger)},
 			[]string{"Timeout", strconv.Itoa(generator.Timeout)},
+			[]string{"Paused", strconv.FormatBool(generator.Paused)},
 			[]string{"Lastrun", generator.LastRun.Format(TimeLayout)},
 			[]string{"CheckerPeriod", strconv.Itoa(generator.CheckerPeriod)},
 			[]string{"QueueSize", strconv.Itoa(generator.QueueSize)},
@@ -328,3 +357,124 @@ var getGeneratorsCmd = &cobra.Command{
 		table.Render()
 	},
 }
+
+var updateGeneratorCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update a generator",
+	Long:  "Update a generator, only specified flags are changed",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client, generator := resolveGenerator()
+
+		if cmd.Flags().Changed("spec") {
+			jsonSpecBytes, err := ioutil.ReadFile(SpecFile)
+			CheckError(err)
+
+			jsonStr := "{\"functionspecs\":" + string(jsonSpecBytes) + "}"
+			workflowSpec, err := core.ConvertJSONToWorkflowSpec(jsonStr)
+			CheckError(err)
+
+			if workflowSpec.ColonyID == "" {
+				workflowSpec.ColonyID = generator.ColonyID
+			}
+
+			workflowSpecJSON, err := workflowSpec.ToJSON()
+			CheckError(err)
+			generator.WorkflowSpec = workflowSpecJSON
+		}
+
+		if cmd.Flags().Changed("trigger") {
+			generator.Trigger = GeneratorTrigger
+		}
+		if cmd.Flags().Changed("timeout") {
+			generator.Timeout = GeneratorTimeout
+		}
+
+		updatedGenerator, err := client.UpdateGenerator(generator, ExecutorPrvKey)
+		CheckError(err)
+
+		log.WithFields(log.Fields{"GeneratorID": updatedGenerator.ID, "Trigger": updatedGenerator.Trigger, "Timeout": updatedGenerator.Timeout}).Info("Generator updated")
+	},
+}
+
+var pauseGeneratorCmd = &cobra.Command{
+	Use:   "pause",
+	Short: "Pause a generator",
+	Long:  "Pause a generator, args can still be packed but no workflows are submitted until the generator is resumed",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+		setGeneratorPaused(true)
+		log.WithFields(log.Fields{"GeneratorID": GeneratorID}).Info("Generator paused")
+	},
+}
+
+var resumeGeneratorCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resume a paused generator",
+	Long:  "Resume a paused generator",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+		setGeneratorPaused(false)
+		log.WithFields(log.Fields{"GeneratorID": GeneratorID}).Info("Generator resumed")
+	},
+}
+
+var generatorHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "List the run history of a generator",
+	Long:  "List the run history of a generator",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client, generator := resolveGenerator()
+
+		runHistory, err := client.GetRunHistory(generator.ID, Count, ExecutorPrvKey)
+		CheckError(err)
+		if len(runHistory) == 0 {
+			log.WithFields(log.Fields{"GeneratorId": generator.ID}).Info("No runs found")
+			os.Exit(0)
+		}
+
+		printRunHistory(runHistory)
+	},
+}
+
+func resolveGenerator() (*client.ColoniesClient, *core.Generator) {
+	keychain, err := security.CreateKeychain(KEYCHAIN_PATH)
+	CheckError(err)
+
+	if ExecutorID == "" {
+		ExecutorID = os.Getenv("COLONIES_EXECUTOR_ID")
+	}
+	if ExecutorID == "" {
+		CheckError(errors.New("Unknown Executor Id"))
+	}
+
+	if ExecutorPrvKey == "" {
+		ExecutorPrvKey, err = keychain.GetPrvKey(ExecutorID)
+		CheckError(err)
+	}
+
+	log.WithFields(log.Fields{"ServerHost": ServerHost, "ServerPort": ServerPort, "Insecure": Insecure}).Info("Starting a Colonies client")
+	client := client.CreateColoniesClient(ServerHost, ServerPort, Insecure, SkipTLSVerify)
+
+	if GeneratorID == "" {
+		CheckError(errors.New("Generator Id not specified"))
+	}
+
+	generator, err := client.GetGenerator(GeneratorID, ExecutorPrvKey)
+	CheckError(err)
+	if generator == nil {
+		CheckError(errors.New("Generator not found"))
+	}
+
+	return client, generator
+}
+
+func setGeneratorPaused(paused bool) {
+	client, generator := resolveGenerator()
+	generator.Paused = paused
+	_, err := client.UpdateGenerator(generator, ExecutorPrvKey)
+	CheckError(err)
+}
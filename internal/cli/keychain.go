@@ -13,6 +13,7 @@ func init() {
 	keychainCmd.AddCommand(addPrivateKeyCmd)
 	keychainCmd.AddCommand(getPrivateKeyCmd)
 	keychainCmd.AddCommand(genPrivateKeyCmd)
+	keychainCmd.AddCommand(encryptKeychainCmd)
 	rootCmd.AddCommand(keychainCmd)
 
 	getPrivateKeyCmd.Flags().StringVarP(&ID, "id", "", "", "Identity")
@@ -80,3 +81,18 @@ var genPrivateKeyCmd = &cobra.Command{
 		log.WithFields(log.Fields{"Id": id, "PrvKey": prvKey}).Info("Generated new private key and stored in keychain")
 	},
 }
+
+var encryptKeychainCmd = &cobra.Command{
+	Use:   "encrypt",
+	Short: "Encrypt all plaintext private keys with the passphrase in " + security.KEYCHAIN_PASSPHRASE_ENV,
+	Long:  "Encrypt all plaintext private keys with the passphrase in " + security.KEYCHAIN_PASSPHRASE_ENV,
+	Run: func(cmd *cobra.Command, args []string) {
+		keychain, err := security.CreateKeychain(KEYCHAIN_PATH)
+		CheckError(err)
+
+		count, err := keychain.Encrypt()
+		CheckError(err)
+
+		log.WithFields(log.Fields{"Count": count}).Info("Encrypted private keys in keychain")
+	},
+}
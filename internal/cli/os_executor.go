@@ -1,7 +1,7 @@
 package cli
 
 import (
-	"bytes"
+	"bufio"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -21,12 +21,16 @@ import (
 	"github.com/colonyos/colonies/pkg/core"
 	"github.com/colonyos/colonies/pkg/security"
 	"github.com/colonyos/colonies/pkg/security/crypto"
+	"github.com/colonyos/colonies/pkg/server"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
 var mutex sync.Mutex
 
+const LogFlushSize = 16 * 1024 // Size in bytes of buffered output that triggers a flush of the logs to the server
+const LogFlushPeriod = 1000    // Period in milliseconds when buffered output is flushed to the server
+
 func init() {
 	osExecutorCmd.AddCommand(executorStartCmd)
 	executorStartCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
@@ -37,6 +41,7 @@ func init() {
 	executorStartCmd.Flags().IntVarP(&Timeout, "timeout", "", 100, "Max time to wait for a process assignment")
 	executorStartCmd.Flags().Float64VarP(&Long, "long", "", 0, "Longitude")
 	executorStartCmd.Flags().Float64VarP(&Lat, "lat", "", 0, "Latitude")
+	executorStartCmd.Flags().BoolVarP(&SaveKeys, "savekeys", "", false, "Save the generated executor Id and private key to a directory only accessible by the current user, by default the private key is only kept in memory")
 }
 
 var osExecutorCmd = &cobra.Command{
@@ -84,12 +89,6 @@ var executorStartCmd = &cobra.Command{
 		executorID, err := crypto.GenerateID(executorPrvKey)
 		CheckError(err)
 
-		err = os.WriteFile("/tmp/executorid", []byte(executorID), 0644)
-		CheckError(err)
-
-		err = os.WriteFile("/tmp/executorprvkey", []byte(executorPrvKey), 0644)
-		CheckError(err)
-
 		if ExecutorName == "" {
 			ExecutorName = os.Getenv("COLONIES_EXECUTOR_NAME")
 		}
@@ -106,13 +105,18 @@ var executorStartCmd = &cobra.Command{
 			CheckError(errors.New("Executor type not specified"))
 		}
 
-		log.Info("Saving executorID to /tmp/executorid")
-		err = os.WriteFile("/tmp/executorid", []byte(executorID), 0644)
-		CheckError(err)
+		if os.Getenv("COLONIES_SAVE_KEYS") == "true" {
+			SaveKeys = true
+		}
+		if SaveKeys {
+			executorIDFile, err := writeExecutorTmpFile("executorid", executorID)
+			CheckError(err)
+			log.WithFields(log.Fields{"File": executorIDFile}).Info("Saved executor Id")
 
-		log.Info("Saving executorPrvKey to /tmp/executorprvkey")
-		err = os.WriteFile("/tmp/executorprvkey", []byte(executorPrvKey), 0644)
-		CheckError(err)
+			executorPrvKeyFile, err := writeExecutorTmpFile("executorprvkey", executorPrvKey)
+			CheckError(err)
+			log.WithFields(log.Fields{"File": executorPrvKeyFile}).Info("Saved executor private key")
+		}
 
 		log.WithFields(log.Fields{"ExecutorID": executorID, "ExecutorName": ExecutorName, "ExecutorType": ExecutorType, "ColonyID": ColonyID, "Long": Long, "Lat": Lat}).Info("Added a new executor")
 		executor := core.CreateExecutor(executorID, ExecutorType, ExecutorName, ColonyID, time.Now(), time.Now())
@@ -134,7 +138,10 @@ var executorStartCmd = &cobra.Command{
 				log.WithFields(log.Fields{"ProcessID": assignedProcess.ID}).Info("Closing process as failed")
 				client.Fail(assignedProcess.ID, []string{"SIGTERM"}, executorPrvKey)
 			}
-			removeExecutorFromTmp(client)
+			mutex.Lock()
+			err := client.DeleteExecutor(executorID, ColonyPrvKey)
+			mutex.Unlock()
+			CheckError(err)
 			os.Exit(0)
 		}()
 
@@ -149,7 +156,7 @@ var executorStartCmd = &cobra.Command{
 					time.Sleep(2 * time.Second)
 					continue
 				default:
-					if strings.HasPrefix(err.Error(), "No processes can be selected for executor with Id") {
+					if errors.Is(err, core.ErrNoWorkAvailable) {
 						continue
 					} else {
 						CheckError(err)
@@ -191,22 +198,36 @@ var executorStartCmd = &cobra.Command{
 				failure = true
 			}
 
+			var logFile *os.File
+			if LogDir != "" {
+				logFile, err = os.OpenFile(LogDir+"/"+assignedProcess.ID+".log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+				CheckError(err)
+			}
+
+			// Stream the output to the server, so that the logs of a running or crashed process can be followed
+			// by users, the output is buffered to avoid sending one request per line
+			streamer := createLogStreamer(client, assignedProcess.ID, executorPrvKey)
 			output := ""
+			reader := bufio.NewReader(stdout)
 			for {
-				tmp := make([]byte, 1)
-				_, err := stdout.Read(tmp)
+				line, err := reader.ReadString('\n')
+				if len(line) > 0 {
+					fmt.Print(line)
+					if logFile != nil {
+						_, err := logFile.WriteString(line)
+						CheckError(err)
+					}
+					streamer.add(line)
+					output += line
+				}
 				if err != nil {
 					break
 				}
-				fmt.Print(string(tmp))
-				if LogDir != "" {
-					f, err := os.OpenFile(LogDir+"/"+assignedProcess.ID+".log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-					CheckError(err)
-					defer f.Close()
-					_, err = f.WriteString(string(tmp))
-					CheckError(err)
-				}
-				output += string(bytes.Trim(tmp, "\x00"))
+			}
+
+			streamer.close()
+			if logFile != nil {
+				logFile.Close()
 			}
 
 			failure = false
@@ -227,3 +248,86 @@ var executorStartCmd = &cobra.Command{
 		}
 	},
 }
+
+// logStreamer buffers the output of a process and sends it to the server when LogFlushSize bytes have been
+// buffered, or every LogFlushPeriod milliseconds
+type logStreamer struct {
+	client         *client.ColoniesClient
+	processID      string
+	executorPrvKey string
+	mutex          sync.Mutex
+	buffer         strings.Builder
+	failed         bool
+	stopChan       chan bool
+	doneChan       chan bool
+}
+
+func createLogStreamer(client *client.ColoniesClient, processID string, executorPrvKey string) *logStreamer {
+	streamer := &logStreamer{client: client, processID: processID, executorPrvKey: executorPrvKey, stopChan: make(chan bool), doneChan: make(chan bool)}
+
+	go func() {
+		ticker := time.NewTicker(LogFlushPeriod * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				streamer.mutex.Lock()
+				streamer.flush()
+				streamer.mutex.Unlock()
+			case <-streamer.stopChan:
+				streamer.doneChan <- true
+				return
+			}
+		}
+	}()
+
+	return streamer
+}
+
+func (streamer *logStreamer) add(line string) {
+	streamer.mutex.Lock()
+	defer streamer.mutex.Unlock()
+
+	if streamer.failed {
+		return
+	}
+
+	streamer.buffer.WriteString(line)
+	if streamer.buffer.Len() >= LogFlushSize {
+		streamer.flush()
+	}
+}
+
+// close stops the periodic flushing and sends the remaining buffered output to the server
+func (streamer *logStreamer) close() {
+	streamer.stopChan <- true
+	<-streamer.doneChan
+
+	streamer.mutex.Lock()
+	defer streamer.mutex.Unlock()
+	streamer.flush()
+}
+
+// flush sends the buffered output to the server, split into chunks the server accepts, must be called with the
+// mutex held
+func (streamer *logStreamer) flush() {
+	if streamer.failed || streamer.buffer.Len() == 0 {
+		return
+	}
+
+	output := streamer.buffer.String()
+	streamer.buffer.Reset()
+	for len(output) > 0 {
+		chunk := output
+		if len(chunk) > server.MAX_LOG_CHUNK_SIZE {
+			chunk = chunk[:server.MAX_LOG_CHUNK_SIZE]
+		}
+		_, err := streamer.client.AddLog(streamer.processID, chunk, streamer.executorPrvKey)
+		if err != nil {
+			log.WithFields(log.Fields{"ProcessID": streamer.processID, "Error": err}).Warn("Failed to stream logs, logs are only kept locally")
+			streamer.failed = true
+			return
+		}
+		output = output[len(chunk):]
+	}
+}
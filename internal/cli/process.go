@@ -23,6 +23,8 @@ func init() {
 	processCmd.AddCommand(listSuccessfulProcessesCmd)
 	processCmd.AddCommand(listFailedProcessesCmd)
 	processCmd.AddCommand(getProcessCmd)
+	processCmd.AddCommand(processHistoryCmd)
+	processCmd.AddCommand(processLogsCmd)
 	processCmd.AddCommand(deleteProcessCmd)
 	processCmd.AddCommand(deleteAllProcessesCmd)
 	processCmd.AddCommand(assignProcessCmd)
@@ -69,6 +71,18 @@ func init() {
 	getProcessCmd.Flags().BoolVarP(&JSON, "json", "", false, "Print JSON instead of tables")
 	getProcessCmd.Flags().BoolVarP(&PrintOutput, "out", "", false, "Print process output")
 
+	processHistoryCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	processHistoryCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	processHistoryCmd.Flags().StringVarP(&ProcessID, "processid", "p", "", "Process Id")
+	processHistoryCmd.MarkFlagRequired("processid")
+	processHistoryCmd.Flags().BoolVarP(&JSON, "json", "", false, "Print JSON instead of tables")
+
+	processLogsCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	processLogsCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	processLogsCmd.Flags().StringVarP(&ProcessID, "processid", "p", "", "Process Id")
+	processLogsCmd.MarkFlagRequired("processid")
+	processLogsCmd.Flags().BoolVarP(&Follow, "follow", "f", false, "Wait for new logs until the process has finished")
+
 	deleteProcessCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
 	deleteProcessCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
 	deleteProcessCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
@@ -88,6 +102,7 @@ func init() {
 	assignProcessCmd.Flags().IntVarP(&Timeout, "timeout", "", 100, "Max time to wait for a process assignment")
 
 	closeSuccessfulCmd.Flags().StringSliceVarP(&Output, "out", "", make([]string, 0), "Output")
+	closeSuccessfulCmd.Flags().StringSliceVarP(&NamedOutput, "namedout", "", make([]string, 0), "Named output, e.g. --namedout model=model.bin,accuracy=0.9")
 	closeSuccessfulCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
 	closeSuccessfulCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
 	closeSuccessfulCmd.Flags().StringVarP(&ProcessID, "processid", "p", "", "Process Id")
@@ -444,6 +459,12 @@ func printFunctionSpec(funcSpec *core.FunctionSpec) {
 		[]string{"MaxRetries", strconv.Itoa(funcSpec.MaxRetries)},
 		[]string{"Priority", strconv.Itoa(funcSpec.Priority)},
 	}
+	if len(funcSpec.Env) > 0 {
+		specData = append(specData, []string{"Env", Env2Str(funcSpec.Env)})
+	}
+	if funcSpec.AllowFailure {
+		specData = append(specData, []string{"AllowFailure", "True"})
+	}
 	specTable := tablewriter.NewWriter(os.Stdout)
 	for _, v := range specData {
 		specTable.Append(v)
@@ -544,6 +565,8 @@ var getProcessCmd = &cobra.Command{
 			[]string{"Retries", strconv.Itoa(process.Retries)},
 			[]string{"Errors", StrArr2Str(process.Errors)},
 			[]string{"Output", StrArr2Str(IfArr2StringArr(process.Output))},
+			[]string{"NamedInput", NamedValues2Str(process.NamedInput)},
+			[]string{"NamedOutput", NamedValues2Str(process.NamedOutput)},
 		}
 		processTable := tablewriter.NewWriter(os.Stdout)
 		for _, v := range processData {
@@ -582,11 +605,12 @@ var getProcessCmd = &cobra.Command{
 					key = attribute.Key
 				}
 
-				var value string
-				if len(attribute.Value) > MaxAttributeLength {
-					value = attribute.Value[0:MaxAttributeLength] + "..."
-				} else {
-					value = attribute.Value
+				value := attribute.Value
+				if attribute.AttributeType == core.ENV {
+					value = EnvValue2Str(value)
+				}
+				if len(value) > MaxAttributeLength {
+					value = value[0:MaxAttributeLength] + "..."
 				}
 				attributeData = append(attributeData, []string{attribute.ID, key, value, attributeType})
 			}
@@ -604,6 +628,122 @@ var getProcessCmd = &cobra.Command{
 	},
 }
 
+var processHistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "List all state transitions of a process",
+	Long:  "List all state transitions of a process, e.g. when it was assigned, unassigned due to a timeout, reset or closed",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		keychain, err := security.CreateKeychain(KEYCHAIN_PATH)
+		CheckError(err)
+
+		if ExecutorID == "" {
+			ExecutorID = os.Getenv("COLONIES_EXECUTOR_ID")
+		}
+		if ExecutorID == "" {
+			CheckError(errors.New("Unknown Executor Id"))
+		}
+
+		if ExecutorPrvKey == "" {
+			ExecutorPrvKey, err = keychain.GetPrvKey(ExecutorID)
+			CheckError(err)
+		}
+		log.WithFields(log.Fields{"ServerHost": ServerHost, "ServerPort": ServerPort, "Insecure": Insecure}).Info("Starting a Colonies client")
+		client := client.CreateColoniesClient(ServerHost, ServerPort, Insecure, SkipTLSVerify)
+
+		events, err := client.GetProcessEvents(ProcessID, ExecutorPrvKey)
+		CheckError(err)
+
+		if JSON {
+			jsonString, err := core.ConvertProcessEventArrayToJSON(events)
+			CheckError(err)
+			fmt.Println(jsonString)
+			os.Exit(0)
+		}
+
+		if len(events) == 0 {
+			log.WithFields(log.Fields{"ProcessId": ProcessID}).Info("No events found")
+			os.Exit(0)
+		}
+
+		var data [][]string
+		for _, event := range events {
+			executorID := event.ExecutorID
+			if executorID == "" {
+				executorID = "None"
+			}
+			data = append(data, []string{event.Time.Format(TimeLayout), event.Type, State2String(event.State), executorID, event.Message})
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Time", "Event", "State", "ExecutorId", "Message"})
+		for _, v := range data {
+			table.Append(v)
+		}
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		table.Render()
+	},
+}
+
+var processLogsCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "Print the logs of a process",
+	Long:  "Print the logs streamed by the executor running a process",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		keychain, err := security.CreateKeychain(KEYCHAIN_PATH)
+		CheckError(err)
+
+		if ExecutorID == "" {
+			ExecutorID = os.Getenv("COLONIES_EXECUTOR_ID")
+		}
+		if ExecutorID == "" {
+			CheckError(errors.New("Unknown Executor Id"))
+		}
+
+		if ExecutorPrvKey == "" {
+			ExecutorPrvKey, err = keychain.GetPrvKey(ExecutorID)
+			CheckError(err)
+		}
+		log.WithFields(log.Fields{"ServerHost": ServerHost, "ServerPort": ServerPort, "Insecure": Insecure}).Info("Starting a Colonies client")
+		client := client.CreateColoniesClient(ServerHost, ServerPort, Insecure, SkipTLSVerify)
+
+		timeout := 0
+		if Follow {
+			timeout = 10
+		}
+
+		offset := int64(0)
+		for {
+			logs, err := client.GetLogs(ProcessID, offset, server.MAX_COUNT, timeout, ExecutorPrvKey)
+			CheckError(err)
+
+			for _, l := range logs {
+				fmt.Print(l.Message)
+				offset = l.End()
+			}
+
+			if len(logs) > 0 {
+				continue
+			}
+
+			if !Follow {
+				break
+			}
+
+			// No new logs arrived within the timeout, if the process has finished, fetch the logs added
+			// since the last call once more and then stop
+			process, err := client.GetProcess(ProcessID, ExecutorPrvKey)
+			CheckError(err)
+			if process.State == core.SUCCESS || process.State == core.FAILED || process.State == core.SKIPPED {
+				Follow = false
+				timeout = 0
+			}
+		}
+	},
+}
+
 var deleteProcessCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete a process",
@@ -739,7 +879,22 @@ var closeSuccessfulCmd = &cobra.Command{
 		process, err := client.GetProcess(ProcessID, ExecutorPrvKey)
 		CheckError(err)
 
-		if len(Output) > 0 {
+		if len(NamedOutput) > 0 {
+			outputIf := make([]interface{}, len(Output))
+			for k, v := range Output {
+				outputIf[k] = v
+			}
+			namedOutput := make(map[string]interface{})
+			for _, v := range NamedOutput {
+				s := strings.SplitN(v, "=", 2)
+				if len(s) != 2 {
+					CheckError(errors.New("Invalid key-value pair, try e.g. --namedout key1=value1,key2=value2"))
+				}
+				namedOutput[s[0]] = s[1]
+			}
+			err = client.CloseWithNamedOutput(process.ID, outputIf, namedOutput, ExecutorPrvKey)
+			CheckError(err)
+		} else if len(Output) > 0 {
 			outputIf := make([]interface{}, len(Output))
 			for k, v := range Output {
 				outputIf[k] = v
@@ -67,6 +67,7 @@ var FuncName string
 var Arg string
 var Args []string
 var Output []string
+var NamedOutput []string
 var Errors []string
 var Env []string
 var MaxWaitTime int
@@ -78,6 +79,9 @@ var EtcdClientPort int
 var EtcdPeerPort int
 var EtcdCluster []string
 var EtcdDataDir string
+var ArtifactDir string
+var SecretKey string
+var SaveKeys bool
 var RelayPort int
 var Timeout int
 var CronID string
@@ -95,6 +99,27 @@ var Approve bool
 var Waiting bool
 var Successful bool
 var Failed bool
+var TemplateName string
+var TemplateVersion int
+var TemplateParams []string
+var WorkflowDeadline int
+var FailurePolicy string
+var Format string
+var Follow bool
+var ArtifactID string
+var ArtifactName string
+var ArtifactPath string
+var ArtifactDest string
+var WebhookID string
+var WebhookURL string
+var WebhookSecret string
+var WebhookKinds []string
+var WebhookTypes []string
+var SecretName string
+var SecretValue string
+var SecretExecutorTypes []string
+var AdminID string
+var AdminName string
 
 func init() {
 	rootCmd.PersistentFlags().BoolVarP(&Verbose, "verbose", "v", false, "verbose output")
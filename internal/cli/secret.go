@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/colonyos/colonies/pkg/client"
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/security"
+	"github.com/kataras/tablewriter"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	secretCmd.AddCommand(addSecretCmd)
+	secretCmd.AddCommand(listSecretsCmd)
+	secretCmd.AddCommand(deleteSecretCmd)
+	rootCmd.AddCommand(secretCmd)
+
+	secretCmd.PersistentFlags().StringVarP(&ServerHost, "host", "", "localhost", "Server host")
+	secretCmd.PersistentFlags().IntVarP(&ServerPort, "port", "", -1, "Server HTTP port")
+	secretCmd.PersistentFlags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
+	secretCmd.PersistentFlags().StringVarP(&ColonyPrvKey, "colonyprvkey", "", "", "Colony private key")
+
+	addSecretCmd.Flags().StringVarP(&SecretName, "name", "", "", "Name of the secret, referenced in env as secretref://name")
+	addSecretCmd.MarkFlagRequired("name")
+	addSecretCmd.Flags().StringVarP(&SecretValue, "value", "", "", "Value of the secret, read from stdin if not set")
+	addSecretCmd.Flags().StringSliceVarP(&SecretExecutorTypes, "executortypes", "", make([]string, 0), "Executor types the secret is resolved for, e.g. --executortypes db-backup,etl")
+	addSecretCmd.MarkFlagRequired("executortypes")
+
+	listSecretsCmd.Flags().BoolVarP(&JSON, "json", "", false, "Print JSON instead of tables")
+
+	deleteSecretCmd.Flags().StringVarP(&SecretName, "name", "", "", "Name of the secret")
+	deleteSecretCmd.MarkFlagRequired("name")
+}
+
+var secretCmd = &cobra.Command{
+	Use:   "secret",
+	Short: "Manage colony secrets",
+	Long:  "Manage colony secrets",
+}
+
+func setupSecretClient() *client.ColoniesClient {
+	keychain, err := security.CreateKeychain(KEYCHAIN_PATH)
+	CheckError(err)
+
+	if ColonyID == "" {
+		ColonyID = os.Getenv("COLONIES_COLONY_ID")
+	}
+	if ColonyID == "" {
+		CheckError(errors.New("Unknown Colony Id"))
+	}
+
+	if ColonyPrvKey == "" {
+		ColonyPrvKey, err = keychain.GetPrvKey(ColonyID)
+		CheckError(err)
+	}
+
+	log.WithFields(log.Fields{"ServerHost": ServerHost, "ServerPort": ServerPort, "Insecure": Insecure}).Info("Starting a Colonies client")
+	return client.CreateColoniesClient(ServerHost, ServerPort, Insecure, SkipTLSVerify)
+}
+
+var addSecretCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add a secret to a colony, or replace the value of an existing secret",
+	Long:  "Add a secret to a colony, or replace the value of an existing secret",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client := setupSecretClient()
+
+		if SecretValue == "" {
+			// Reading the value from stdin keeps it out of the shell history
+			reader := bufio.NewReader(os.Stdin)
+			value, err := reader.ReadString('\n')
+			if err != nil && value == "" {
+				CheckError(errors.New("Failed to read secret value from stdin"))
+			}
+			SecretValue = strings.TrimSuffix(strings.TrimSuffix(value, "\n"), "\r")
+		}
+
+		secret := core.CreateSecret(ColonyID, SecretName, SecretValue, SecretExecutorTypes)
+		addedSecret, err := client.AddSecret(secret, ColonyPrvKey)
+		CheckError(err)
+
+		log.WithFields(log.Fields{"Name": addedSecret.Name, "Ref": core.CreateSecretRef(addedSecret.Name)}).Info("Secret added")
+	},
+}
+
+var listSecretsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "List all secrets in a colony, values are never shown",
+	Long:  "List all secrets in a colony, values are never shown",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client := setupSecretClient()
+
+		secrets, err := client.GetSecrets(ColonyID, ColonyPrvKey)
+		CheckError(err)
+
+		if len(secrets) == 0 {
+			log.WithFields(log.Fields{"ColonyId": ColonyID}).Info("No secrets found")
+			os.Exit(0)
+		}
+
+		if JSON {
+			jsonString, err := core.ConvertSecretArrayToJSON(secrets)
+			CheckError(err)
+			fmt.Println(jsonString)
+			os.Exit(0)
+		}
+
+		var data [][]string
+		for _, secret := range secrets {
+			data = append(data, []string{secret.Name, core.CreateSecretRef(secret.Name), strings.Join(secret.ExecutorTypes, ","), secret.Added.Format(TimeLayout)})
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Name", "Ref", "Executor types", "Added"})
+		for _, v := range data {
+			table.Append(v)
+		}
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		table.Render()
+	},
+}
+
+var deleteSecretCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a secret",
+	Long:  "Delete a secret",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client := setupSecretClient()
+
+		err := client.DeleteSecret(ColonyID, SecretName, ColonyPrvKey)
+		CheckError(err)
+
+		log.WithFields(log.Fields{"Name": SecretName}).Info("Secret deleted")
+	},
+}
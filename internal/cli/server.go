@@ -15,6 +15,7 @@ import (
 	"github.com/colonyos/colonies/pkg/database/postgresql"
 	"github.com/colonyos/colonies/pkg/security"
 	"github.com/colonyos/colonies/pkg/server"
+	"github.com/colonyos/colonies/pkg/storage"
 	"github.com/gin-gonic/gin"
 	"github.com/kataras/tablewriter"
 	log "github.com/sirupsen/logrus"
@@ -42,6 +43,8 @@ func init() {
 	serverCmd.PersistentFlags().IntVarP(&RelayPort, "relayport", "", 2381, "Colonies server relay port")
 	serverCmd.PersistentFlags().StringSliceVarP(&EtcdCluster, "initial-cluster", "", make([]string, 0), "Cluster config, e.g. --etcdcluster server1=localhost:peerport:relayport:apiport,server2=localhost:peerport:relayport:apiport")
 	serverCmd.PersistentFlags().StringVarP(&EtcdDataDir, "etcddatadir", "", "", "Etcd data dir")
+	serverCmd.PersistentFlags().StringVarP(&ArtifactDir, "artifactdir", "", "", "Directory where artifacts are stored")
+	serverCmd.PersistentFlags().StringVarP(&SecretKey, "secretkey", "", "", "Hex encoded 32 byte key used to encrypt colony secrets, must be the same on all servers in a cluster")
 
 	serverStatusCmd.PersistentFlags().StringVarP(&ServerHost, "host", "", "localhost", "Server host")
 	serverStatusCmd.PersistentFlags().IntVarP(&ServerPort, "port", "", -1, "Server HTTP port")
@@ -236,6 +239,27 @@ var serverStartCmd = &cobra.Command{
 			log.Warning("EtcdDataDir not specified, setting it to " + EtcdDataDir)
 		}
 
+		if ArtifactDir == "" {
+			ArtifactDir = os.Getenv("COLONIES_ARTIFACT_DIR")
+		}
+		if ArtifactDir == "" {
+			ArtifactDir = "/tmp/colonies/prod/artifacts"
+			log.Warning("ArtifactDir not specified, setting it to " + ArtifactDir)
+		}
+		artifactStorage, err := storage.CreateLocalStorage(ArtifactDir)
+		CheckError(err)
+
+		if SecretKey == "" {
+			SecretKey = os.Getenv("COLONIES_SECRET_KEY")
+		}
+		var secretKey []byte
+		if SecretKey == "" {
+			log.Warning("SecretKey not specified, colony secrets are disabled")
+		} else {
+			secretKey, err = security.ParseSecretKey(SecretKey)
+			CheckError(err)
+		}
+
 		if Verbose {
 			log.SetLevel(log.DebugLevel)
 		} else {
@@ -254,6 +278,8 @@ var serverStartCmd = &cobra.Command{
 
 		retentionPeriod := 60000 // Run retention worker once a minute
 
+		allowPrivateWebhooks := os.Getenv("COLONIES_ALLOW_PRIVATE_WEBHOOKS") == "true"
+
 		setupProfiler()
 
 		server := server.CreateColoniesServer(db,
@@ -271,7 +297,10 @@ var serverStartCmd = &cobra.Command{
 			AllowExecutorReregister,
 			retention,
 			retentionPolicy,
-			retentionPeriod)
+			retentionPeriod,
+			artifactStorage,
+			secretKey,
+			allowPrivateWebhooks)
 
 		for {
 			err := server.ServeForever()
@@ -0,0 +1,194 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/colonyos/colonies/pkg/client"
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/security"
+	"github.com/colonyos/colonies/pkg/server"
+	"github.com/kataras/tablewriter"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	webhookCmd.AddCommand(addWebhookCmd)
+	webhookCmd.AddCommand(listWebhooksCmd)
+	webhookCmd.AddCommand(deleteWebhookCmd)
+	webhookCmd.AddCommand(listWebhookDeliveriesCmd)
+	rootCmd.AddCommand(webhookCmd)
+
+	webhookCmd.PersistentFlags().StringVarP(&ServerHost, "host", "", "localhost", "Server host")
+	webhookCmd.PersistentFlags().IntVarP(&ServerPort, "port", "", -1, "Server HTTP port")
+	webhookCmd.PersistentFlags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
+	webhookCmd.PersistentFlags().StringVarP(&ColonyPrvKey, "colonyprvkey", "", "", "Colony private key")
+
+	addWebhookCmd.Flags().StringVarP(&WebhookURL, "url", "", "", "URL the events are posted to")
+	addWebhookCmd.MarkFlagRequired("url")
+	addWebhookCmd.Flags().StringVarP(&WebhookSecret, "secret", "", "", "Secret used to sign payloads, generated by the server if not set")
+	addWebhookCmd.Flags().StringSliceVarP(&WebhookKinds, "kinds", "", make([]string, 0), "Kinds of events to post, e.g. --kinds process,executor, all kinds if not set")
+	addWebhookCmd.Flags().StringSliceVarP(&WebhookTypes, "types", "", make([]string, 0), "Types of events to post, e.g. --types failed,offline, all types if not set")
+	addWebhookCmd.Flags().BoolVarP(&JSON, "json", "", false, "Print JSON instead of tables")
+
+	listWebhooksCmd.Flags().BoolVarP(&JSON, "json", "", false, "Print JSON instead of tables")
+
+	deleteWebhookCmd.Flags().StringVarP(&WebhookID, "webhookid", "", "", "Webhook Id")
+	deleteWebhookCmd.MarkFlagRequired("webhookid")
+
+	listWebhookDeliveriesCmd.Flags().StringVarP(&WebhookID, "webhookid", "", "", "Webhook Id")
+	listWebhookDeliveriesCmd.MarkFlagRequired("webhookid")
+	listWebhookDeliveriesCmd.Flags().IntVarP(&Count, "count", "", server.MAX_COUNT, "Number of deliveries to list")
+	listWebhookDeliveriesCmd.Flags().BoolVarP(&JSON, "json", "", false, "Print JSON instead of tables")
+}
+
+var webhookCmd = &cobra.Command{
+	Use:   "webhook",
+	Short: "Manage webhooks",
+	Long:  "Manage webhooks",
+}
+
+func setupWebhookClient() *client.ColoniesClient {
+	keychain, err := security.CreateKeychain(KEYCHAIN_PATH)
+	CheckError(err)
+
+	if ColonyID == "" {
+		ColonyID = os.Getenv("COLONIES_COLONY_ID")
+	}
+	if ColonyID == "" {
+		CheckError(errors.New("Unknown Colony Id"))
+	}
+
+	if ColonyPrvKey == "" {
+		ColonyPrvKey, err = keychain.GetPrvKey(ColonyID)
+		CheckError(err)
+	}
+
+	log.WithFields(log.Fields{"ServerHost": ServerHost, "ServerPort": ServerPort, "Insecure": Insecure}).Info("Starting a Colonies client")
+	return client.CreateColoniesClient(ServerHost, ServerPort, Insecure, SkipTLSVerify)
+}
+
+func printWebhooksTable(webhooks []*core.Webhook) {
+	var data [][]string
+	for _, webhook := range webhooks {
+		data = append(data, []string{webhook.ID, webhook.URL, strings.Join(webhook.Kinds, ","), strings.Join(webhook.Types, ","), webhook.Added.Format(TimeLayout)})
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"WebhookId", "URL", "Kinds", "Types", "Added"})
+	for _, v := range data {
+		table.Append(v)
+	}
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.Render()
+}
+
+var addWebhookCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Register a webhook that colony events are posted to",
+	Long:  "Register a webhook that colony events are posted to",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client := setupWebhookClient()
+
+		webhook := core.CreateWebhook(ColonyID, WebhookURL, WebhookSecret, WebhookKinds, WebhookTypes)
+		addedWebhook, err := client.AddWebhook(webhook, ColonyPrvKey)
+		CheckError(err)
+
+		if JSON {
+			jsonString, err := addedWebhook.ToJSON()
+			CheckError(err)
+			fmt.Println(jsonString)
+			os.Exit(0)
+		}
+
+		log.WithFields(log.Fields{"WebhookId": addedWebhook.ID, "URL": addedWebhook.URL}).Info("Webhook added")
+		fmt.Println("Secret (store it now, it will not be shown again): " + addedWebhook.Secret)
+	},
+}
+
+var listWebhooksCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "List all webhooks in a colony",
+	Long:  "List all webhooks in a colony",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client := setupWebhookClient()
+
+		webhooks, err := client.GetWebhooks(ColonyID, ColonyPrvKey)
+		CheckError(err)
+
+		if len(webhooks) == 0 {
+			log.WithFields(log.Fields{"ColonyId": ColonyID}).Info("No webhooks found")
+			os.Exit(0)
+		}
+
+		if JSON {
+			jsonString, err := core.ConvertWebhookArrayToJSON(webhooks)
+			CheckError(err)
+			fmt.Println(jsonString)
+			os.Exit(0)
+		}
+
+		printWebhooksTable(webhooks)
+	},
+}
+
+var deleteWebhookCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a webhook",
+	Long:  "Delete a webhook",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client := setupWebhookClient()
+
+		err := client.DeleteWebhook(WebhookID, ColonyPrvKey)
+		CheckError(err)
+
+		log.WithFields(log.Fields{"WebhookId": WebhookID}).Info("Webhook deleted")
+	},
+}
+
+var listWebhookDeliveriesCmd = &cobra.Command{
+	Use:   "deliveries",
+	Short: "List the delivery log of a webhook, newest first",
+	Long:  "List the delivery log of a webhook, newest first",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client := setupWebhookClient()
+
+		deliveries, err := client.GetWebhookDeliveries(WebhookID, Count, ColonyPrvKey)
+		CheckError(err)
+
+		if len(deliveries) == 0 {
+			log.WithFields(log.Fields{"WebhookId": WebhookID}).Info("No deliveries found")
+			os.Exit(0)
+		}
+
+		if JSON {
+			jsonString, err := core.ConvertWebhookDeliveryArrayToJSON(deliveries)
+			CheckError(err)
+			fmt.Println(jsonString)
+			os.Exit(0)
+		}
+
+		var data [][]string
+		for _, delivery := range deliveries {
+			data = append(data, []string{delivery.Time.Format(TimeLayout), delivery.Kind + "." + delivery.Type, delivery.EventID, strconv.Itoa(delivery.Attempts), strconv.Itoa(delivery.StatusCode), strconv.FormatBool(delivery.Success), delivery.Error})
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Time", "Event", "EventId", "Attempts", "Status", "Success", "Error"})
+		for _, v := range data {
+			table.Append(v)
+		}
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		table.Render()
+	},
+}
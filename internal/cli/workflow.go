@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/colonyos/colonies/pkg/client"
 	"github.com/colonyos/colonies/pkg/core"
@@ -19,6 +20,7 @@ import (
 
 func init() {
 	workflowCmd.AddCommand(submitWorkflowCmd)
+	workflowCmd.AddCommand(validateWorkflowCmd)
 	workflowCmd.AddCommand(listWaitingWorkflowsCmd)
 	workflowCmd.AddCommand(listRunningWorkflowsCmd)
 	workflowCmd.AddCommand(listSuccessfulWorkflowsCmd)
@@ -26,6 +28,7 @@ func init() {
 	workflowCmd.AddCommand(getWorkflowCmd)
 	workflowCmd.AddCommand(deleteWorkflowCmd)
 	workflowCmd.AddCommand(deleteAllWorkflowsCmd)
+	workflowCmd.AddCommand(retryWorkflowCmd)
 	rootCmd.AddCommand(workflowCmd)
 
 	submitWorkflowCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
@@ -33,7 +36,21 @@ func init() {
 	submitWorkflowCmd.Flags().StringVarP(&SpecFile, "spec", "", "", "JSON specification of a Colony workflow")
 	submitWorkflowCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
 	submitWorkflowCmd.Flags().BoolVarP(&Wait, "wait", "", false, "Colony Id")
-	submitWorkflowCmd.MarkFlagRequired("spec")
+	submitWorkflowCmd.Flags().StringVarP(&TemplateName, "template", "", "", "Name of a workflow template to submit instead of a JSON specification")
+	submitWorkflowCmd.Flags().IntVarP(&TemplateVersion, "version", "", 0, "Workflow template version, 0 means the latest version")
+	submitWorkflowCmd.Flags().StringArrayVarP(&TemplateParams, "param", "", make([]string, 0), "Workflow template parameter, e.g. --param key1=value1 --param key2=value2")
+	submitWorkflowCmd.Flags().IntVarP(&WorkflowDeadline, "deadline", "", 0, "Max time in seconds the workflow may run before all remaining processes fail, 0 means no deadline")
+	submitWorkflowCmd.Flags().StringVarP(&FailurePolicy, "failurepolicy", "", "", "Failure policy, failfast cancels all remaining processes when a process fails, continue runs independent branches to completion")
+
+	validateWorkflowCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	validateWorkflowCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	validateWorkflowCmd.Flags().StringVarP(&SpecFile, "spec", "", "", "JSON specification of a Colony workflow")
+	validateWorkflowCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
+	validateWorkflowCmd.Flags().StringVarP(&TemplateName, "template", "", "", "Name of a workflow template to validate instead of a JSON specification")
+	validateWorkflowCmd.Flags().IntVarP(&TemplateVersion, "version", "", 0, "Workflow template version, 0 means the latest version")
+	validateWorkflowCmd.Flags().StringArrayVarP(&TemplateParams, "param", "", make([]string, 0), "Workflow template parameter, e.g. --param key1=value1 --param key2=value2")
+	validateWorkflowCmd.Flags().IntVarP(&WorkflowDeadline, "deadline", "", 0, "Max time in seconds the workflow may run before all remaining processes fail, 0 means no deadline")
+	validateWorkflowCmd.Flags().StringVarP(&FailurePolicy, "failurepolicy", "", "", "Failure policy, failfast cancels all remaining processes when a process fails, continue runs independent branches to completion")
 
 	listWaitingWorkflowsCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
 	listWaitingWorkflowsCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
@@ -74,6 +91,12 @@ func init() {
 	getWorkflowCmd.Flags().StringVarP(&WorkflowID, "workflowid", "", "", "Workflow Id")
 	getWorkflowCmd.MarkFlagRequired("workflowid")
 	getWorkflowCmd.Flags().BoolVarP(&JSON, "json", "", false, "Print JSON instead of tables")
+	getWorkflowCmd.Flags().StringVarP(&Format, "format", "", "", "Print the workflow as a graph instead of tables, dot (Graphviz) or mermaid")
+
+	retryWorkflowCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	retryWorkflowCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	retryWorkflowCmd.Flags().StringVarP(&WorkflowID, "workflowid", "", "", "Workflow Id")
+	retryWorkflowCmd.MarkFlagRequired("workflowid")
 }
 
 var workflowCmd = &cobra.Command{
@@ -89,11 +112,7 @@ var submitWorkflowCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		parseServerEnv()
 
-		jsonSpecBytes, err := ioutil.ReadFile(SpecFile)
-		CheckError(err)
-
-		jsonStr := "{\"functionspecs\":" + string(jsonSpecBytes) + "}"
-		workflowSpec, err := core.ConvertJSONToWorkflowSpec(jsonStr)
+		workflowSpec, err := readWorkflowSpec()
 		CheckError(err)
 
 		if workflowSpec.ColonyID == "" {
@@ -132,6 +151,116 @@ var submitWorkflowCmd = &cobra.Command{
 	},
 }
 
+var validateWorkflowCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate a workflow without submitting it",
+	Long:  "Validate a workflow without submitting it, all problems found in the workflow are listed",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		workflowSpec, err := readWorkflowSpec()
+		CheckError(err)
+
+		if workflowSpec.ColonyID == "" {
+			if ColonyID == "" {
+				ColonyID = os.Getenv("COLONIES_COLONY_ID")
+			}
+			if ColonyID == "" {
+				CheckError(errors.New("Unknown Colony Id, please set COLONYID env variable or specify ColonyID in JSON file"))
+			}
+
+			workflowSpec.ColonyID = ColonyID
+		}
+
+		keychain, err := security.CreateKeychain(KEYCHAIN_PATH)
+		CheckError(err)
+
+		if ExecutorID == "" {
+			ExecutorID = os.Getenv("COLONIES_EXECUTOR_ID")
+		}
+		if ExecutorID == "" {
+			CheckError(errors.New("Unknown Executor Id"))
+		}
+
+		if ExecutorPrvKey == "" {
+			ExecutorPrvKey, err = keychain.GetPrvKey(ExecutorID)
+			CheckError(err)
+		}
+
+		log.WithFields(log.Fields{"ServerHost": ServerHost, "ServerPort": ServerPort, "Insecure": Insecure}).Info("Starting a Colonies client")
+		client := client.CreateColoniesClient(ServerHost, ServerPort, Insecure, SkipTLSVerify)
+
+		validation, err := client.ValidateWorkflowSpec(workflowSpec, ExecutorPrvKey)
+		CheckError(err)
+
+		if validation.Valid() {
+			log.Info("Workflow is valid")
+			return
+		}
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Problem"})
+		for _, problem := range validation.Problems {
+			table.Append([]string{problem})
+		}
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		table.Render()
+
+		CheckError(errors.New("Workflow is invalid, found " + strconv.Itoa(len(validation.Problems)) + " problem(s)"))
+	},
+}
+
+// readWorkflowSpec reads a workflow spec from the --spec file, or creates a workflow spec referencing a
+// stored workflow template if --template is specified
+func readWorkflowSpec() (*core.WorkflowSpec, error) {
+	var workflowSpec *core.WorkflowSpec
+	if TemplateName != "" {
+		params, err := parseTemplateParams(TemplateParams)
+		if err != nil {
+			return nil, err
+		}
+		workflowSpec = core.CreateWorkflowSpecFromTemplate("", TemplateName, TemplateVersion, params)
+	} else {
+		if SpecFile == "" {
+			return nil, errors.New("Either a JSON specification (--spec) or a workflow template (--template) must be specified")
+		}
+
+		var err error
+		workflowSpec, err = readWorkflowSpecFile(SpecFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	workflowSpec.Deadline = WorkflowDeadline
+	workflowSpec.FailurePolicy = FailurePolicy
+
+	return workflowSpec, nil
+}
+
+func readWorkflowSpecFile(specFile string) (*core.WorkflowSpec, error) {
+	jsonSpecBytes, err := ioutil.ReadFile(specFile)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonStr := "{\"functionspecs\":" + string(jsonSpecBytes) + "}"
+	return core.ConvertJSONToWorkflowSpec(jsonStr)
+}
+
+func parseTemplateParams(keyValues []string) (map[string]string, error) {
+	params := make(map[string]string)
+	for _, keyValue := range keyValues {
+		s := strings.SplitN(keyValue, "=", 2)
+		if len(s) != 2 {
+			return nil, errors.New("Invalid parameter <" + keyValue + ">, try e.g. --param key1=value1")
+		}
+		params[s[0]] = s[1]
+	}
+
+	return params, nil
+}
+
 var listWaitingWorkflowsCmd = &cobra.Command{
 	Use:   "psw",
 	Short: "List all waiting workflows",
@@ -487,6 +616,33 @@ var listFailedWorkflowsCmd = &cobra.Command{
 	},
 }
 
+// printGraphFormat prints the workflow in Graphviz DOT or Mermaid format
+func printGraphFormat(client *client.ColoniesClient, graph *core.ProcessGraph, format string) {
+	if format != "dot" && format != "mermaid" {
+		CheckError(errors.New("Invalid format <" + format + ">, must be dot or mermaid"))
+	}
+
+	var processes []*core.Process
+	for _, processID := range graph.ProcessIDs {
+		process, err := client.GetProcess(processID, ExecutorPrvKey)
+		CheckError(err)
+		processes = append(processes, process)
+	}
+
+	executors, err := client.GetExecutors(graph.ColonyID, ExecutorPrvKey)
+	CheckError(err)
+	executorNames := make(map[string]string)
+	for _, executor := range executors {
+		executorNames[executor.ID] = executor.Name
+	}
+
+	if format == "dot" {
+		fmt.Print(graph.ToDOT(processes, executorNames))
+	} else {
+		fmt.Print(graph.ToMermaid(processes, executorNames))
+	}
+}
+
 func printGraf(client *client.ColoniesClient, graph *core.ProcessGraph) {
 	fmt.Println("Workflow:")
 	workflowData := [][]string{
@@ -497,6 +653,15 @@ func printGraf(client *client.ColoniesClient, graph *core.ProcessGraph) {
 		[]string{"StartTime", graph.StartTime.Format(TimeLayout)},
 		[]string{"EndTime", graph.EndTime.Format(TimeLayout)},
 	}
+	if graph.ParentProcessID != "" {
+		workflowData = append(workflowData, []string{"ParentProcessID", graph.ParentProcessID})
+	}
+	if graph.FailurePolicy != "" {
+		workflowData = append(workflowData, []string{"FailurePolicy", graph.FailurePolicy})
+	}
+	if !graph.Deadline.IsZero() {
+		workflowData = append(workflowData, []string{"Deadline", graph.Deadline.Format(TimeLayout)})
+	}
 	workflowTable := tablewriter.NewWriter(os.Stdout)
 	for _, v := range workflowData {
 		workflowTable.Append(v)
@@ -589,6 +754,43 @@ var getWorkflowCmd = &cobra.Command{
 			os.Exit(0)
 		}
 
+		if Format != "" {
+			printGraphFormat(client, graph, Format)
+			os.Exit(0)
+		}
+
 		printGraf(client, graph)
 	},
 }
+
+var retryWorkflowCmd = &cobra.Command{
+	Use:   "retry",
+	Short: "Retry a failed workflow",
+	Long:  "Retry a failed workflow, failed processes are re-run while successful processes and their outputs are kept",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		keychain, err := security.CreateKeychain(KEYCHAIN_PATH)
+		CheckError(err)
+
+		if ExecutorID == "" {
+			ExecutorID = os.Getenv("COLONIES_EXECUTOR_ID")
+		}
+		if ExecutorID == "" {
+			CheckError(errors.New("Unknown Executor Id"))
+		}
+
+		if ExecutorPrvKey == "" {
+			ExecutorPrvKey, err = keychain.GetPrvKey(ExecutorID)
+			CheckError(err)
+		}
+
+		log.WithFields(log.Fields{"ServerHost": ServerHost, "ServerPort": ServerPort, "Insecure": Insecure}).Info("Starting a Colonies client")
+		client := client.CreateColoniesClient(ServerHost, ServerPort, Insecure, SkipTLSVerify)
+
+		graph, err := client.RetryProcessGraph(WorkflowID, ExecutorPrvKey)
+		CheckError(err)
+
+		log.WithFields(log.Fields{"WorkflowID": graph.ID, "State": State2String(graph.State)}).Info("Workflow retried")
+	},
+}
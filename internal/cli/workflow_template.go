@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/colonyos/colonies/pkg/client"
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/security"
+	"github.com/kataras/tablewriter"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	workflowTemplateCmd.AddCommand(addWorkflowTemplateCmd)
+	workflowTemplateCmd.AddCommand(listWorkflowTemplatesCmd)
+	workflowTemplateCmd.AddCommand(getWorkflowTemplateCmd)
+	workflowTemplateCmd.AddCommand(removeWorkflowTemplateCmd)
+	workflowCmd.AddCommand(workflowTemplateCmd)
+
+	addWorkflowTemplateCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	addWorkflowTemplateCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	addWorkflowTemplateCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
+	addWorkflowTemplateCmd.Flags().StringVarP(&TemplateName, "name", "", "", "Workflow template name")
+	addWorkflowTemplateCmd.MarkFlagRequired("name")
+	addWorkflowTemplateCmd.Flags().StringVarP(&SpecFile, "spec", "", "", "JSON specification of a Colony workflow, ${param} in args and env are substituted")
+	addWorkflowTemplateCmd.MarkFlagRequired("spec")
+	addWorkflowTemplateCmd.Flags().StringArrayVarP(&TemplateParams, "param", "", make([]string, 0), "Parameter, e.g. --param dataset for a required parameter or --param epochs=10 for a parameter with a default value")
+
+	listWorkflowTemplatesCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	listWorkflowTemplatesCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	listWorkflowTemplatesCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
+
+	getWorkflowTemplateCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	getWorkflowTemplateCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	getWorkflowTemplateCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
+	getWorkflowTemplateCmd.Flags().StringVarP(&TemplateName, "name", "", "", "Workflow template name")
+	getWorkflowTemplateCmd.MarkFlagRequired("name")
+	getWorkflowTemplateCmd.Flags().IntVarP(&TemplateVersion, "version", "", 0, "Workflow template version, 0 means the latest version")
+	getWorkflowTemplateCmd.Flags().BoolVarP(&JSON, "json", "", false, "Print JSON instead of tables")
+
+	removeWorkflowTemplateCmd.Flags().StringVarP(&ExecutorID, "executorid", "", "", "Executor Id")
+	removeWorkflowTemplateCmd.Flags().StringVarP(&ExecutorPrvKey, "executorprvkey", "", "", "Executor private key")
+	removeWorkflowTemplateCmd.Flags().StringVarP(&ColonyID, "colonyid", "", "", "Colony Id")
+	removeWorkflowTemplateCmd.Flags().StringVarP(&TemplateName, "name", "", "", "Workflow template name")
+	removeWorkflowTemplateCmd.MarkFlagRequired("name")
+	removeWorkflowTemplateCmd.Flags().IntVarP(&TemplateVersion, "version", "", 0, "Workflow template version, 0 means all versions")
+}
+
+var workflowTemplateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "Manage workflow templates",
+	Long:  "Manage workflow templates",
+}
+
+func setupWorkflowTemplateClient() *client.ColoniesClient {
+	keychain, err := security.CreateKeychain(KEYCHAIN_PATH)
+	CheckError(err)
+
+	if ColonyID == "" {
+		ColonyID = os.Getenv("COLONIES_COLONY_ID")
+	}
+	if ColonyID == "" {
+		CheckError(errors.New("Unknown Colony Id"))
+	}
+
+	if ExecutorID == "" {
+		ExecutorID = os.Getenv("COLONIES_EXECUTOR_ID")
+	}
+	if ExecutorID == "" {
+		CheckError(errors.New("Unknown Executor Id"))
+	}
+
+	if ExecutorPrvKey == "" {
+		ExecutorPrvKey, err = keychain.GetPrvKey(ExecutorID)
+		CheckError(err)
+	}
+
+	log.WithFields(log.Fields{"ServerHost": ServerHost, "ServerPort": ServerPort, "Insecure": Insecure}).Info("Starting a Colonies client")
+	return client.CreateColoniesClient(ServerHost, ServerPort, Insecure, SkipTLSVerify)
+}
+
+var addWorkflowTemplateCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add a workflow template, adding a template with an existing name creates a new version",
+	Long:  "Add a workflow template, adding a template with an existing name creates a new version",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client := setupWorkflowTemplateClient()
+
+		var parameters []core.TemplateParameter
+		for _, param := range TemplateParams {
+			s := strings.SplitN(param, "=", 2)
+			if len(s) == 2 {
+				parameters = append(parameters, core.TemplateParameter{Name: s[0], Default: s[1]})
+			} else {
+				parameters = append(parameters, core.TemplateParameter{Name: s[0], Required: true})
+			}
+		}
+
+		workflowSpec, err := readWorkflowSpecFile(SpecFile)
+		CheckError(err)
+		workflowSpec.ColonyID = ColonyID
+
+		template := core.CreateWorkflowTemplate(ColonyID, TemplateName, parameters, workflowSpec)
+		addedTemplate, err := client.AddWorkflowTemplate(template, ExecutorPrvKey)
+		CheckError(err)
+
+		log.WithFields(log.Fields{"Name": addedTemplate.Name, "Version": addedTemplate.Version}).Info("Workflow template added")
+	},
+}
+
+var listWorkflowTemplatesCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "List all workflow templates",
+	Long:  "List all workflow templates",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client := setupWorkflowTemplateClient()
+
+		templates, err := client.GetWorkflowTemplates(ColonyID, ExecutorPrvKey)
+		CheckError(err)
+
+		if len(templates) == 0 {
+			log.WithFields(log.Fields{"ColonyId": ColonyID}).Info("No workflow templates found")
+			os.Exit(0)
+		}
+
+		var data [][]string
+		for _, template := range templates {
+			var paramNames []string
+			for _, parameter := range template.Parameters {
+				paramNames = append(paramNames, parameter.Name)
+			}
+			data = append(data, []string{template.Name, strconv.Itoa(template.Version), StrArr2StrWithCommas(paramNames), template.Added.Format(TimeLayout)})
+		}
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Name", "Version", "Parameters", "Added"})
+		for _, v := range data {
+			table.Append(v)
+		}
+		table.SetAlignment(tablewriter.ALIGN_LEFT)
+		table.Render()
+	},
+}
+
+var getWorkflowTemplateCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get info about a workflow template",
+	Long:  "Get info about a workflow template",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client := setupWorkflowTemplateClient()
+
+		template, err := client.GetWorkflowTemplate(ColonyID, TemplateName, TemplateVersion, ExecutorPrvKey)
+		CheckError(err)
+
+		if JSON {
+			jsonString, err := template.ToJSON()
+			CheckError(err)
+			fmt.Println(jsonString)
+			os.Exit(0)
+		}
+
+		fmt.Println("Workflow template:")
+		templateData := [][]string{
+			[]string{"Id", template.ID},
+			[]string{"ColonyID", template.ColonyID},
+			[]string{"Name", template.Name},
+			[]string{"Version", strconv.Itoa(template.Version)},
+			[]string{"Added", template.Added.Format(TimeLayout)},
+		}
+		templateTable := tablewriter.NewWriter(os.Stdout)
+		for _, v := range templateData {
+			templateTable.Append(v)
+		}
+		templateTable.SetAlignment(tablewriter.ALIGN_LEFT)
+		templateTable.SetAutoWrapText(false)
+		templateTable.Render()
+
+		if len(template.Parameters) > 0 {
+			fmt.Println()
+			fmt.Println("Parameters:")
+			var data [][]string
+			for _, parameter := range template.Parameters {
+				data = append(data, []string{parameter.Name, strconv.FormatBool(parameter.Required), parameter.Default, parameter.Description})
+			}
+			paramTable := tablewriter.NewWriter(os.Stdout)
+			paramTable.SetHeader([]string{"Name", "Required", "Default", "Description"})
+			for _, v := range data {
+				paramTable.Append(v)
+			}
+			paramTable.SetAlignment(tablewriter.ALIGN_LEFT)
+			paramTable.Render()
+		}
+
+		if template.Workflow != nil {
+			for i, funcSpec := range template.Workflow.FunctionSpecs {
+				fmt.Println()
+				fmt.Println("FunctionSpec " + strconv.Itoa(i) + ":")
+				printFunctionSpec(&funcSpec)
+			}
+		}
+	},
+}
+
+var removeWorkflowTemplateCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Remove a workflow template",
+	Long:  "Remove a workflow template, all versions are removed unless a version is specified",
+	Run: func(cmd *cobra.Command, args []string) {
+		parseServerEnv()
+
+		client := setupWorkflowTemplateClient()
+
+		err := client.DeleteWorkflowTemplate(ColonyID, TemplateName, TemplateVersion, ExecutorPrvKey)
+		CheckError(err)
+
+		log.WithFields(log.Fields{"Name": TemplateName, "Version": TemplateVersion}).Info("Workflow template removed")
+	},
+}
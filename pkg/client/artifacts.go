@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
+	"github.com/colonyos/colonies/pkg/storage"
+)
+
+func (client *ColoniesClient) artifactURL() string {
+	protocol := "https"
+	if client.insecure {
+		protocol = "http"
+	}
+
+	return protocol + "://" + client.host + ":" + strconv.Itoa(client.port) + "/artifact"
+}
+
+func createArtifactMsgHeader(payloadType string, jsonString string, prvKey string) (string, error) {
+	rpcMsg, err := rpc.CreateRPCMsg(payloadType, jsonString, prvKey)
+	if err != nil {
+		return "", err
+	}
+
+	return rpcMsg.ToJSON()
+}
+
+// AddArtifact uploads the content read from reader, the content is read twice, first to calculate the hash which
+// is signed together with the other meta data, and then to upload it
+func (client *ColoniesClient) AddArtifact(colonyID string, name string, reader io.ReadSeeker, archive bool, prvKey string) (*core.Artifact, error) {
+	return client.AddArtifactWithContext(colonyID, name, reader, archive, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) AddArtifactWithContext(colonyID string, name string, reader io.ReadSeeker, archive bool, ctx context.Context, prvKey string) (*core.Artifact, error) {
+	hash, size, err := storage.Hash(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = reader.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := rpc.CreateAddArtifactMsg(colonyID, name, hash, size, archive)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := createArtifactMsgHeader(rpc.AddArtifactPayloadType, jsonString, prvKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.restyClient.R().
+		SetContext(ctx).
+		SetHeader(rpc.ArtifactMsgHeader, header).
+		SetHeader("Content-Type", "application/octet-stream").
+		SetBody(reader).
+		Post(client.artifactURL())
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := parseRPCReply(string(resp.Body()))
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToArtifact(respBodyString)
+}
+
+// DownloadArtifact writes the content of an artifact to writer, an error is returned if the content does not match
+// the hash of the artifact. The content is streamed, so anything written to writer must be discarded if an error is
+// returned.
+func (client *ColoniesClient) DownloadArtifact(artifactID string, writer io.Writer, prvKey string) (*core.Artifact, error) {
+	return client.DownloadArtifactWithContext(artifactID, writer, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DownloadArtifactWithContext(artifactID string, writer io.Writer, ctx context.Context, prvKey string) (*core.Artifact, error) {
+	artifact, err := client.GetArtifactWithContext(artifactID, ctx, prvKey)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := rpc.CreateDownloadArtifactMsg(artifactID)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := createArtifactMsgHeader(rpc.DownloadArtifactPayloadType, jsonString, prvKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.restyClient.R().
+		SetContext(ctx).
+		SetHeader(rpc.ArtifactMsgHeader, header).
+		SetDoNotParseResponse(true).
+		Get(client.artifactURL())
+	if err != nil {
+		return nil, err
+	}
+	body := resp.RawBody()
+	defer body.Close()
+
+	if resp.IsError() {
+		respBody, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		_, err = parseRPCReply(string(respBody))
+		return nil, err
+	}
+
+	hasher := sha256.New()
+	_, err = io.Copy(io.MultiWriter(writer, hasher), body)
+	if err != nil {
+		return nil, err
+	}
+
+	if hex.EncodeToString(hasher.Sum(nil)) != artifact.Hash {
+		return nil, errors.New("Failed to download artifact, content does not match the hash of the artifact")
+	}
+
+	return artifact, nil
+}
+
+func (client *ColoniesClient) GetArtifact(artifactID string, prvKey string) (*core.Artifact, error) {
+	return client.GetArtifactWithContext(artifactID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetArtifactWithContext(artifactID string, ctx context.Context, prvKey string) (*core.Artifact, error) {
+	msg := rpc.CreateGetArtifactMsg(artifactID)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.GetArtifactPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToArtifact(respBodyString)
+}
+
+func (client *ColoniesClient) GetArtifacts(colonyID string, count int, prvKey string) ([]*core.Artifact, error) {
+	return client.GetArtifactsWithContext(colonyID, count, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetArtifactsWithContext(colonyID string, count int, ctx context.Context, prvKey string) ([]*core.Artifact, error) {
+	msg := rpc.CreateGetArtifactsMsg(colonyID, count)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.GetArtifactsPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToArtifactArray(respBodyString)
+}
+
+func (client *ColoniesClient) DeleteArtifact(artifactID string, prvKey string) error {
+	return client.DeleteArtifactWithContext(artifactID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DeleteArtifactWithContext(artifactID string, ctx context.Context, prvKey string) error {
+	msg := rpc.CreateDeleteArtifactMsg(artifactID)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.sendMessage(rpc.DeleteArtifactPayloadType, jsonString, prvKey, false, ctx)
+	return err
+}
@@ -0,0 +1,30 @@
+package clienttest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+// HandlerTransport sends RPC messages directly to a http.Handler, e.g. a Colonies server running in the same
+// process, without opening any network connections. It is intended for tests, see client.Transport
+type HandlerTransport struct {
+	handler http.Handler
+}
+
+func CreateHandlerTransport(handler http.Handler) *HandlerTransport {
+	return &HandlerTransport{handler: handler}
+}
+
+func (transport *HandlerTransport) Send(ctx context.Context, jsonString string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/api", strings.NewReader(jsonString))
+	if err != nil {
+		return "", err
+	}
+
+	recorder := httptest.NewRecorder()
+	transport.handler.ServeHTTP(recorder, req)
+
+	return recorder.Body.String(), nil
+}
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"net/url"
 	"strconv"
+	"sync/atomic"
+	"time"
 
 	"github.com/colonyos/colonies/pkg/cluster"
 	"github.com/colonyos/colonies/pkg/core"
@@ -16,10 +18,52 @@ import (
 
 type ColoniesClient struct {
 	restyClient   *resty.Client
+	transport     Transport
 	host          string
 	port          int
 	insecure      bool
 	skipTLSVerify bool
+	timeout       time.Duration
+	maxRetries    int
+	retryBackoff  time.Duration
+}
+
+// idempotentPayloadTypes are the payload types that do not change the state of the server, and thus are safe to
+// send again if the server could not be reached
+var idempotentPayloadTypes = map[string]bool{
+	rpc.GetColoniesPayloadType:          true,
+	rpc.GetColonyPayloadType:            true,
+	rpc.GetExecutorsPayloadType:         true,
+	rpc.GetExecutorPayloadType:          true,
+	rpc.GetProcessHistPayloadType:       true,
+	rpc.GetProcessesPayloadType:         true,
+	rpc.GetProcessPayloadType:           true,
+	rpc.GetProcessEventsPayloadType:     true,
+	rpc.GetLogsPayloadType:              true,
+	rpc.GetColonyStatisticsPayloadType:  true,
+	rpc.GetStatisiticsPayloadType:       true,
+	rpc.GetAttributePayloadType:         true,
+	rpc.ValidateWorkflowSpecPayloadType: true,
+	rpc.GetProcessGraphPayloadType:      true,
+	rpc.GetProcessGraphsPayloadType:     true,
+	rpc.GetGeneratorPayloadType:         true,
+	rpc.ResolveGeneratorPayloadType:     true,
+	rpc.GetGeneratorsPayloadType:        true,
+	rpc.GetCronPayloadType:              true,
+	rpc.GetCronsPayloadType:             true,
+	rpc.GetRunHistoryPayloadType:        true,
+	rpc.GetAuditLogPayloadType:          true,
+	rpc.GetWorkflowTemplatePayloadType:  true,
+	rpc.GetWorkflowTemplatesPayloadType: true,
+	rpc.GetFunctionsPayloadType:         true,
+	rpc.GetArtifactPayloadType:          true,
+	rpc.GetArtifactsPayloadType:         true,
+	rpc.GetWebhooksPayloadType:          true,
+	rpc.GetWebhookDeliveriesPayloadType: true,
+	rpc.GetSecretsPayloadType:           true,
+	rpc.GetColonyAdminsPayloadType:      true,
+	rpc.GetClusterPayloadType:           true,
+	rpc.VersionPayloadType:              true,
 }
 
 func CreateColoniesClient(host string, port int, insecure bool, skipTLSVerify bool) *ColoniesClient {
@@ -30,27 +74,67 @@ func CreateColoniesClient(host string, port int, insecure bool, skipTLSVerify bo
 	client.port = port
 	client.insecure = insecure
 	client.skipTLSVerify = skipTLSVerify
+	client.maxRetries = DEFAULT_MAX_RETRIES
+	client.retryBackoff = DEFAULT_RETRY_BACKOFF
 
 	if skipTLSVerify {
 		client.restyClient.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
 	}
 
+	client.transport = createHTTPTransport(client.restyClient, client.apiURL())
+
 	return client
 }
 
-func (client *ColoniesClient) SendRawMessage(jsonString string, insecure bool) (string, error) {
+// CreateColoniesClientWithTransport creates a client that sends RPC messages using the given transport, e.g. to
+// call an in-process server in tests. Artifacts, subscriptions and health checks still require a HTTP server.
+func CreateColoniesClientWithTransport(transport Transport) *ColoniesClient {
+	client := &ColoniesClient{}
+	client.restyClient = resty.New()
+	client.transport = transport
+	client.maxRetries = DEFAULT_MAX_RETRIES
+	client.retryBackoff = DEFAULT_RETRY_BACKOFF
+
+	return client
+}
+
+// SetTimeout sets the max time a call may take, calls with a context that has a deadline are not affected. Calls
+// where the server may wait, e.g. Assign, get their timeout added. Zero means no timeout, which is the default.
+func (client *ColoniesClient) SetTimeout(timeout time.Duration) {
+	client.timeout = timeout
+}
+
+// SetRetries sets how many times calls that do not change the state of the server are retried if the server
+// cannot be reached, the backoff is doubled after every retry
+func (client *ColoniesClient) SetRetries(maxRetries int, backoff time.Duration) {
+	client.maxRetries = maxRetries
+	client.retryBackoff = backoff
+}
+
+func (client *ColoniesClient) apiURL() string {
 	protocol := "https"
 	if client.insecure {
 		protocol = "http"
 	}
-	resp, err := client.restyClient.R().
-		SetBody(jsonString).
-		Post(protocol + "://" + client.host + ":" + strconv.Itoa(client.port) + "/api")
-	if err != nil {
-		return "", err
+
+	return protocol + "://" + client.host + ":" + strconv.Itoa(client.port) + "/api"
+}
+
+func (client *ColoniesClient) SendRawMessage(jsonString string, insecure bool) (string, error) {
+	return client.transport.Send(context.Background(), jsonString)
+}
+
+// contextWithTimeout applies the client timeout to calls where the context has no deadline, extraTimeout is added
+// for calls where the server waits, e.g. for a process to be assigned
+func (client *ColoniesClient) contextWithTimeout(ctx context.Context, extraTimeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || client.timeout == 0 {
+		return ctx, func() {}
+	}
+	if extraTimeout < 0 {
+		extraTimeout = 0
 	}
 
-	return string(resp.Body()), nil
+	return context.WithTimeout(ctx, client.timeout+extraTimeout)
 }
 
 func (client *ColoniesClient) sendMessage(method string, jsonString string, prvKey string, insecure bool, ctx context.Context) (string, error) {
@@ -72,20 +156,46 @@ func (client *ColoniesClient) sendMessage(method string, jsonString string, prvK
 		return "", err
 	}
 
-	protocol := "https"
-	if client.insecure {
-		protocol = "http"
+	ctx, cancel := client.contextWithTimeout(ctx, 0)
+	defer cancel()
+
+	maxRetries := 0
+	if idempotentPayloadTypes[method] {
+		maxRetries = client.maxRetries
 	}
-	resp, err := client.restyClient.R().
-		SetContext(ctx).
-		SetBody(jsonString).
-		Post(protocol + "://" + client.host + ":" + strconv.Itoa(client.port) + "/api")
-	if err != nil {
-		return "", err
+
+	backoff := client.retryBackoff
+	for retries := 0; ; retries++ {
+		respBodyString, err := client.transport.Send(ctx, jsonString)
+		if err == nil {
+			return parseRPCReply(respBodyString)
+		}
+
+		if retries >= maxRetries || ctx.Err() != nil || !isConnectionError(err) {
+			return "", err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return "", err
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		if backoff > MAX_RETRY_BACKOFF {
+			backoff = MAX_RETRY_BACKOFF
+		}
 	}
+}
 
-	respBodyString := string(resp.Body())
+func isConnectionError(err error) bool {
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
 
+func parseRPCReply(respBodyString string) (string, error) {
 	rpcReplyMsg, err := rpc.CreateRPCReplyMsgFromJSON(respBodyString)
 	if err != nil {
 		return "", errors.New("Expected a valid Colonies RPC message, but got this: " + respBodyString)
@@ -97,13 +207,13 @@ func (client *ColoniesClient) sendMessage(method string, jsonString string, prvK
 			return "", err
 		}
 
-		return "", &core.ColoniesError{Status: failure.Status, Message: failure.Message}
+		return "", failure.ToError()
 	}
 
 	return rpcReplyMsg.DecodePayload(), nil
 }
 
-func (client *ColoniesClient) establishWebSocketConn(jsonString string) (*websocket.Conn, error) {
+func (client *ColoniesClient) establishWebSocketConn(jsonString string, ctx context.Context) (*websocket.Conn, error) {
 	dialer := *websocket.DefaultDialer
 	var u url.URL
 
@@ -116,7 +226,7 @@ func (client *ColoniesClient) establishWebSocketConn(jsonString string) (*websoc
 		}
 	}
 
-	wsConn, _, err := dialer.Dial(u.String(), nil)
+	wsConn, _, err := dialer.DialContext(ctx, u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +240,10 @@ func (client *ColoniesClient) establishWebSocketConn(jsonString string) (*websoc
 }
 
 func (client *ColoniesClient) SubscribeProcesses(executorType string, state int, timeout int, prvKey string) (*ProcessSubscription, error) {
+	return client.SubscribeProcessesWithContext(executorType, state, timeout, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) SubscribeProcessesWithContext(executorType string, state int, timeout int, ctx context.Context, prvKey string) (*ProcessSubscription, error) {
 	msg := rpc.CreateSubscribeProcessesMsg(executorType, state, timeout)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
@@ -146,7 +260,7 @@ func (client *ColoniesClient) SubscribeProcesses(executorType string, state int,
 		return nil, err
 	}
 
-	wsConn, err := client.establishWebSocketConn(jsonString)
+	wsConn, err := client.establishWebSocketConn(jsonString, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -156,8 +270,8 @@ func (client *ColoniesClient) SubscribeProcesses(executorType string, state int,
 		for {
 			_, jsonBytes, err := subscription.wsConn.ReadMessage()
 			if err != nil {
-				subscription.ErrChan <- err
-				continue
+				closeWithError(subscription.ErrChan, err) // The connection is closed, e.g. the subscription timed out
+				return
 			}
 
 			rpcReplyMsg, err := rpc.CreateRPCReplyMsgFromJSON(string(jsonBytes))
@@ -170,8 +284,10 @@ func (client *ColoniesClient) SubscribeProcesses(executorType string, state int,
 				failureMsg, err := core.ConvertJSONToFailure(rpcReplyMsg.DecodePayload())
 				if err != nil {
 					subscription.ErrChan <- err
+					continue
 				}
-				subscription.ErrChan <- errors.New(failureMsg.Message)
+				subscription.ErrChan <- failureMsg.ToError()
+				continue
 			}
 
 			process, err := core.ConvertJSONToProcess(rpcReplyMsg.DecodePayload())
@@ -188,6 +304,10 @@ func (client *ColoniesClient) SubscribeProcesses(executorType string, state int,
 }
 
 func (client *ColoniesClient) SubscribeProcess(processID string, executorType string, state int, timeout int, prvKey string) (*ProcessSubscription, error) {
+	return client.SubscribeProcessWithContext(processID, executorType, state, timeout, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) SubscribeProcessWithContext(processID string, executorType string, state int, timeout int, ctx context.Context, prvKey string) (*ProcessSubscription, error) {
 	msg := rpc.CreateSubscribeProcessMsg(processID, executorType, state, timeout)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
@@ -204,7 +324,7 @@ func (client *ColoniesClient) SubscribeProcess(processID string, executorType st
 		return nil, err
 	}
 
-	wsConn, err := client.establishWebSocketConn(jsonString)
+	wsConn, err := client.establishWebSocketConn(jsonString, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -214,8 +334,8 @@ func (client *ColoniesClient) SubscribeProcess(processID string, executorType st
 		for {
 			_, jsonBytes, err := subscription.wsConn.ReadMessage()
 			if err != nil {
-				subscription.ErrChan <- err
-				continue
+				closeWithError(subscription.ErrChan, err) // The connection is closed, e.g. the subscription timed out
+				return
 			}
 			rpcReplyMsg, err := rpc.CreateRPCReplyMsgFromJSON(string(jsonBytes))
 			if err != nil {
@@ -227,8 +347,10 @@ func (client *ColoniesClient) SubscribeProcess(processID string, executorType st
 				failureMsg, err := core.ConvertJSONToFailure(rpcReplyMsg.DecodePayload())
 				if err != nil {
 					subscription.ErrChan <- err
+					continue
 				}
-				subscription.ErrChan <- errors.New(failureMsg.Message)
+				subscription.ErrChan <- failureMsg.ToError()
+				continue
 			}
 
 			process, err := core.ConvertJSONToProcess(rpcReplyMsg.DecodePayload())
@@ -243,14 +365,96 @@ func (client *ColoniesClient) SubscribeProcess(processID string, executorType st
 	return subscription, nil
 }
 
+// SubscribeColonyEvents subscribes to process, processgraph, executor and cron events in a colony, kinds and
+// eventTypes are optional filters, e.g. kinds=[]string{core.EXECUTOR_KIND}. Set since to the LastSequence of a
+// previous subscription to also receive the events that were signalled after it was closed, or to 0 to only
+// receive new events. The LastSequence of a new subscription is set to the current sequence number of the server
+// when the subscription is established.
+func (client *ColoniesClient) SubscribeColonyEvents(colonyID string, kinds []string, eventTypes []string, since int64, timeout int, prvKey string) (*ColonyEventSubscription, error) {
+	return client.SubscribeColonyEventsWithContext(colonyID, kinds, eventTypes, since, timeout, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) SubscribeColonyEventsWithContext(colonyID string, kinds []string, eventTypes []string, since int64, timeout int, ctx context.Context, prvKey string) (*ColonyEventSubscription, error) {
+	msg := rpc.CreateSubscribeColonyEventsMsg(colonyID, kinds, eventTypes, since, timeout)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcMsg, err := rpc.CreateRPCMsg(rpc.SubscribeColonyEventsPayloadType, jsonString, prvKey)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonString, err = rpcMsg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	wsConn, err := client.establishWebSocketConn(jsonString, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription := createColonyEventSubscription(wsConn)
+	subscription.lastSequence = since
+	go func(subscription *ColonyEventSubscription) {
+		for {
+			_, jsonBytes, err := subscription.wsConn.ReadMessage()
+			if err != nil {
+				closeWithError(subscription.ErrChan, err) // The connection is closed, e.g. the subscription timed out
+				return
+			}
+			rpcReplyMsg, err := rpc.CreateRPCReplyMsgFromJSON(string(jsonBytes))
+			if err != nil {
+				subscription.ErrChan <- err
+				continue
+			}
+
+			if rpcReplyMsg.Error {
+				failureMsg, err := core.ConvertJSONToFailure(rpcReplyMsg.DecodePayload())
+				if err != nil {
+					subscription.ErrChan <- err
+					continue
+				}
+				subscription.ErrChan <- failureMsg.ToError()
+				continue
+			}
+
+			event, err := core.ConvertJSONToColonyEvent(rpcReplyMsg.DecodePayload())
+			if err != nil {
+				subscription.ErrChan <- err
+				continue
+			}
+			if event.Type == core.SUBSCRIBED_EVENT {
+				// The server reports the cursor the subscription starts from
+				if event.Sequence > atomic.LoadInt64(&subscription.lastSequence) {
+					atomic.StoreInt64(&subscription.lastSequence, event.Sequence)
+				}
+				continue
+			}
+			subscription.EventChan <- event
+			if event.Sequence > 0 {
+				atomic.StoreInt64(&subscription.lastSequence, event.Sequence)
+			}
+		}
+	}(subscription)
+
+	return subscription, nil
+}
+
 func (client *ColoniesClient) AddColony(colony *core.Colony, prvKey string) (*core.Colony, error) {
+	return client.AddColonyWithContext(colony, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) AddColonyWithContext(colony *core.Colony, ctx context.Context, prvKey string) (*core.Colony, error) {
 	msg := rpc.CreateAddColonyMsg(colony)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.AddColonyPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.AddColonyPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -264,13 +468,17 @@ func (client *ColoniesClient) AddColony(colony *core.Colony, prvKey string) (*co
 }
 
 func (client *ColoniesClient) DeleteColony(colonyID string, prvKey string) error {
+	return client.DeleteColonyWithContext(colonyID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DeleteColonyWithContext(colonyID string, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateDeleteColonyMsg(colonyID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.DeleteColonyPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.DeleteColonyPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -279,13 +487,17 @@ func (client *ColoniesClient) DeleteColony(colonyID string, prvKey string) error
 }
 
 func (client *ColoniesClient) RenameColony(colonyID string, name string, prvKey string) error {
+	return client.RenameColonyWithContext(colonyID, name, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) RenameColonyWithContext(colonyID string, name string, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateRenameColonyMsg(colonyID, name)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.RenameColonyPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.RenameColonyPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -294,13 +506,17 @@ func (client *ColoniesClient) RenameColony(colonyID string, name string, prvKey
 }
 
 func (client *ColoniesClient) GetColonies(prvKey string) ([]*core.Colony, error) {
+	return client.GetColoniesWithContext(context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetColoniesWithContext(ctx context.Context, prvKey string) ([]*core.Colony, error) {
 	msg := rpc.CreateGetColoniesMsg()
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetColoniesPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetColoniesPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -309,13 +525,17 @@ func (client *ColoniesClient) GetColonies(prvKey string) ([]*core.Colony, error)
 }
 
 func (client *ColoniesClient) GetColonyByID(colonyID string, prvKey string) (*core.Colony, error) {
+	return client.GetColonyByIDWithContext(colonyID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetColonyByIDWithContext(colonyID string, ctx context.Context, prvKey string) (*core.Colony, error) {
 	msg := rpc.CreateGetColonyMsg(colonyID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetColonyPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetColonyPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -324,13 +544,17 @@ func (client *ColoniesClient) GetColonyByID(colonyID string, prvKey string) (*co
 }
 
 func (client *ColoniesClient) AddExecutor(executor *core.Executor, prvKey string) (*core.Executor, error) {
+	return client.AddExecutorWithContext(executor, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) AddExecutorWithContext(executor *core.Executor, ctx context.Context, prvKey string) (*core.Executor, error) {
 	msg := rpc.CreateAddExecutorMsg(executor)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.AddExecutorPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.AddExecutorPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -339,13 +563,17 @@ func (client *ColoniesClient) AddExecutor(executor *core.Executor, prvKey string
 }
 
 func (client *ColoniesClient) GetExecutors(colonyID string, prvKey string) ([]*core.Executor, error) {
+	return client.GetExecutorsWithContext(colonyID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetExecutorsWithContext(colonyID string, ctx context.Context, prvKey string) ([]*core.Executor, error) {
 	msg := rpc.CreateGetExecutorsMsg(colonyID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetExecutorsPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetExecutorsPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -354,13 +582,17 @@ func (client *ColoniesClient) GetExecutors(colonyID string, prvKey string) ([]*c
 }
 
 func (client *ColoniesClient) GetExecutor(executorID string, prvKey string) (*core.Executor, error) {
+	return client.GetExecutorWithContext(executorID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetExecutorWithContext(executorID string, ctx context.Context, prvKey string) (*core.Executor, error) {
 	msg := rpc.CreateGetExecutorMsg(executorID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetExecutorPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetExecutorPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -369,13 +601,17 @@ func (client *ColoniesClient) GetExecutor(executorID string, prvKey string) (*co
 }
 
 func (client *ColoniesClient) ApproveExecutor(executorID string, prvKey string) error {
+	return client.ApproveExecutorWithContext(executorID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) ApproveExecutorWithContext(executorID string, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateApproveExecutorMsg(executorID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.ApproveExecutorPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.ApproveExecutorPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -384,13 +620,17 @@ func (client *ColoniesClient) ApproveExecutor(executorID string, prvKey string)
 }
 
 func (client *ColoniesClient) RejectExecutor(executorID string, prvKey string) error {
+	return client.RejectExecutorWithContext(executorID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) RejectExecutorWithContext(executorID string, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateRejectExecutorMsg(executorID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.RejectExecutorPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.RejectExecutorPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -399,13 +639,17 @@ func (client *ColoniesClient) RejectExecutor(executorID string, prvKey string) e
 }
 
 func (client *ColoniesClient) DeleteExecutor(executorID string, prvKey string) error {
+	return client.DeleteExecutorWithContext(executorID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DeleteExecutorWithContext(executorID string, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateDeleteExecutorMsg(executorID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.DeleteExecutorPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.DeleteExecutorPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -414,13 +658,17 @@ func (client *ColoniesClient) DeleteExecutor(executorID string, prvKey string) e
 }
 
 func (client *ColoniesClient) Submit(funcSpec *core.FunctionSpec, prvKey string) (*core.Process, error) {
+	return client.SubmitWithContext(funcSpec, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) SubmitWithContext(funcSpec *core.FunctionSpec, ctx context.Context, prvKey string) (*core.Process, error) {
 	msg := rpc.CreateSubmitFunctionSpecMsg(funcSpec)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.SubmitFunctionSpecPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.SubmitFunctionSpecPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -429,19 +677,7 @@ func (client *ColoniesClient) Submit(funcSpec *core.FunctionSpec, prvKey string)
 }
 
 func (client *ColoniesClient) Assign(colonyID string, timeout int, prvKey string) (*core.Process, error) {
-	msg := rpc.CreateAssignProcessMsg(colonyID)
-	msg.Timeout = timeout
-	jsonString, err := msg.ToJSON()
-	if err != nil {
-		return nil, err
-	}
-
-	respBodyString, err := client.sendMessage(rpc.AssignProcessPayloadType, jsonString, prvKey, false, context.TODO())
-	if err != nil {
-		return nil, err
-	}
-
-	return core.ConvertJSONToProcess(respBodyString)
+	return client.AssignWithContext(colonyID, timeout, context.Background(), prvKey)
 }
 
 func (client *ColoniesClient) AssignWithContext(colonyID string, timeout int, ctx context.Context, prvKey string) (*core.Process, error) {
@@ -452,6 +688,10 @@ func (client *ColoniesClient) AssignWithContext(colonyID string, timeout int, ct
 		return nil, err
 	}
 
+	// The server may wait up to timeout seconds before replying
+	ctx, cancel := client.contextWithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
 	respBodyString, err := client.sendMessage(rpc.AssignProcessPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
@@ -461,13 +701,17 @@ func (client *ColoniesClient) AssignWithContext(colonyID string, timeout int, ct
 }
 
 func (client *ColoniesClient) GetProcessHistForColony(state int, colonyID string, seconds int, prvKey string) ([]*core.Process, error) {
+	return client.GetProcessHistForColonyWithContext(state, colonyID, seconds, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetProcessHistForColonyWithContext(state int, colonyID string, seconds int, ctx context.Context, prvKey string) ([]*core.Process, error) {
 	msg := rpc.CreateGetProcessHistMsg(colonyID, "", seconds, state)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetProcessHistPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetProcessHistPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -476,13 +720,17 @@ func (client *ColoniesClient) GetProcessHistForColony(state int, colonyID string
 }
 
 func (client *ColoniesClient) GetProcessHistForExecutor(state int, colonyID string, executorID string, seconds int, prvKey string) ([]*core.Process, error) {
+	return client.GetProcessHistForExecutorWithContext(state, colonyID, executorID, seconds, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetProcessHistForExecutorWithContext(state int, colonyID string, executorID string, seconds int, ctx context.Context, prvKey string) ([]*core.Process, error) {
 	msg := rpc.CreateGetProcessHistMsg(colonyID, executorID, seconds, state)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetProcessHistPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetProcessHistPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -490,14 +738,14 @@ func (client *ColoniesClient) GetProcessHistForExecutor(state int, colonyID stri
 	return core.ConvertJSONToProcessArray(respBodyString)
 }
 
-func (client *ColoniesClient) getProcesses(state int, colonyID string, executorType string, count int, prvKey string) ([]*core.Process, error) {
+func (client *ColoniesClient) getProcesses(state int, colonyID string, executorType string, count int, ctx context.Context, prvKey string) ([]*core.Process, error) {
 	msg := rpc.CreateGetProcessesMsg(colonyID, count, state, executorType)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetProcessesPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetProcessesPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -505,14 +753,14 @@ func (client *ColoniesClient) getProcesses(state int, colonyID string, executorT
 	return core.ConvertJSONToProcessArray(respBodyString)
 }
 
-func (client *ColoniesClient) getProcessesWithExecutorType(state int, colonyID string, count int, executorType string, prvKey string) ([]*core.Process, error) {
+func (client *ColoniesClient) getProcessesWithExecutorType(state int, colonyID string, count int, executorType string, ctx context.Context, prvKey string) ([]*core.Process, error) {
 	msg := rpc.CreateGetProcessesMsg(colonyID, count, state, "")
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetProcessesPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetProcessesPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -521,29 +769,49 @@ func (client *ColoniesClient) getProcessesWithExecutorType(state int, colonyID s
 }
 
 func (client *ColoniesClient) GetWaitingProcesses(colonyID string, executorType string, count int, prvKey string) ([]*core.Process, error) {
-	return client.getProcesses(core.WAITING, colonyID, executorType, count, prvKey)
+	return client.GetWaitingProcessesWithContext(colonyID, executorType, count, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetWaitingProcessesWithContext(colonyID string, executorType string, count int, ctx context.Context, prvKey string) ([]*core.Process, error) {
+	return client.getProcesses(core.WAITING, colonyID, executorType, count, ctx, prvKey)
 }
 
 func (client *ColoniesClient) GetRunningProcesses(colonyID string, executorType string, count int, prvKey string) ([]*core.Process, error) {
-	return client.getProcesses(core.RUNNING, colonyID, executorType, count, prvKey)
+	return client.GetRunningProcessesWithContext(colonyID, executorType, count, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetRunningProcessesWithContext(colonyID string, executorType string, count int, ctx context.Context, prvKey string) ([]*core.Process, error) {
+	return client.getProcesses(core.RUNNING, colonyID, executorType, count, ctx, prvKey)
 }
 
 func (client *ColoniesClient) GetSuccessfulProcesses(colonyID string, executorType string, count int, prvKey string) ([]*core.Process, error) {
-	return client.getProcesses(core.SUCCESS, colonyID, executorType, count, prvKey)
+	return client.GetSuccessfulProcessesWithContext(colonyID, executorType, count, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetSuccessfulProcessesWithContext(colonyID string, executorType string, count int, ctx context.Context, prvKey string) ([]*core.Process, error) {
+	return client.getProcesses(core.SUCCESS, colonyID, executorType, count, ctx, prvKey)
 }
 
 func (client *ColoniesClient) GetFailedProcesses(colonyID string, executorType string, count int, prvKey string) ([]*core.Process, error) {
-	return client.getProcesses(core.FAILED, colonyID, executorType, count, prvKey)
+	return client.GetFailedProcessesWithContext(colonyID, executorType, count, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetFailedProcessesWithContext(colonyID string, executorType string, count int, ctx context.Context, prvKey string) ([]*core.Process, error) {
+	return client.getProcesses(core.FAILED, colonyID, executorType, count, ctx, prvKey)
 }
 
 func (client *ColoniesClient) ColonyStatistics(colonyID string, prvKey string) (*core.Statistics, error) {
+	return client.ColonyStatisticsWithContext(colonyID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) ColonyStatisticsWithContext(colonyID string, ctx context.Context, prvKey string) (*core.Statistics, error) {
 	msg := rpc.CreateGetColonyStatisticsMsg(colonyID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetColonyStatisticsPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetColonyStatisticsPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -552,13 +820,17 @@ func (client *ColoniesClient) ColonyStatistics(colonyID string, prvKey string) (
 }
 
 func (client *ColoniesClient) Statistics(prvKey string) (*core.Statistics, error) {
+	return client.StatisticsWithContext(context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) StatisticsWithContext(ctx context.Context, prvKey string) (*core.Statistics, error) {
 	msg := rpc.CreateGetStatisticsMsg()
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetStatisiticsPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetStatisiticsPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -567,13 +839,17 @@ func (client *ColoniesClient) Statistics(prvKey string) (*core.Statistics, error
 }
 
 func (client *ColoniesClient) GetProcess(processID string, prvKey string) (*core.Process, error) {
+	return client.GetProcessWithContext(processID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetProcessWithContext(processID string, ctx context.Context, prvKey string) (*core.Process, error) {
 	msg := rpc.CreateGetProcessMsg(processID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetProcessPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetProcessPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -581,14 +857,81 @@ func (client *ColoniesClient) GetProcess(processID string, prvKey string) (*core
 	return core.ConvertJSONToProcess(respBodyString)
 }
 
+func (client *ColoniesClient) GetProcessEvents(processID string, prvKey string) ([]*core.ProcessEvent, error) {
+	return client.GetProcessEventsWithContext(processID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetProcessEventsWithContext(processID string, ctx context.Context, prvKey string) ([]*core.ProcessEvent, error) {
+	msg := rpc.CreateGetProcessEventsMsg(processID)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.GetProcessEventsPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToProcessEventArray(respBodyString)
+}
+
+func (client *ColoniesClient) AddLog(processID string, message string, prvKey string) (*core.Log, error) {
+	return client.AddLogWithContext(processID, message, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) AddLogWithContext(processID string, message string, ctx context.Context, prvKey string) (*core.Log, error) {
+	msg := rpc.CreateAddLogMsg(processID, message)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.AddLogPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToLog(respBodyString)
+}
+
+// GetLogs returns at most count log chunks of a process starting at offset. If timeout is larger than 0, the server
+// waits at most timeout seconds for new chunks before replying, which is used to follow a running process.
+func (client *ColoniesClient) GetLogs(processID string, offset int64, count int, timeout int, prvKey string) ([]*core.Log, error) {
+	return client.GetLogsWithContext(processID, offset, count, timeout, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetLogsWithContext(processID string, offset int64, count int, timeout int, ctx context.Context, prvKey string) ([]*core.Log, error) {
+	msg := rpc.CreateGetLogsMsg(processID, offset, count, timeout)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	// The server may wait up to timeout seconds before replying
+	ctx, cancel := client.contextWithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	respBodyString, err := client.sendMessage(rpc.GetLogsPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToLogArray(respBodyString)
+}
+
 func (client *ColoniesClient) DeleteProcess(processID string, prvKey string) error {
+	return client.DeleteProcessWithContext(processID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DeleteProcessWithContext(processID string, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateDeleteProcessMsg(processID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.DeleteProcessPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.DeleteProcessPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -597,6 +940,10 @@ func (client *ColoniesClient) DeleteProcess(processID string, prvKey string) err
 }
 
 func (client *ColoniesClient) DeleteAllProcesses(colonyID string, prvKey string) error {
+	return client.DeleteAllProcessesWithContext(colonyID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DeleteAllProcessesWithContext(colonyID string, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateDeleteAllProcessesMsg(colonyID)
 	msg.State = core.NOTSET
 	jsonString, err := msg.ToJSON()
@@ -604,7 +951,7 @@ func (client *ColoniesClient) DeleteAllProcesses(colonyID string, prvKey string)
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.DeleteAllProcessesPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.DeleteAllProcessesPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -613,6 +960,10 @@ func (client *ColoniesClient) DeleteAllProcesses(colonyID string, prvKey string)
 }
 
 func (client *ColoniesClient) DeleteAllProcessesWithState(colonyID string, state int, prvKey string) error {
+	return client.DeleteAllProcessesWithStateWithContext(colonyID, state, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DeleteAllProcessesWithStateWithContext(colonyID string, state int, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateDeleteAllProcessesMsg(colonyID)
 	msg.State = state
 	jsonString, err := msg.ToJSON()
@@ -620,7 +971,7 @@ func (client *ColoniesClient) DeleteAllProcessesWithState(colonyID string, state
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.DeleteAllProcessesPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.DeleteAllProcessesPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -629,13 +980,17 @@ func (client *ColoniesClient) DeleteAllProcessesWithState(colonyID string, state
 }
 
 func (client *ColoniesClient) Close(processID string, prvKey string) error {
+	return client.CloseWithContext(processID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) CloseWithContext(processID string, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateCloseSuccessfulMsg(processID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.CloseSuccessfulPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.CloseSuccessfulPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -644,6 +999,10 @@ func (client *ColoniesClient) Close(processID string, prvKey string) error {
 }
 
 func (client *ColoniesClient) CloseWithOutput(processID string, output []interface{}, prvKey string) error {
+	return client.CloseWithOutputWithContext(processID, output, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) CloseWithOutputWithContext(processID string, output []interface{}, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateCloseSuccessfulMsg(processID)
 	msg.Output = output
 	jsonString, err := msg.ToJSON()
@@ -651,7 +1010,30 @@ func (client *ColoniesClient) CloseWithOutput(processID string, output []interfa
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.CloseSuccessfulPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.CloseSuccessfulPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CloseWithNamedOutput closes a process with named outputs, which children can bind to using the inputs of their
+// function specs, e.g. "inputs": {"model": "train.out.model"}
+func (client *ColoniesClient) CloseWithNamedOutput(processID string, output []interface{}, namedOutput map[string]interface{}, prvKey string) error {
+	return client.CloseWithNamedOutputWithContext(processID, output, namedOutput, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) CloseWithNamedOutputWithContext(processID string, output []interface{}, namedOutput map[string]interface{}, ctx context.Context, prvKey string) error {
+	msg := rpc.CreateCloseSuccessfulMsg(processID)
+	msg.Output = output
+	msg.NamedOutput = namedOutput
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.sendMessage(rpc.CloseSuccessfulPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -660,13 +1042,17 @@ func (client *ColoniesClient) CloseWithOutput(processID string, output []interfa
 }
 
 func (client *ColoniesClient) Fail(processID string, errs []string, prvKey string) error {
+	return client.FailWithContext(processID, errs, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) FailWithContext(processID string, errs []string, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateCloseFailedMsg(processID, errs)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.CloseFailedPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.CloseFailedPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -675,13 +1061,17 @@ func (client *ColoniesClient) Fail(processID string, errs []string, prvKey strin
 }
 
 func (client *ColoniesClient) AddAttribute(attribute core.Attribute, prvKey string) (core.Attribute, error) {
+	return client.AddAttributeWithContext(attribute, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) AddAttributeWithContext(attribute core.Attribute, ctx context.Context, prvKey string) (core.Attribute, error) {
 	msg := rpc.CreateAddAttributeMsg(attribute)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return core.Attribute{}, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.AddAttributePayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.AddAttributePayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return core.Attribute{}, err
 	}
@@ -690,13 +1080,17 @@ func (client *ColoniesClient) AddAttribute(attribute core.Attribute, prvKey stri
 }
 
 func (client *ColoniesClient) GetAttribute(attributeID string, prvKey string) (core.Attribute, error) {
+	return client.GetAttributeWithContext(attributeID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetAttributeWithContext(attributeID string, ctx context.Context, prvKey string) (core.Attribute, error) {
 	msg := rpc.CreateGetAttributeMsg(attributeID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return core.Attribute{}, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetAttributePayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetAttributePayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return core.Attribute{}, err
 	}
@@ -705,13 +1099,17 @@ func (client *ColoniesClient) GetAttribute(attributeID string, prvKey string) (c
 }
 
 func (client *ColoniesClient) SubmitWorkflowSpec(workflowSpec *core.WorkflowSpec, prvKey string) (*core.ProcessGraph, error) {
+	return client.SubmitWorkflowSpecWithContext(workflowSpec, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) SubmitWorkflowSpecWithContext(workflowSpec *core.WorkflowSpec, ctx context.Context, prvKey string) (*core.ProcessGraph, error) {
 	msg := rpc.CreateSubmitWorkflowSpecMsg(workflowSpec)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.SubmitWorkflowSpecPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.SubmitWorkflowSpecPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -719,14 +1117,37 @@ func (client *ColoniesClient) SubmitWorkflowSpec(workflowSpec *core.WorkflowSpec
 	return core.ConvertJSONToProcessGraph(respBodyString)
 }
 
+func (client *ColoniesClient) ValidateWorkflowSpec(workflowSpec *core.WorkflowSpec, prvKey string) (*core.WorkflowValidation, error) {
+	return client.ValidateWorkflowSpecWithContext(workflowSpec, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) ValidateWorkflowSpecWithContext(workflowSpec *core.WorkflowSpec, ctx context.Context, prvKey string) (*core.WorkflowValidation, error) {
+	msg := rpc.CreateValidateWorkflowSpecMsg(workflowSpec)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.ValidateWorkflowSpecPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToWorkflowValidation(respBodyString)
+}
+
 func (client *ColoniesClient) AddChild(processGraphID string, parentProcessID string, childProcessID string, funcSpec *core.FunctionSpec, insert bool, prvKey string) (*core.Process, error) {
+	return client.AddChildWithContext(processGraphID, parentProcessID, childProcessID, funcSpec, insert, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) AddChildWithContext(processGraphID string, parentProcessID string, childProcessID string, funcSpec *core.FunctionSpec, insert bool, ctx context.Context, prvKey string) (*core.Process, error) {
 	msg := rpc.CreateAddChildMsg(processGraphID, parentProcessID, childProcessID, funcSpec, insert)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.AddChildPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.AddChildPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -735,13 +1156,17 @@ func (client *ColoniesClient) AddChild(processGraphID string, parentProcessID st
 }
 
 func (client *ColoniesClient) GetProcessGraph(processGraphID string, prvKey string) (*core.ProcessGraph, error) {
+	return client.GetProcessGraphWithContext(processGraphID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetProcessGraphWithContext(processGraphID string, ctx context.Context, prvKey string) (*core.ProcessGraph, error) {
 	msg := rpc.CreateGetProcessGraphMsg(processGraphID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetProcessGraphPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetProcessGraphPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -749,14 +1174,14 @@ func (client *ColoniesClient) GetProcessGraph(processGraphID string, prvKey stri
 	return core.ConvertJSONToProcessGraph(respBodyString)
 }
 
-func (client *ColoniesClient) getProcessGraphs(state int, colonyID string, count int, prvKey string) ([]*core.ProcessGraph, error) {
+func (client *ColoniesClient) getProcessGraphs(state int, colonyID string, count int, ctx context.Context, prvKey string) ([]*core.ProcessGraph, error) {
 	msg := rpc.CreateGetProcessGraphsMsg(colonyID, count, state)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetProcessGraphsPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetProcessGraphsPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -765,29 +1190,49 @@ func (client *ColoniesClient) getProcessGraphs(state int, colonyID string, count
 }
 
 func (client *ColoniesClient) GetWaitingProcessGraphs(colonyID string, count int, prvKey string) ([]*core.ProcessGraph, error) {
-	return client.getProcessGraphs(core.WAITING, colonyID, count, prvKey)
+	return client.GetWaitingProcessGraphsWithContext(colonyID, count, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetWaitingProcessGraphsWithContext(colonyID string, count int, ctx context.Context, prvKey string) ([]*core.ProcessGraph, error) {
+	return client.getProcessGraphs(core.WAITING, colonyID, count, ctx, prvKey)
 }
 
 func (client *ColoniesClient) GetRunningProcessGraphs(colonyID string, count int, prvKey string) ([]*core.ProcessGraph, error) {
-	return client.getProcessGraphs(core.RUNNING, colonyID, count, prvKey)
+	return client.GetRunningProcessGraphsWithContext(colonyID, count, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetRunningProcessGraphsWithContext(colonyID string, count int, ctx context.Context, prvKey string) ([]*core.ProcessGraph, error) {
+	return client.getProcessGraphs(core.RUNNING, colonyID, count, ctx, prvKey)
 }
 
 func (client *ColoniesClient) GetSuccessfulProcessGraphs(colonyID string, count int, prvKey string) ([]*core.ProcessGraph, error) {
-	return client.getProcessGraphs(core.SUCCESS, colonyID, count, prvKey)
+	return client.GetSuccessfulProcessGraphsWithContext(colonyID, count, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetSuccessfulProcessGraphsWithContext(colonyID string, count int, ctx context.Context, prvKey string) ([]*core.ProcessGraph, error) {
+	return client.getProcessGraphs(core.SUCCESS, colonyID, count, ctx, prvKey)
 }
 
 func (client *ColoniesClient) GetFailedProcessGraphs(colonyID string, count int, prvKey string) ([]*core.ProcessGraph, error) {
-	return client.getProcessGraphs(core.FAILED, colonyID, count, prvKey)
+	return client.GetFailedProcessGraphsWithContext(colonyID, count, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetFailedProcessGraphsWithContext(colonyID string, count int, ctx context.Context, prvKey string) ([]*core.ProcessGraph, error) {
+	return client.getProcessGraphs(core.FAILED, colonyID, count, ctx, prvKey)
 }
 
 func (client *ColoniesClient) DeleteProcessGraph(processGraphID string, prvKey string) error {
+	return client.DeleteProcessGraphWithContext(processGraphID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DeleteProcessGraphWithContext(processGraphID string, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateDeleteProcessGraphMsg(processGraphID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.DeleteProcessGraphPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.DeleteProcessGraphPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -795,7 +1240,30 @@ func (client *ColoniesClient) DeleteProcessGraph(processGraphID string, prvKey s
 	return nil
 }
 
+func (client *ColoniesClient) RetryProcessGraph(processGraphID string, prvKey string) (*core.ProcessGraph, error) {
+	return client.RetryProcessGraphWithContext(processGraphID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) RetryProcessGraphWithContext(processGraphID string, ctx context.Context, prvKey string) (*core.ProcessGraph, error) {
+	msg := rpc.CreateRetryProcessGraphMsg(processGraphID)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.RetryProcessGraphPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToProcessGraph(respBodyString)
+}
+
 func (client *ColoniesClient) DeleteAllProcessGraphs(colonyID string, prvKey string) error {
+	return client.DeleteAllProcessGraphsWithContext(colonyID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DeleteAllProcessGraphsWithContext(colonyID string, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateDeleteAllProcessGraphsMsg(colonyID)
 	msg.State = core.NOTSET
 	jsonString, err := msg.ToJSON()
@@ -803,7 +1271,7 @@ func (client *ColoniesClient) DeleteAllProcessGraphs(colonyID string, prvKey str
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.DeleteAllProcessGraphsPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.DeleteAllProcessGraphsPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -812,6 +1280,10 @@ func (client *ColoniesClient) DeleteAllProcessGraphs(colonyID string, prvKey str
 }
 
 func (client *ColoniesClient) DeleteAllProcessGraphsWithState(colonyID string, state int, prvKey string) error {
+	return client.DeleteAllProcessGraphsWithStateWithContext(colonyID, state, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DeleteAllProcessGraphsWithStateWithContext(colonyID string, state int, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateDeleteAllProcessGraphsMsg(colonyID)
 	msg.State = state
 	jsonString, err := msg.ToJSON()
@@ -819,7 +1291,7 @@ func (client *ColoniesClient) DeleteAllProcessGraphsWithState(colonyID string, s
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.DeleteAllProcessGraphsPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.DeleteAllProcessGraphsPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -828,13 +1300,36 @@ func (client *ColoniesClient) DeleteAllProcessGraphsWithState(colonyID string, s
 }
 
 func (client *ColoniesClient) AddGenerator(generator *core.Generator, prvKey string) (*core.Generator, error) {
+	return client.AddGeneratorWithContext(generator, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) AddGeneratorWithContext(generator *core.Generator, ctx context.Context, prvKey string) (*core.Generator, error) {
 	msg := rpc.CreateAddGeneratorMsg(generator)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.AddGeneratorPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.AddGeneratorPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToGenerator(respBodyString)
+}
+
+func (client *ColoniesClient) UpdateGenerator(generator *core.Generator, prvKey string) (*core.Generator, error) {
+	return client.UpdateGeneratorWithContext(generator, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) UpdateGeneratorWithContext(generator *core.Generator, ctx context.Context, prvKey string) (*core.Generator, error) {
+	msg := rpc.CreateUpdateGeneratorMsg(generator)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.UpdateGeneratorPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -843,13 +1338,17 @@ func (client *ColoniesClient) AddGenerator(generator *core.Generator, prvKey str
 }
 
 func (client *ColoniesClient) GetGenerator(generatorID string, prvKey string) (*core.Generator, error) {
+	return client.GetGeneratorWithContext(generatorID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetGeneratorWithContext(generatorID string, ctx context.Context, prvKey string) (*core.Generator, error) {
 	msg := rpc.CreateGetGeneratorMsg(generatorID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetGeneratorPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetGeneratorPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -858,13 +1357,17 @@ func (client *ColoniesClient) GetGenerator(generatorID string, prvKey string) (*
 }
 
 func (client *ColoniesClient) ResolveGenerator(generatorName string, prvKey string) (*core.Generator, error) {
+	return client.ResolveGeneratorWithContext(generatorName, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) ResolveGeneratorWithContext(generatorName string, ctx context.Context, prvKey string) (*core.Generator, error) {
 	msg := rpc.CreateResolveGeneratorMsg(generatorName)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.ResolveGeneratorPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.ResolveGeneratorPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -873,13 +1376,17 @@ func (client *ColoniesClient) ResolveGenerator(generatorName string, prvKey stri
 }
 
 func (client *ColoniesClient) GetGenerators(colonyID string, count int, prvKey string) ([]*core.Generator, error) {
+	return client.GetGeneratorsWithContext(colonyID, count, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetGeneratorsWithContext(colonyID string, count int, ctx context.Context, prvKey string) ([]*core.Generator, error) {
 	msg := rpc.CreateGetGeneratorsMsg(colonyID, count)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetGeneratorsPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetGeneratorsPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -888,13 +1395,17 @@ func (client *ColoniesClient) GetGenerators(colonyID string, count int, prvKey s
 }
 
 func (client *ColoniesClient) PackGenerator(generatorID string, arg string, prvKey string) error {
+	return client.PackGeneratorWithContext(generatorID, arg, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) PackGeneratorWithContext(generatorID string, arg string, ctx context.Context, prvKey string) error {
 	msg := rpc.CreatePackGeneratorMsg(generatorID, arg)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.PackGeneratorPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.PackGeneratorPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -903,13 +1414,17 @@ func (client *ColoniesClient) PackGenerator(generatorID string, arg string, prvK
 }
 
 func (client *ColoniesClient) DeleteGenerator(generatorID string, prvKey string) error {
+	return client.DeleteGeneratorWithContext(generatorID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DeleteGeneratorWithContext(generatorID string, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateDeleteGeneratorMsg(generatorID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.DeleteGeneratorPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.DeleteGeneratorPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -918,13 +1433,36 @@ func (client *ColoniesClient) DeleteGenerator(generatorID string, prvKey string)
 }
 
 func (client *ColoniesClient) AddCron(cron *core.Cron, prvKey string) (*core.Cron, error) {
+	return client.AddCronWithContext(cron, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) AddCronWithContext(cron *core.Cron, ctx context.Context, prvKey string) (*core.Cron, error) {
 	msg := rpc.CreateAddCronMsg(cron)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.AddCronPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.AddCronPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToCron(respBodyString)
+}
+
+func (client *ColoniesClient) UpdateCron(cron *core.Cron, prvKey string) (*core.Cron, error) {
+	return client.UpdateCronWithContext(cron, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) UpdateCronWithContext(cron *core.Cron, ctx context.Context, prvKey string) (*core.Cron, error) {
+	msg := rpc.CreateUpdateCronMsg(cron)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.UpdateCronPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -933,13 +1471,17 @@ func (client *ColoniesClient) AddCron(cron *core.Cron, prvKey string) (*core.Cro
 }
 
 func (client *ColoniesClient) GetCron(cronID string, prvKey string) (*core.Cron, error) {
+	return client.GetCronWithContext(cronID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetCronWithContext(cronID string, ctx context.Context, prvKey string) (*core.Cron, error) {
 	msg := rpc.CreateGetCronMsg(cronID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetCronPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetCronPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -948,13 +1490,17 @@ func (client *ColoniesClient) GetCron(cronID string, prvKey string) (*core.Cron,
 }
 
 func (client *ColoniesClient) GetCrons(colonyID string, count int, prvKey string) ([]*core.Cron, error) {
+	return client.GetCronsWithContext(colonyID, count, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetCronsWithContext(colonyID string, count int, ctx context.Context, prvKey string) ([]*core.Cron, error) {
 	msg := rpc.CreateGetCronsMsg(colonyID, count)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetCronsPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetCronsPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -963,13 +1509,17 @@ func (client *ColoniesClient) GetCrons(colonyID string, count int, prvKey string
 }
 
 func (client *ColoniesClient) RunCron(cronID string, prvKey string) (*core.Cron, error) {
+	return client.RunCronWithContext(cronID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) RunCronWithContext(cronID string, ctx context.Context, prvKey string) (*core.Cron, error) {
 	msg := rpc.CreateRunCronMsg(cronID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.RunCronPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.RunCronPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -977,14 +1527,132 @@ func (client *ColoniesClient) RunCron(cronID string, prvKey string) (*core.Cron,
 	return core.ConvertJSONToCron(respBodyString)
 }
 
+func (client *ColoniesClient) GetRunHistory(triggerID string, count int, prvKey string) ([]*core.RunRecord, error) {
+	return client.GetRunHistoryWithContext(triggerID, count, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetRunHistoryWithContext(triggerID string, count int, ctx context.Context, prvKey string) ([]*core.RunRecord, error) {
+	msg := rpc.CreateGetRunHistoryMsg(triggerID, count)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.GetRunHistoryPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToRunRecordArray(respBodyString)
+}
+
+func (client *ColoniesClient) GetAuditLog(colonyID string, count int, prvKey string) ([]*core.AuditRecord, error) {
+	return client.GetAuditLogWithContext(colonyID, count, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetAuditLogWithContext(colonyID string, count int, ctx context.Context, prvKey string) ([]*core.AuditRecord, error) {
+	msg := rpc.CreateGetAuditLogMsg(colonyID, count)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.GetAuditLogPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToAuditRecordArray(respBodyString)
+}
+
 func (client *ColoniesClient) DeleteCron(cronID string, prvKey string) error {
+	return client.DeleteCronWithContext(cronID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DeleteCronWithContext(cronID string, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateDeleteCronMsg(cronID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.DeleteCronPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.DeleteCronPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (client *ColoniesClient) AddWorkflowTemplate(template *core.WorkflowTemplate, prvKey string) (*core.WorkflowTemplate, error) {
+	return client.AddWorkflowTemplateWithContext(template, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) AddWorkflowTemplateWithContext(template *core.WorkflowTemplate, ctx context.Context, prvKey string) (*core.WorkflowTemplate, error) {
+	msg := rpc.CreateAddWorkflowTemplateMsg(template)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.AddWorkflowTemplatePayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToWorkflowTemplate(respBodyString)
+}
+
+func (client *ColoniesClient) GetWorkflowTemplate(colonyID string, name string, version int, prvKey string) (*core.WorkflowTemplate, error) {
+	return client.GetWorkflowTemplateWithContext(colonyID, name, version, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetWorkflowTemplateWithContext(colonyID string, name string, version int, ctx context.Context, prvKey string) (*core.WorkflowTemplate, error) {
+	msg := rpc.CreateGetWorkflowTemplateMsg(colonyID, name, version)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.GetWorkflowTemplatePayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToWorkflowTemplate(respBodyString)
+}
+
+func (client *ColoniesClient) GetWorkflowTemplates(colonyID string, prvKey string) ([]*core.WorkflowTemplate, error) {
+	return client.GetWorkflowTemplatesWithContext(colonyID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetWorkflowTemplatesWithContext(colonyID string, ctx context.Context, prvKey string) ([]*core.WorkflowTemplate, error) {
+	msg := rpc.CreateGetWorkflowTemplatesMsg(colonyID)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.GetWorkflowTemplatesPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToWorkflowTemplateArray(respBodyString)
+}
+
+func (client *ColoniesClient) DeleteWorkflowTemplate(colonyID string, name string, version int, prvKey string) error {
+	return client.DeleteWorkflowTemplateWithContext(colonyID, name, version, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DeleteWorkflowTemplateWithContext(colonyID string, name string, version int, ctx context.Context, prvKey string) error {
+	msg := rpc.CreateDeleteWorkflowTemplateMsg(colonyID, name, version)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.sendMessage(rpc.DeleteWorkflowTemplatePayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -993,13 +1661,17 @@ func (client *ColoniesClient) DeleteCron(cronID string, prvKey string) error {
 }
 
 func (client *ColoniesClient) AddFunction(function *core.Function, prvKey string) (*core.Function, error) {
+	return client.AddFunctionWithContext(function, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) AddFunctionWithContext(function *core.Function, ctx context.Context, prvKey string) (*core.Function, error) {
 	msg := rpc.CreateAddFunctionMsg(function)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.AddFunctionPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.AddFunctionPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1008,13 +1680,17 @@ func (client *ColoniesClient) AddFunction(function *core.Function, prvKey string
 }
 
 func (client *ColoniesClient) GetFunctionsByExecutorID(executorID string, prvKey string) ([]*core.Function, error) {
+	return client.GetFunctionsByExecutorIDWithContext(executorID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetFunctionsByExecutorIDWithContext(executorID string, ctx context.Context, prvKey string) ([]*core.Function, error) {
 	msg := rpc.CreateGetFunctionsByExecutorIDMsg(executorID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetFunctionsPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetFunctionsPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1023,13 +1699,17 @@ func (client *ColoniesClient) GetFunctionsByExecutorID(executorID string, prvKey
 }
 
 func (client *ColoniesClient) GetFunctionsByColonyID(colonyID string, prvKey string) ([]*core.Function, error) {
+	return client.GetFunctionsByColonyIDWithContext(colonyID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetFunctionsByColonyIDWithContext(colonyID string, ctx context.Context, prvKey string) ([]*core.Function, error) {
 	msg := rpc.CreateGetFunctionsByColonyIDMsg(colonyID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetFunctionsPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetFunctionsPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1038,13 +1718,17 @@ func (client *ColoniesClient) GetFunctionsByColonyID(colonyID string, prvKey str
 }
 
 func (client *ColoniesClient) DeleteFunction(functionID string, prvKey string) error {
+	return client.DeleteFunctionWithContext(functionID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DeleteFunctionWithContext(functionID string, ctx context.Context, prvKey string) error {
 	msg := rpc.CreateDeleteFunctionMsg(functionID)
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.DeleteFunctionPayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.DeleteFunctionPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
@@ -1053,13 +1737,17 @@ func (client *ColoniesClient) DeleteFunction(functionID string, prvKey string) e
 }
 
 func (client *ColoniesClient) Version() (string, string, error) {
+	return client.VersionWithContext(context.Background())
+}
+
+func (client *ColoniesClient) VersionWithContext(ctx context.Context) (string, string, error) {
 	msg := rpc.CreateVersionMsg("", "")
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return "", "", err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.VersionPayloadType, jsonString, "", true, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.VersionPayloadType, jsonString, "", true, ctx)
 	if err != nil {
 		return "", "", err
 	}
@@ -1073,24 +1761,36 @@ func (client *ColoniesClient) Version() (string, string, error) {
 }
 
 func (client *ColoniesClient) CheckHealth() error {
+	return client.CheckHealthWithContext(context.Background())
+}
+
+func (client *ColoniesClient) CheckHealthWithContext(ctx context.Context) error {
+	ctx, cancel := client.contextWithTimeout(ctx, 0)
+	defer cancel()
+
 	protocol := "https"
 	if client.insecure {
 		protocol = "http"
 	}
 	_, err := client.restyClient.R().
+		SetContext(ctx).
 		Get(protocol + "://" + client.host + ":" + strconv.Itoa(client.port) + "/health")
 
 	return err
 }
 
 func (client *ColoniesClient) GetClusterInfo(prvKey string) (*cluster.Config, error) {
+	return client.GetClusterInfoWithContext(context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetClusterInfoWithContext(ctx context.Context, prvKey string) (*cluster.Config, error) {
 	msg := rpc.CreateGetClusterMsg()
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return nil, err
 	}
 
-	respBodyString, err := client.sendMessage(rpc.GetClusterPayloadType, jsonString, prvKey, false, context.TODO())
+	respBodyString, err := client.sendMessage(rpc.GetClusterPayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1099,13 +1799,17 @@ func (client *ColoniesClient) GetClusterInfo(prvKey string) (*cluster.Config, er
 }
 
 func (client *ColoniesClient) ResetDatabase(prvKey string) error {
+	return client.ResetDatabaseWithContext(context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) ResetDatabaseWithContext(ctx context.Context, prvKey string) error {
 	msg := rpc.CreateResetDatabaseMsg()
 	jsonString, err := msg.ToJSON()
 	if err != nil {
 		return err
 	}
 
-	_, err = client.sendMessage(rpc.ResetDatabasePayloadType, jsonString, prvKey, false, context.TODO())
+	_, err = client.sendMessage(rpc.ResetDatabasePayloadType, jsonString, prvKey, false, ctx)
 	if err != nil {
 		return err
 	}
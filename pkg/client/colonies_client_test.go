@@ -0,0 +1,256 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
+	"github.com/colonyos/colonies/pkg/security/crypto"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+type transportMock struct {
+	mutex    sync.Mutex
+	calls    int
+	failures int
+	block    bool
+	reply    string
+	deadline bool
+}
+
+func (transport *transportMock) Send(ctx context.Context, jsonString string) (string, error) {
+	transport.mutex.Lock()
+	transport.calls++
+	_, transport.deadline = ctx.Deadline()
+	if transport.failures > 0 {
+		transport.failures--
+		transport.mutex.Unlock()
+		return "", &url.Error{Op: "Post", URL: "https://localhost/api", Err: errors.New("connection refused")}
+	}
+	transport.mutex.Unlock()
+
+	if transport.block {
+		<-ctx.Done()
+		return "", &url.Error{Op: "Post", URL: "https://localhost/api", Err: ctx.Err()}
+	}
+
+	return transport.reply, nil
+}
+
+func createTransportMock(t *testing.T, payloadType string, jsonString string) *transportMock {
+	rpcReplyMsg, err := rpc.CreateRPCReplyMsg(payloadType, jsonString)
+	assert.Nil(t, err)
+	reply, err := rpcReplyMsg.ToJSON()
+	assert.Nil(t, err)
+
+	return &transportMock{reply: reply}
+}
+
+func createTestClient(t *testing.T, transport Transport) (*ColoniesClient, string) {
+	prvKey, err := crypto.CreateCrypto().GeneratePrivateKey()
+	assert.Nil(t, err)
+
+	client := CreateColoniesClientWithTransport(transport)
+	client.SetRetries(DEFAULT_MAX_RETRIES, time.Millisecond)
+
+	return client, prvKey
+}
+
+func TestClientRetry(t *testing.T) {
+	process := core.CreateProcess(core.CreateEmptyFunctionSpec())
+	jsonString, err := process.ToJSON()
+	assert.Nil(t, err)
+
+	transport := createTransportMock(t, rpc.GetProcessPayloadType, jsonString)
+	client, prvKey := createTestClient(t, transport)
+
+	// Reads should be retried
+	transport.failures = DEFAULT_MAX_RETRIES
+	processFromServer, err := client.GetProcess(process.ID, prvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, process.ID, processFromServer.ID)
+	assert.Equal(t, DEFAULT_MAX_RETRIES+1, transport.calls)
+
+	// Give up after max retries
+	transport.calls = 0
+	transport.failures = DEFAULT_MAX_RETRIES + 1
+	_, err = client.GetProcess(process.ID, prvKey)
+	assert.NotNil(t, err)
+	assert.Equal(t, DEFAULT_MAX_RETRIES+1, transport.calls)
+
+	// Calls that change the state of the server must not be retried
+	transport.calls = 0
+	transport.failures = 1
+	err = client.Close(process.ID, prvKey)
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, transport.calls)
+}
+
+func TestClientFailure(t *testing.T) {
+	failure := core.CreateFailureWithCode(404, core.ERROR_NOT_FOUND, "error_msg")
+	jsonString, err := failure.ToJSON()
+	assert.Nil(t, err)
+
+	rpcReplyMsg, err := rpc.CreateRPCErrorReplyMsg(rpc.ErrorPayloadType, jsonString)
+	assert.Nil(t, err)
+	reply, err := rpcReplyMsg.ToJSON()
+	assert.Nil(t, err)
+
+	transport := &transportMock{reply: reply}
+	client, prvKey := createTestClient(t, transport)
+
+	_, err = client.GetProcess(core.GenerateRandomID(), prvKey)
+	assert.True(t, errors.Is(err, core.ErrNotFound))
+	assert.Equal(t, 1, transport.calls)
+}
+
+func TestClientContext(t *testing.T) {
+	transport := &transportMock{block: true}
+	client, prvKey := createTestClient(t, transport)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := client.GetProcessWithContext(core.GenerateRandomID(), ctx, prvKey)
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, transport.calls)
+}
+
+func TestClientTimeout(t *testing.T) {
+	transport := &transportMock{}
+	client, prvKey := createTestClient(t, transport)
+
+	// No timeout by default
+	_, err := client.GetProcess(core.GenerateRandomID(), prvKey)
+	assert.NotNil(t, err)
+	assert.False(t, transport.deadline)
+
+	transport.block = true
+	client.SetTimeout(10 * time.Millisecond)
+	start := time.Now()
+	_, err = client.GetProcess(core.GenerateRandomID(), prvKey)
+	assert.NotNil(t, err)
+	assert.True(t, transport.deadline)
+	assert.Less(t, time.Since(start), time.Second)
+
+	// The assign timeout is added to the client timeout
+	start = time.Now()
+	_, err = client.Assign(core.GenerateRandomID(), 1, prvKey)
+	assert.NotNil(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestSubscribeColonyEventsClosed(t *testing.T) {
+	event := core.CreateColonyEvent(core.GenerateRandomID(), core.PROCESS_KIND, core.SUBMITTED_EVENT, core.GenerateRandomID(), core.WAITING, "")
+	event.Sequence = 42
+	eventJSON, err := event.ToJSON()
+	assert.Nil(t, err)
+	rpcReplyMsg, err := rpc.CreateRPCReplyMsg(rpc.SubscribeColonyEventsPayloadType, eventJSON)
+	assert.Nil(t, err)
+	reply, err := rpcReplyMsg.ToJSON()
+	assert.Nil(t, err)
+
+	// The server sends one event and then closes the connection
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		wsConn, err := upgrader.Upgrade(w, r, nil)
+		assert.Nil(t, err)
+		_, _, err = wsConn.ReadMessage()
+		assert.Nil(t, err)
+		err = wsConn.WriteMessage(websocket.TextMessage, []byte(reply))
+		assert.Nil(t, err)
+		wsConn.Close()
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	assert.Nil(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	assert.Nil(t, err)
+
+	prvKey, err := crypto.CreateCrypto().GeneratePrivateKey()
+	assert.Nil(t, err)
+	client := CreateColoniesClient(serverURL.Hostname(), port, true, false)
+
+	subscription, err := client.SubscribeColonyEvents(event.ColonyID, nil, nil, 10, 100, prvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, subscription.LastSequence(), int64(10))
+
+	select {
+	case receivedEvent := <-subscription.EventChan:
+		assert.True(t, event.Equals(receivedEvent))
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "Timeout waiting for colony event")
+	}
+
+	select {
+	case err := <-subscription.ErrChan:
+		assert.NotNil(t, err)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "Timeout waiting for the subscription to be closed")
+	}
+
+	// The subscription should only report that the connection was closed once
+	select {
+	case <-subscription.ErrChan:
+		assert.Fail(t, "Subscription reported closed connection twice")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	assert.Equal(t, subscription.LastSequence(), int64(42))
+}
+
+func TestSubscribeColonyEventsSubscribed(t *testing.T) {
+	subscribedEvent := core.CreateColonyEvent(core.GenerateRandomID(), "", core.SUBSCRIBED_EVENT, "", 0, "")
+	subscribedEvent.Sequence = 42
+	eventJSON, err := subscribedEvent.ToJSON()
+	assert.Nil(t, err)
+	rpcReplyMsg, err := rpc.CreateRPCReplyMsg(rpc.SubscribeColonyEventsPayloadType, eventJSON)
+	assert.Nil(t, err)
+	reply, err := rpcReplyMsg.ToJSON()
+	assert.Nil(t, err)
+
+	// The server only reports the cursor of the subscription and then closes the connection
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		wsConn, err := upgrader.Upgrade(w, r, nil)
+		assert.Nil(t, err)
+		_, _, err = wsConn.ReadMessage()
+		assert.Nil(t, err)
+		err = wsConn.WriteMessage(websocket.TextMessage, []byte(reply))
+		assert.Nil(t, err)
+		wsConn.Close()
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	assert.Nil(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	assert.Nil(t, err)
+
+	prvKey, err := crypto.CreateCrypto().GeneratePrivateKey()
+	assert.Nil(t, err)
+	client := CreateColoniesClient(serverURL.Hostname(), port, true, false)
+
+	subscription, err := client.SubscribeColonyEvents(subscribedEvent.ColonyID, nil, nil, 0, 100, prvKey)
+	assert.Nil(t, err)
+
+	select {
+	case <-subscription.EventChan:
+		assert.Fail(t, "The subscribed event should not be received")
+	case err := <-subscription.ErrChan:
+		assert.NotNil(t, err)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "Timeout waiting for the subscription to be closed")
+	}
+
+	assert.Equal(t, subscription.LastSequence(), int64(42))
+}
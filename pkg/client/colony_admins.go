@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
+)
+
+// AddColonyAdmin adds an identity that is allowed to administer the colony, prvKey must be the colony private key or
+// the private key of an existing admin
+func (client *ColoniesClient) AddColonyAdmin(colonyID string, adminID string, name string, prvKey string) (*core.ColonyAdmin, error) {
+	return client.AddColonyAdminWithContext(colonyID, adminID, name, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) AddColonyAdminWithContext(colonyID string, adminID string, name string, ctx context.Context, prvKey string) (*core.ColonyAdmin, error) {
+	msg := rpc.CreateAddColonyAdminMsg(core.CreateColonyAdmin(colonyID, adminID, name))
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.AddColonyAdminPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToColonyAdmin(respBodyString)
+}
+
+func (client *ColoniesClient) GetColonyAdmins(colonyID string, prvKey string) ([]*core.ColonyAdmin, error) {
+	return client.GetColonyAdminsWithContext(colonyID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetColonyAdminsWithContext(colonyID string, ctx context.Context, prvKey string) ([]*core.ColonyAdmin, error) {
+	msg := rpc.CreateGetColonyAdminsMsg(colonyID)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.GetColonyAdminsPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToColonyAdminArray(respBodyString)
+}
+
+func (client *ColoniesClient) DeleteColonyAdmin(colonyID string, adminID string, prvKey string) error {
+	return client.DeleteColonyAdminWithContext(colonyID, adminID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DeleteColonyAdminWithContext(colonyID string, adminID string, ctx context.Context, prvKey string) error {
+	msg := rpc.CreateDeleteColonyAdminMsg(colonyID, adminID)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.sendMessage(rpc.DeleteColonyAdminPayloadType, jsonString, prvKey, false, ctx)
+	return err
+}
@@ -0,0 +1,7 @@
+package client
+
+import "time"
+
+const DEFAULT_MAX_RETRIES = 3                        // Max number of retries of calls that do not change the state of the server
+const DEFAULT_RETRY_BACKOFF = 100 * time.Millisecond // Time to wait before the first retry, doubled after every retry
+const MAX_RETRY_BACKOFF = 5 * time.Second            // Max time to wait between two retries
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
+	"github.com/colonyos/colonies/pkg/security/crypto"
+)
+
+// RotateKey replaces the private key of a colony, or of an executor if executorID is set. The request is signed
+// with the current private key, prvKey, and the Id of the colony or executor is not changed. Once rotated, only
+// newPrvKey can be used.
+func (client *ColoniesClient) RotateKey(colonyID string, executorID string, newPrvKey string, prvKey string) (*core.IdentityKey, error) {
+	return client.RotateKeyWithContext(colonyID, executorID, newPrvKey, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) RotateKeyWithContext(colonyID string, executorID string, newPrvKey string, ctx context.Context, prvKey string) (*core.IdentityKey, error) {
+	identityID := colonyID
+	if executorID != "" {
+		identityID = executorID
+	}
+
+	newKeySignature, err := crypto.CreateCrypto().GenerateSignature(core.KeyRotationProof(identityID), newPrvKey)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := rpc.CreateRotateKeyMsg(colonyID, executorID, newKeySignature)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.RotateKeyPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToIdentityKey(respBodyString)
+}
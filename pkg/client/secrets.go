@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
+)
+
+// AddSecret adds a secret to a colony, or replaces the value of a secret with the same name. The value is
+// encrypted by the server and is never returned, processes reference it with core.CreateSecretRef
+func (client *ColoniesClient) AddSecret(secret *core.Secret, prvKey string) (*core.Secret, error) {
+	return client.AddSecretWithContext(secret, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) AddSecretWithContext(secret *core.Secret, ctx context.Context, prvKey string) (*core.Secret, error) {
+	msg := rpc.CreateAddSecretMsg(secret)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.AddSecretPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToSecret(respBodyString)
+}
+
+func (client *ColoniesClient) GetSecrets(colonyID string, prvKey string) ([]*core.Secret, error) {
+	return client.GetSecretsWithContext(colonyID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetSecretsWithContext(colonyID string, ctx context.Context, prvKey string) ([]*core.Secret, error) {
+	msg := rpc.CreateGetSecretsMsg(colonyID)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.GetSecretsPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToSecretArray(respBodyString)
+}
+
+func (client *ColoniesClient) DeleteSecret(colonyID string, name string, prvKey string) error {
+	return client.DeleteSecretWithContext(colonyID, name, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DeleteSecretWithContext(colonyID string, name string, ctx context.Context, prvKey string) error {
+	msg := rpc.CreateDeleteSecretMsg(colonyID, name)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.sendMessage(rpc.DeleteSecretPayloadType, jsonString, prvKey, false, ctx)
+	return err
+}
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"sync/atomic"
+
 	"github.com/colonyos/colonies/pkg/core"
 	"github.com/gorilla/websocket"
 )
@@ -14,7 +16,7 @@ type ProcessSubscription struct {
 func createProcessSubscription(wsConn *websocket.Conn) *ProcessSubscription {
 	subscription := &ProcessSubscription{}
 	subscription.ProcessChan = make(chan *core.Process)
-	subscription.ErrChan = make(chan error)
+	subscription.ErrChan = make(chan error, 1)
 	subscription.wsConn = wsConn
 
 	return subscription
@@ -23,3 +25,38 @@ func createProcessSubscription(wsConn *websocket.Conn) *ProcessSubscription {
 func (subscription *ProcessSubscription) Close() error {
 	return subscription.wsConn.Close()
 }
+
+type ColonyEventSubscription struct {
+	EventChan    chan *core.ColonyEvent
+	ErrChan      chan error
+	wsConn       *websocket.Conn
+	lastSequence int64
+}
+
+func createColonyEventSubscription(wsConn *websocket.Conn) *ColonyEventSubscription {
+	subscription := &ColonyEventSubscription{}
+	subscription.EventChan = make(chan *core.ColonyEvent)
+	subscription.ErrChan = make(chan error, 1)
+	subscription.wsConn = wsConn
+
+	return subscription
+}
+
+func (subscription *ColonyEventSubscription) Close() error {
+	return subscription.wsConn.Close()
+}
+
+// LastSequence returns the sequence number of the last event received, it can be passed as since to
+// SubscribeColonyEvents to resume the subscription without missing any events
+func (subscription *ColonyEventSubscription) LastSequence() int64 {
+	return atomic.LoadInt64(&subscription.lastSequence)
+}
+
+// closeWithError reports why the connection was closed, it never blocks since nobody may be listening to errChan
+// after the subscription has been closed
+func closeWithError(errChan chan error, err error) {
+	select {
+	case errChan <- err:
+	default:
+	}
+}
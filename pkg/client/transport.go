@@ -0,0 +1,34 @@
+package client
+
+import (
+	"context"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Transport sends a JSON encoded RPC message to a Colonies server and returns the JSON encoded RPC reply
+type Transport interface {
+	Send(ctx context.Context, jsonString string) (string, error)
+}
+
+// HTTPTransport sends RPC messages to the /api endpoint of a Colonies server
+type HTTPTransport struct {
+	restyClient *resty.Client
+	url         string
+}
+
+func createHTTPTransport(restyClient *resty.Client, url string) *HTTPTransport {
+	return &HTTPTransport{restyClient: restyClient, url: url}
+}
+
+func (transport *HTTPTransport) Send(ctx context.Context, jsonString string) (string, error) {
+	resp, err := transport.restyClient.R().
+		SetContext(ctx).
+		SetBody(jsonString).
+		Post(transport.url)
+	if err != nil {
+		return "", err
+	}
+
+	return string(resp.Body()), nil
+}
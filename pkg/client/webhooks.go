@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
+)
+
+// AddWebhook registers a webhook in a colony, the returned webhook contains the secret used to sign payloads, it
+// is generated by the server if not set and is never returned again
+func (client *ColoniesClient) AddWebhook(webhook *core.Webhook, prvKey string) (*core.Webhook, error) {
+	return client.AddWebhookWithContext(webhook, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) AddWebhookWithContext(webhook *core.Webhook, ctx context.Context, prvKey string) (*core.Webhook, error) {
+	msg := rpc.CreateAddWebhookMsg(webhook)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.AddWebhookPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToWebhook(respBodyString)
+}
+
+func (client *ColoniesClient) GetWebhooks(colonyID string, prvKey string) ([]*core.Webhook, error) {
+	return client.GetWebhooksWithContext(colonyID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetWebhooksWithContext(colonyID string, ctx context.Context, prvKey string) ([]*core.Webhook, error) {
+	msg := rpc.CreateGetWebhooksMsg(colonyID)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.GetWebhooksPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToWebhookArray(respBodyString)
+}
+
+func (client *ColoniesClient) DeleteWebhook(webhookID string, prvKey string) error {
+	return client.DeleteWebhookWithContext(webhookID, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) DeleteWebhookWithContext(webhookID string, ctx context.Context, prvKey string) error {
+	msg := rpc.CreateDeleteWebhookMsg(webhookID)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.sendMessage(rpc.DeleteWebhookPayloadType, jsonString, prvKey, false, ctx)
+	return err
+}
+
+func (client *ColoniesClient) GetWebhookDeliveries(webhookID string, count int, prvKey string) ([]*core.WebhookDelivery, error) {
+	return client.GetWebhookDeliveriesWithContext(webhookID, count, context.Background(), prvKey)
+}
+
+func (client *ColoniesClient) GetWebhookDeliveriesWithContext(webhookID string, count int, ctx context.Context, prvKey string) ([]*core.WebhookDelivery, error) {
+	msg := rpc.CreateGetWebhookDeliveriesMsg(webhookID, count)
+	jsonString, err := msg.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	respBodyString, err := client.sendMessage(rpc.GetWebhookDeliveriesPayloadType, jsonString, prvKey, false, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.ConvertJSONToWebhookDeliveryArray(respBodyString)
+}
@@ -0,0 +1,133 @@
+package core
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/security/crypto"
+	"github.com/google/uuid"
+)
+
+const ARTIFACT_REF_PREFIX = "artifact://"
+
+// Artifact describes a blob stored by the server, e.g. a model file produced by a process. The content is kept
+// in a storage backend and is identified by its SHA-256 hash, processes reference artifacts in their input or
+// output using CreateArtifactRef.
+type Artifact struct {
+	ID         string    `json:"artifactid"`
+	ColonyID   string    `json:"colonyid"`
+	Name       string    `json:"name"`
+	Hash       string    `json:"hash"`
+	Size       int64     `json:"size"`
+	Archive    bool      `json:"archive"` // True if the content is a gzipped tar archive of a directory
+	ExecutorID string    `json:"executorid"`
+	Added      time.Time `json:"added"`
+}
+
+func CreateArtifact(colonyID string, name string, hash string, size int64, archive bool) *Artifact {
+	uuid := uuid.New()
+	crypto := crypto.CreateCrypto()
+	id := crypto.GenerateHash(uuid.String())
+
+	return &Artifact{
+		ID:       id,
+		ColonyID: colonyID,
+		Name:     name,
+		Hash:     hash,
+		Size:     size,
+		Archive:  archive,
+		Added:    time.Now(),
+	}
+}
+
+// CreateArtifactRef returns a value that can be used as process input or output to reference an artifact
+func CreateArtifactRef(artifactID string) string {
+	return ARTIFACT_REF_PREFIX + artifactID
+}
+
+// ParseArtifactRef returns the artifact id of a value created by CreateArtifactRef
+func ParseArtifactRef(value interface{}) (string, bool) {
+	str, ok := value.(string)
+	if !ok || !strings.HasPrefix(str, ARTIFACT_REF_PREFIX) {
+		return "", false
+	}
+
+	artifactID := strings.TrimPrefix(str, ARTIFACT_REF_PREFIX)
+	if artifactID == "" {
+		return "", false
+	}
+
+	return artifactID, true
+}
+
+func ConvertJSONToArtifact(jsonString string) (*Artifact, error) {
+	var artifact *Artifact
+	err := json.Unmarshal([]byte(jsonString), &artifact)
+	if err != nil {
+		return nil, err
+	}
+
+	return artifact, nil
+}
+
+func ConvertJSONToArtifactArray(jsonString string) ([]*Artifact, error) {
+	var artifacts []*Artifact
+	err := json.Unmarshal([]byte(jsonString), &artifacts)
+	if err != nil {
+		return artifacts, err
+	}
+
+	return artifacts, nil
+}
+
+func ConvertArtifactArrayToJSON(artifacts []*Artifact) (string, error) {
+	jsonBytes, err := json.MarshalIndent(artifacts, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func IsArtifactArraysEqual(artifacts1 []*Artifact, artifacts2 []*Artifact) bool {
+	if len(artifacts1) != len(artifacts2) {
+		return false
+	}
+
+	for i := range artifacts1 {
+		if !artifacts1[i].Equals(artifacts2[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (artifact *Artifact) Equals(artifact2 *Artifact) bool {
+	if artifact2 == nil {
+		return false
+	}
+
+	if artifact.ID != artifact2.ID ||
+		artifact.ColonyID != artifact2.ColonyID ||
+		artifact.Name != artifact2.Name ||
+		artifact.Hash != artifact2.Hash ||
+		artifact.Size != artifact2.Size ||
+		artifact.Archive != artifact2.Archive ||
+		artifact.ExecutorID != artifact2.ExecutorID ||
+		artifact.Added.Unix() != artifact2.Added.Unix() {
+		return false
+	}
+
+	return true
+}
+
+func (artifact *Artifact) ToJSON() (string, error) {
+	jsonBytes, err := json.MarshalIndent(artifact, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateArtifact(t *testing.T) {
+	colonyID := GenerateRandomID()
+	hash := GenerateRandomID()
+	artifact := CreateArtifact(colonyID, "model.bin", hash, 1024, false)
+	assert.Len(t, artifact.ID, 64)
+	assert.Equal(t, artifact.ColonyID, colonyID)
+	assert.Equal(t, artifact.Name, "model.bin")
+	assert.Equal(t, artifact.Hash, hash)
+	assert.Equal(t, artifact.Size, int64(1024))
+	assert.False(t, artifact.Archive)
+}
+
+func TestArtifactRef(t *testing.T) {
+	artifactID := GenerateRandomID()
+	ref := CreateArtifactRef(artifactID)
+
+	parsedID, ok := ParseArtifactRef(ref)
+	assert.True(t, ok)
+	assert.Equal(t, parsedID, artifactID)
+
+	_, ok = ParseArtifactRef(artifactID)
+	assert.False(t, ok)
+	_, ok = ParseArtifactRef(ARTIFACT_REF_PREFIX)
+	assert.False(t, ok)
+	_, ok = ParseArtifactRef(1.0)
+	assert.False(t, ok)
+}
+
+func TestIsArtifactEquals(t *testing.T) {
+	artifact1 := CreateArtifact(GenerateRandomID(), "model.bin", GenerateRandomID(), 1024, false)
+	artifact2 := CreateArtifact(GenerateRandomID(), "data", GenerateRandomID(), 2048, true)
+
+	assert.True(t, artifact1.Equals(artifact1))
+	assert.False(t, artifact1.Equals(artifact2))
+	assert.False(t, artifact1.Equals(nil))
+}
+
+func TestArtifactToJSON(t *testing.T) {
+	artifact := CreateArtifact(GenerateRandomID(), "model.bin", GenerateRandomID(), 1024, false)
+
+	jsonStr, err := artifact.ToJSON()
+	assert.Nil(t, err)
+
+	artifact2, err := ConvertJSONToArtifact(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, artifact.Equals(artifact2))
+
+	_, err = ConvertJSONToArtifact(jsonStr + "error")
+	assert.NotNil(t, err)
+}
+
+func TestArtifactArrayToJSON(t *testing.T) {
+	artifact1 := CreateArtifact(GenerateRandomID(), "model.bin", GenerateRandomID(), 1024, false)
+	artifact2 := CreateArtifact(GenerateRandomID(), "data", GenerateRandomID(), 2048, true)
+
+	artifacts := []*Artifact{artifact1, artifact2}
+	jsonStr, err := ConvertArtifactArrayToJSON(artifacts)
+	assert.Nil(t, err)
+
+	artifacts2, err := ConvertJSONToArtifactArray(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, IsArtifactArraysEqual(artifacts, artifacts2))
+	assert.False(t, IsArtifactArraysEqual(artifacts, []*Artifact{artifact2, artifact1}))
+}
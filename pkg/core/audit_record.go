@@ -0,0 +1,129 @@
+package core
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/security/crypto"
+	"github.com/google/uuid"
+)
+
+// AuditRecord is an entry in the audit log, one record is stored every time a signed RPC call that changes the
+// state of the server is handled, whether the call succeeded or not
+type AuditRecord struct {
+	ID          string    `json:"auditrecordid"`
+	ColonyID    string    `json:"colonyid"`
+	RecoveredID string    `json:"recoveredid"`
+	PayloadType string    `json:"payloadtype"`
+	TargetIDs   []string  `json:"targetids"`
+	Status      int       `json:"status"`
+	Error       string    `json:"error"`
+	Time        time.Time `json:"time"`
+}
+
+func CreateAuditRecord(colonyID string, recoveredID string, payloadType string, targetIDs []string, status int, errMsg string) *AuditRecord {
+	uuid := uuid.New()
+	crypto := crypto.CreateCrypto()
+	id := crypto.GenerateHash(uuid.String())
+
+	if targetIDs == nil {
+		targetIDs = make([]string, 0)
+	}
+
+	return &AuditRecord{
+		ID:          id,
+		ColonyID:    colonyID,
+		RecoveredID: recoveredID,
+		PayloadType: payloadType,
+		TargetIDs:   targetIDs,
+		Status:      status,
+		Error:       errMsg,
+		Time:        time.Now(),
+	}
+}
+
+func ConvertJSONToAuditRecord(jsonString string) (*AuditRecord, error) {
+	var auditRecord *AuditRecord
+	err := json.Unmarshal([]byte(jsonString), &auditRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return auditRecord, nil
+}
+
+func ConvertJSONToAuditRecordArray(jsonString string) ([]*AuditRecord, error) {
+	var auditRecords []*AuditRecord
+	err := json.Unmarshal([]byte(jsonString), &auditRecords)
+	if err != nil {
+		return auditRecords, err
+	}
+
+	return auditRecords, nil
+}
+
+func ConvertAuditRecordArrayToJSON(auditRecords []*AuditRecord) (string, error) {
+	jsonBytes, err := json.MarshalIndent(auditRecords, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func IsAuditRecordArraysEqual(auditRecords1 []*AuditRecord, auditRecords2 []*AuditRecord) bool {
+	counter := 0
+	for _, auditRecord1 := range auditRecords1 {
+		for _, auditRecord2 := range auditRecords2 {
+			if auditRecord1.Equals(auditRecord2) {
+				counter++
+			}
+		}
+	}
+
+	if counter == len(auditRecords1) && counter == len(auditRecords2) {
+		return true
+	}
+
+	return false
+}
+
+func (auditRecord *AuditRecord) Successful() bool {
+	return auditRecord.Status >= 200 && auditRecord.Status < 300
+}
+
+func (auditRecord *AuditRecord) Equals(auditRecord2 *AuditRecord) bool {
+	if auditRecord2 == nil {
+		return false
+	}
+
+	if auditRecord.ID != auditRecord2.ID ||
+		auditRecord.ColonyID != auditRecord2.ColonyID ||
+		auditRecord.RecoveredID != auditRecord2.RecoveredID ||
+		auditRecord.PayloadType != auditRecord2.PayloadType ||
+		auditRecord.Status != auditRecord2.Status ||
+		auditRecord.Error != auditRecord2.Error ||
+		auditRecord.Time.Unix() != auditRecord2.Time.Unix() {
+		return false
+	}
+
+	if len(auditRecord.TargetIDs) != len(auditRecord2.TargetIDs) {
+		return false
+	}
+	for i := range auditRecord.TargetIDs {
+		if auditRecord.TargetIDs[i] != auditRecord2.TargetIDs[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (auditRecord *AuditRecord) ToJSON() (string, error) {
+	jsonBytes, err := json.MarshalIndent(auditRecord, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
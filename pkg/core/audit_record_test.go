@@ -0,0 +1,61 @@
+package core
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAuditRecord(t *testing.T) {
+	colonyID := GenerateRandomID()
+	recoveredID := GenerateRandomID()
+	processID := GenerateRandomID()
+	auditRecord := CreateAuditRecord(colonyID, recoveredID, "deleteprocessmsg", []string{"processid=" + processID}, http.StatusOK, "")
+	assert.Len(t, auditRecord.ID, 64)
+	assert.Equal(t, auditRecord.ColonyID, colonyID)
+	assert.Equal(t, auditRecord.RecoveredID, recoveredID)
+	assert.Equal(t, auditRecord.PayloadType, "deleteprocessmsg")
+	assert.Equal(t, auditRecord.TargetIDs, []string{"processid=" + processID})
+	assert.True(t, auditRecord.Successful())
+
+	auditRecord = CreateAuditRecord(colonyID, recoveredID, "deleteprocessmsg", nil, http.StatusForbidden, "Access denied")
+	assert.NotNil(t, auditRecord.TargetIDs)
+	assert.False(t, auditRecord.Successful())
+}
+
+func TestIsAuditRecordEquals(t *testing.T) {
+	auditRecord1 := CreateAuditRecord(GenerateRandomID(), GenerateRandomID(), "deleteprocessmsg", []string{"processid=1"}, http.StatusOK, "")
+	auditRecord2 := CreateAuditRecord(GenerateRandomID(), GenerateRandomID(), "deleteprocessmsg", []string{"processid=2"}, http.StatusOK, "")
+
+	assert.True(t, auditRecord1.Equals(auditRecord1))
+	assert.False(t, auditRecord1.Equals(auditRecord2))
+	assert.False(t, auditRecord1.Equals(nil))
+}
+
+func TestAuditRecordToJSON(t *testing.T) {
+	auditRecord := CreateAuditRecord(GenerateRandomID(), GenerateRandomID(), "approveexecutormsg", []string{"executorid=1"}, http.StatusForbidden, "Access denied")
+
+	jsonStr, err := auditRecord.ToJSON()
+	assert.Nil(t, err)
+
+	auditRecord2, err := ConvertJSONToAuditRecord(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, auditRecord.Equals(auditRecord2))
+
+	_, err = ConvertJSONToAuditRecord(jsonStr + "error")
+	assert.NotNil(t, err)
+}
+
+func TestAuditRecordArrayToJSON(t *testing.T) {
+	auditRecord1 := CreateAuditRecord(GenerateRandomID(), GenerateRandomID(), "deleteprocessmsg", []string{"processid=1"}, http.StatusOK, "")
+	auditRecord2 := CreateAuditRecord(GenerateRandomID(), GenerateRandomID(), "approveexecutormsg", []string{"executorid=1"}, http.StatusOK, "")
+
+	auditRecords := []*AuditRecord{auditRecord1, auditRecord2}
+	jsonStr, err := ConvertAuditRecordArrayToJSON(auditRecords)
+	assert.Nil(t, err)
+
+	auditRecords2, err := ConvertJSONToAuditRecordArray(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, IsAuditRecordArraysEqual(auditRecords, auditRecords2))
+}
@@ -0,0 +1,94 @@
+package core
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ColonyAdmin is an identity, i.e. the Id derived from a private key, that can administer a colony in addition to
+// the colony private key, e.g. approve executors, delete processes and manage crons. Admins are added and removed by
+// the colony owner or by other admins.
+type ColonyAdmin struct {
+	ColonyID string    `json:"colonyid"`
+	AdminID  string    `json:"adminid"`
+	Name     string    `json:"name"`
+	AddedBy  string    `json:"addedby"`
+	Added    time.Time `json:"added"`
+}
+
+func CreateColonyAdmin(colonyID string, adminID string, name string) *ColonyAdmin {
+	return &ColonyAdmin{
+		ColonyID: colonyID,
+		AdminID:  adminID,
+		Name:     name,
+		Added:    time.Now(),
+	}
+}
+
+func ConvertJSONToColonyAdmin(jsonString string) (*ColonyAdmin, error) {
+	var admin *ColonyAdmin
+	err := json.Unmarshal([]byte(jsonString), &admin)
+	if err != nil {
+		return nil, err
+	}
+
+	return admin, nil
+}
+
+func ConvertJSONToColonyAdminArray(jsonString string) ([]*ColonyAdmin, error) {
+	var admins []*ColonyAdmin
+	err := json.Unmarshal([]byte(jsonString), &admins)
+	if err != nil {
+		return admins, err
+	}
+
+	return admins, nil
+}
+
+func ConvertColonyAdminArrayToJSON(admins []*ColonyAdmin) (string, error) {
+	jsonBytes, err := json.MarshalIndent(admins, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func IsColonyAdminArraysEqual(admins1 []*ColonyAdmin, admins2 []*ColonyAdmin) bool {
+	if len(admins1) != len(admins2) {
+		return false
+	}
+
+	for i := range admins1 {
+		if !admins1[i].Equals(admins2[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (admin *ColonyAdmin) Equals(admin2 *ColonyAdmin) bool {
+	if admin2 == nil {
+		return false
+	}
+
+	if admin.ColonyID != admin2.ColonyID ||
+		admin.AdminID != admin2.AdminID ||
+		admin.Name != admin2.Name ||
+		admin.AddedBy != admin2.AddedBy ||
+		admin.Added.Unix() != admin2.Added.Unix() {
+		return false
+	}
+
+	return true
+}
+
+func (admin *ColonyAdmin) ToJSON() (string, error) {
+	jsonBytes, err := json.MarshalIndent(admin, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
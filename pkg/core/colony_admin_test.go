@@ -0,0 +1,47 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsColonyAdminEquals(t *testing.T) {
+	colonyID := GenerateRandomID()
+	admin1 := CreateColonyAdmin(colonyID, GenerateRandomID(), "alice")
+	admin2 := CreateColonyAdmin(colonyID, GenerateRandomID(), "bob")
+
+	assert.True(t, admin1.Equals(admin1))
+	assert.False(t, admin1.Equals(admin2))
+	assert.False(t, admin1.Equals(nil))
+}
+
+func TestColonyAdminToJSON(t *testing.T) {
+	admin := CreateColonyAdmin(GenerateRandomID(), GenerateRandomID(), "alice")
+	admin.AddedBy = GenerateRandomID()
+
+	jsonStr, err := admin.ToJSON()
+	assert.Nil(t, err)
+
+	admin2, err := ConvertJSONToColonyAdmin(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, admin.Equals(admin2))
+
+	_, err = ConvertJSONToColonyAdmin(jsonStr + "error")
+	assert.NotNil(t, err)
+}
+
+func TestColonyAdminArrayToJSON(t *testing.T) {
+	colonyID := GenerateRandomID()
+	admin1 := CreateColonyAdmin(colonyID, GenerateRandomID(), "alice")
+	admin2 := CreateColonyAdmin(colonyID, GenerateRandomID(), "bob")
+
+	admins := []*ColonyAdmin{admin1, admin2}
+	jsonStr, err := ConvertColonyAdminArrayToJSON(admins)
+	assert.Nil(t, err)
+
+	admins2, err := ConvertJSONToColonyAdminArray(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, IsColonyAdminArraysEqual(admins, admins2))
+	assert.False(t, IsColonyAdminArraysEqual(admins, []*ColonyAdmin{admin2, admin1}))
+}
@@ -0,0 +1,158 @@
+package core
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/security/crypto"
+	"github.com/google/uuid"
+)
+
+// Kinds of colony events, i.e. what kind of entity the event is about
+const (
+	PROCESS_KIND      = "process"
+	PROCESSGRAPH_KIND = "processgraph"
+	EXECUTOR_KIND     = "executor"
+	CRON_KIND         = "cron"
+)
+
+// Colony event types in addition to the process event types
+const (
+	ADDED_EVENT     = "added"
+	APPROVED_EVENT  = "approved"
+	REJECTED_EVENT  = "rejected"
+	REMOVED_EVENT   = "removed"
+	TRIGGERED_EVENT = "triggered"
+	OFFLINE_EVENT   = "offline"
+)
+
+// SUBSCRIBED_EVENT is sent first on a colony event subscription, its sequence number is the cursor the subscription
+// starts from so that it can be resumed even if no other events are received
+const SUBSCRIBED_EVENT = "subscribed"
+
+// ColonyEvent is sent to subscribers of a colony when a process, processgraph, executor or cron in the colony
+// changes, the target Id is the Id of the entity the event is about. The sequence number is assigned when the event
+// is stored and increases monotonically, it is used as a cursor to resume a subscription without missing events.
+type ColonyEvent struct {
+	ID         string    `json:"colonyeventid"`
+	Sequence   int64     `json:"sequence"`
+	ColonyID   string    `json:"colonyid"`
+	Kind       string    `json:"kind"`
+	Type       string    `json:"type"`
+	TargetID   string    `json:"targetid"`
+	State      int       `json:"state"`
+	ExecutorID string    `json:"executorid"`
+	Time       time.Time `json:"time"`
+}
+
+func CreateColonyEvent(colonyID string, kind string, eventType string, targetID string, state int, executorID string) *ColonyEvent {
+	uuid := uuid.New()
+	crypto := crypto.CreateCrypto()
+	id := crypto.GenerateHash(uuid.String())
+
+	return &ColonyEvent{
+		ID:         id,
+		ColonyID:   colonyID,
+		Kind:       kind,
+		Type:       eventType,
+		TargetID:   targetID,
+		State:      state,
+		ExecutorID: executorID,
+		Time:       time.Now(),
+	}
+}
+
+func CreateProcessColonyEvent(process *Process, eventType string, executorID string) *ColonyEvent {
+	return CreateColonyEvent(process.FunctionSpec.Conditions.ColonyID, PROCESS_KIND, eventType, process.ID, process.State, executorID)
+}
+
+func ConvertJSONToColonyEvent(jsonString string) (*ColonyEvent, error) {
+	var event *ColonyEvent
+	err := json.Unmarshal([]byte(jsonString), &event)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func ConvertJSONToColonyEventArray(jsonString string) ([]*ColonyEvent, error) {
+	var events []*ColonyEvent
+	err := json.Unmarshal([]byte(jsonString), &events)
+	if err != nil {
+		return events, err
+	}
+
+	return events, nil
+}
+
+func ConvertColonyEventArrayToJSON(events []*ColonyEvent) (string, error) {
+	jsonBytes, err := json.MarshalIndent(events, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func IsColonyEventArraysEqual(events1 []*ColonyEvent, events2 []*ColonyEvent) bool {
+	if len(events1) != len(events2) {
+		return false
+	}
+
+	for i := range events1 {
+		if !events1[i].Equals(events2[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Matches returns true if the event matches the filters, an empty filter matches all events
+func (event *ColonyEvent) Matches(kinds []string, eventTypes []string) bool {
+	return matchesFilter(event.Kind, kinds) && matchesFilter(event.Type, eventTypes)
+}
+
+func matchesFilter(value string, filter []string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+
+	for _, f := range filter {
+		if f == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (event *ColonyEvent) Equals(event2 *ColonyEvent) bool {
+	if event2 == nil {
+		return false
+	}
+
+	if event.ID != event2.ID ||
+		event.Sequence != event2.Sequence ||
+		event.ColonyID != event2.ColonyID ||
+		event.Kind != event2.Kind ||
+		event.Type != event2.Type ||
+		event.TargetID != event2.TargetID ||
+		event.State != event2.State ||
+		event.ExecutorID != event2.ExecutorID ||
+		event.Time.Unix() != event2.Time.Unix() {
+		return false
+	}
+
+	return true
+}
+
+func (event *ColonyEvent) ToJSON() (string, error) {
+	jsonBytes, err := json.MarshalIndent(event, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
@@ -0,0 +1,82 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateColonyEvent(t *testing.T) {
+	colonyID := GenerateRandomID()
+	executorID := GenerateRandomID()
+	funcSpec := CreateEmptyFunctionSpec()
+	funcSpec.Conditions.ColonyID = colonyID
+	process := CreateProcess(funcSpec)
+
+	event := CreateProcessColonyEvent(process, SUBMITTED_EVENT, executorID)
+	assert.Len(t, event.ID, 64)
+	assert.Equal(t, event.ColonyID, colonyID)
+	assert.Equal(t, event.Kind, PROCESS_KIND)
+	assert.Equal(t, event.Type, SUBMITTED_EVENT)
+	assert.Equal(t, event.TargetID, process.ID)
+	assert.Equal(t, event.State, WAITING)
+	assert.Equal(t, event.ExecutorID, executorID)
+}
+
+func TestColonyEventMatches(t *testing.T) {
+	event := CreateColonyEvent(GenerateRandomID(), EXECUTOR_KIND, ADDED_EVENT, GenerateRandomID(), PENDING, "")
+
+	assert.True(t, event.Matches(nil, nil))
+	assert.True(t, event.Matches([]string{PROCESS_KIND, EXECUTOR_KIND}, nil))
+	assert.True(t, event.Matches(nil, []string{ADDED_EVENT}))
+	assert.True(t, event.Matches([]string{EXECUTOR_KIND}, []string{ADDED_EVENT}))
+	assert.False(t, event.Matches([]string{PROCESS_KIND}, nil))
+	assert.False(t, event.Matches([]string{EXECUTOR_KIND}, []string{REMOVED_EVENT}))
+}
+
+func TestIsColonyEventEquals(t *testing.T) {
+	colonyID := GenerateRandomID()
+	event1 := CreateColonyEvent(colonyID, CRON_KIND, ADDED_EVENT, GenerateRandomID(), 0, "")
+	event2 := CreateColonyEvent(colonyID, CRON_KIND, TRIGGERED_EVENT, GenerateRandomID(), 0, "")
+
+	assert.True(t, event1.Equals(event1))
+	assert.False(t, event1.Equals(event2))
+
+	event3 := *event1
+	event3.Sequence = 42
+	assert.False(t, event1.Equals(&event3))
+	assert.False(t, event1.Equals(nil))
+}
+
+func TestColonyEventToJSON(t *testing.T) {
+	event := CreateColonyEvent(GenerateRandomID(), PROCESSGRAPH_KIND, SUCCESSFUL_EVENT, GenerateRandomID(), SUCCESS, GenerateRandomID())
+	event.Sequence = 42
+
+	jsonStr, err := event.ToJSON()
+	assert.Nil(t, err)
+
+	event2, err := ConvertJSONToColonyEvent(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, event.Equals(event2))
+
+	_, err = ConvertJSONToColonyEvent(jsonStr + "error")
+	assert.NotNil(t, err)
+}
+
+func TestColonyEventArrayToJSON(t *testing.T) {
+	colonyID := GenerateRandomID()
+	events := []*ColonyEvent{
+		CreateColonyEvent(colonyID, PROCESS_KIND, SUBMITTED_EVENT, GenerateRandomID(), WAITING, ""),
+		CreateColonyEvent(colonyID, EXECUTOR_KIND, APPROVED_EVENT, GenerateRandomID(), APPROVED, ""),
+	}
+
+	jsonStr, err := ConvertColonyEventArrayToJSON(events)
+	assert.Nil(t, err)
+
+	events2, err := ConvertJSONToColonyEventArray(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, IsColonyEventArraysEqual(events, events2))
+
+	_, err = ConvertJSONToColonyEventArray(jsonStr + "error")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,514 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// A condition is a small boolean expression evaluated over the outputs of the parents of a process, e.g.
+//
+//	output[0] > 0.5
+//	train.output[0].drift >= 0.3 && evaluate.output[1] == "ok"
+//
+// output refers to the outputs of all parents concatenated in the same way as the process Input, while
+// <nodename>.output refers to the output of a specific parent. Values can be indexed with [i] and fields
+// of JSON objects can be selected with .field. Supported operators are ==, !=, <, <=, >, >=, &&, || and !.
+
+// CONDITION_OUTPUT is the name conditions use for the outputs of all parents, it cannot be used as a node name
+const CONDITION_OUTPUT = "output"
+
+const (
+	tokenEOF = iota
+	tokenNumber
+	tokenString
+	tokenIdent
+	tokenOp
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+	tokenDot
+)
+
+type conditionToken struct {
+	kind  int
+	value string
+}
+
+type conditionExpr interface {
+	eval(ctx map[string]interface{}) (interface{}, error)
+}
+
+type literalExpr struct {
+	value interface{}
+}
+
+type refExpr struct {
+	path []interface{} // string for fields, int for indexes
+}
+
+type notExpr struct {
+	expr conditionExpr
+}
+
+type binaryExpr struct {
+	op    string
+	left  conditionExpr
+	right conditionExpr
+}
+
+type conditionParser struct {
+	tokens []conditionToken
+	pos    int
+}
+
+func tokenizeCondition(condition string) ([]conditionToken, error) {
+	var tokens []conditionToken
+	runes := []rune(condition)
+	i := 0
+	for i < len(runes) {
+		c := runes[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(':
+			tokens = append(tokens, conditionToken{kind: tokenLParen, value: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, conditionToken{kind: tokenRParen, value: ")"})
+			i++
+		case c == '[':
+			tokens = append(tokens, conditionToken{kind: tokenLBracket, value: "["})
+			i++
+		case c == ']':
+			tokens = append(tokens, conditionToken{kind: tokenRBracket, value: "]"})
+			i++
+		case c == '.':
+			tokens = append(tokens, conditionToken{kind: tokenDot, value: "."})
+			i++
+		case c == '&' || c == '|':
+			if i+1 >= len(runes) || runes[i+1] != c {
+				return nil, errors.New("Invalid operator <" + string(c) + "> in condition, did you mean <" + string(c) + string(c) + ">?")
+			}
+			if c == '&' {
+				tokens = append(tokens, conditionToken{kind: tokenAnd, value: "&&"})
+			} else {
+				tokens = append(tokens, conditionToken{kind: tokenOr, value: "||"})
+			}
+			i += 2
+		case c == '=' || c == '!' || c == '<' || c == '>':
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				tokens = append(tokens, conditionToken{kind: tokenOp, value: string(c) + "="})
+				i += 2
+			} else if c == '!' {
+				tokens = append(tokens, conditionToken{kind: tokenNot, value: "!"})
+				i++
+			} else if c == '=' {
+				return nil, errors.New("Invalid operator <=> in condition, did you mean <==>?")
+			} else {
+				tokens = append(tokens, conditionToken{kind: tokenOp, value: string(c)})
+				i++
+			}
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(runes) && runes[j] != c {
+				j++
+			}
+			if j >= len(runes) {
+				return nil, errors.New("Unterminated string in condition")
+			}
+			tokens = append(tokens, conditionToken{kind: tokenString, value: string(runes[i+1 : j])})
+			i = j + 1
+		case unicode.IsDigit(c) || (c == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			j := i + 1
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || runes[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, conditionToken{kind: tokenNumber, value: string(runes[i:j])})
+			i = j
+		case unicode.IsLetter(c) || c == '_':
+			j := i + 1
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_' || runes[j] == '-') {
+				j++
+			}
+			tokens = append(tokens, conditionToken{kind: tokenIdent, value: string(runes[i:j])})
+			i = j
+		default:
+			return nil, errors.New("Invalid character <" + string(c) + "> in condition")
+		}
+	}
+
+	tokens = append(tokens, conditionToken{kind: tokenEOF})
+	return tokens, nil
+}
+
+func parseCondition(condition string) (conditionExpr, error) {
+	tokens, err := tokenizeCondition(condition)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &conditionParser{tokens: tokens}
+	expr, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.peek().kind != tokenEOF {
+		return nil, errors.New("Unexpected <" + parser.peek().value + "> in condition")
+	}
+
+	return expr, nil
+}
+
+func (parser *conditionParser) peek() conditionToken {
+	return parser.tokens[parser.pos]
+}
+
+func (parser *conditionParser) next() conditionToken {
+	token := parser.tokens[parser.pos]
+	if token.kind != tokenEOF {
+		parser.pos++
+	}
+	return token
+}
+
+func (parser *conditionParser) parseOr() (conditionExpr, error) {
+	left, err := parser.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for parser.peek().kind == tokenOr {
+		parser.next()
+		right, err := parser.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryExpr{op: "||", left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (parser *conditionParser) parseAnd() (conditionExpr, error) {
+	left, err := parser.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for parser.peek().kind == tokenAnd {
+		parser.next()
+		right, err := parser.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryExpr{op: "&&", left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (parser *conditionParser) parseNot() (conditionExpr, error) {
+	if parser.peek().kind == tokenNot {
+		parser.next()
+		expr, err := parser.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &notExpr{expr: expr}, nil
+	}
+
+	return parser.parseComparison()
+}
+
+func (parser *conditionParser) parseComparison() (conditionExpr, error) {
+	left, err := parser.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	if parser.peek().kind == tokenOp {
+		op := parser.next().value
+		right, err := parser.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		return &binaryExpr{op: op, left: left, right: right}, nil
+	}
+
+	return left, nil
+}
+
+func (parser *conditionParser) parsePrimary() (conditionExpr, error) {
+	token := parser.next()
+	switch token.kind {
+	case tokenNumber:
+		value, err := strconv.ParseFloat(token.value, 64)
+		if err != nil {
+			return nil, errors.New("Invalid number <" + token.value + "> in condition")
+		}
+		return &literalExpr{value: value}, nil
+	case tokenString:
+		return &literalExpr{value: token.value}, nil
+	case tokenLParen:
+		expr, err := parser.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if parser.next().kind != tokenRParen {
+			return nil, errors.New("Missing <)> in condition")
+		}
+		return expr, nil
+	case tokenIdent:
+		switch token.value {
+		case "true":
+			return &literalExpr{value: true}, nil
+		case "false":
+			return &literalExpr{value: false}, nil
+		case "null":
+			return &literalExpr{value: nil}, nil
+		}
+		return parser.parseRef(token.value)
+	case tokenEOF:
+		return nil, errors.New("Unexpected end of condition")
+	default:
+		return nil, errors.New("Unexpected <" + token.value + "> in condition")
+	}
+}
+
+func (parser *conditionParser) parseRef(name string) (conditionExpr, error) {
+	ref := &refExpr{path: []interface{}{name}}
+	for {
+		switch parser.peek().kind {
+		case tokenDot:
+			parser.next()
+			token := parser.next()
+			if token.kind != tokenIdent {
+				return nil, errors.New("Expected a field name after <.> in condition")
+			}
+			ref.path = append(ref.path, token.value)
+		case tokenLBracket:
+			parser.next()
+			token := parser.next()
+			index, err := strconv.Atoi(token.value)
+			if token.kind != tokenNumber || err != nil || index < 0 {
+				return nil, errors.New("Expected a non-negative integer index in condition")
+			}
+			if parser.next().kind != tokenRBracket {
+				return nil, errors.New("Missing <]> in condition")
+			}
+			ref.path = append(ref.path, index)
+		default:
+			return ref, nil
+		}
+	}
+}
+
+func (expr *literalExpr) eval(ctx map[string]interface{}) (interface{}, error) {
+	return expr.value, nil
+}
+
+func (expr *refExpr) eval(ctx map[string]interface{}) (interface{}, error) {
+	var value interface{} = ctx
+	name := ""
+	for _, step := range expr.path {
+		switch s := step.(type) {
+		case string:
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, errors.New("Failed to evaluate condition, <" + name + "> is not an object")
+			}
+			value, ok = m[s]
+			if !ok {
+				return nil, errors.New("Failed to evaluate condition, <" + strings.TrimPrefix(name+"."+s, ".") + "> not found")
+			}
+			name = strings.TrimPrefix(name+"."+s, ".")
+		case int:
+			arr, ok := value.([]interface{})
+			if !ok {
+				return nil, errors.New("Failed to evaluate condition, <" + name + "> is not an array")
+			}
+			if s >= len(arr) {
+				return nil, errors.New("Failed to evaluate condition, index " + strconv.Itoa(s) + " out of range for <" + name + ">")
+			}
+			value = arr[s]
+			name = name + "[" + strconv.Itoa(s) + "]"
+		}
+	}
+
+	return value, nil
+}
+
+func (expr *notExpr) eval(ctx map[string]interface{}) (interface{}, error) {
+	value, err := expr.expr.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return !isTruthy(value), nil
+}
+
+func (expr *binaryExpr) eval(ctx map[string]interface{}) (interface{}, error) {
+	left, err := expr.left.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Short-circuit logical operators
+	switch expr.op {
+	case "&&":
+		if !isTruthy(left) {
+			return false, nil
+		}
+		right, err := expr.right.eval(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return isTruthy(right), nil
+	case "||":
+		if isTruthy(left) {
+			return true, nil
+		}
+		right, err := expr.right.eval(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return isTruthy(right), nil
+	}
+
+	right, err := expr.right.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	leftNumber, leftIsNumber := toNumber(left)
+	rightNumber, rightIsNumber := toNumber(right)
+
+	switch expr.op {
+	case "==":
+		if leftIsNumber && rightIsNumber {
+			return leftNumber == rightNumber, nil
+		}
+		return fmt.Sprint(left) == fmt.Sprint(right), nil
+	case "!=":
+		if leftIsNumber && rightIsNumber {
+			return leftNumber != rightNumber, nil
+		}
+		return fmt.Sprint(left) != fmt.Sprint(right), nil
+	}
+
+	var cmp int
+	if leftIsNumber && rightIsNumber {
+		if leftNumber < rightNumber {
+			cmp = -1
+		} else if leftNumber > rightNumber {
+			cmp = 1
+		}
+	} else {
+		leftStr, leftIsStr := left.(string)
+		rightStr, rightIsStr := right.(string)
+		if !leftIsStr || !rightIsStr {
+			return nil, errors.New("Failed to evaluate condition, cannot compare <" + fmt.Sprint(left) + "> and <" + fmt.Sprint(right) + "> with " + expr.op)
+		}
+		cmp = strings.Compare(leftStr, rightStr)
+	}
+
+	switch expr.op {
+	case "<":
+		return cmp < 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">":
+		return cmp > 0, nil
+	case ">=":
+		return cmp >= 0, nil
+	}
+
+	return nil, errors.New("Invalid operator <" + expr.op + "> in condition")
+}
+
+// Outputs are often passed as strings, e.g. by executors wrapping shell commands, so numeric strings
+// are compared as numbers
+func toNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, false
+		}
+		return f, true
+	}
+
+	return 0, false
+}
+
+func isTruthy(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case int:
+		return v != 0
+	case string:
+		b, err := strconv.ParseBool(v)
+		if err == nil {
+			return b
+		}
+		return v != ""
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+
+	return true
+}
+
+// VerifyCondition checks that a condition is syntactically valid without evaluating it
+func VerifyCondition(condition string) error {
+	_, err := parseCondition(condition)
+	return err
+}
+
+// EvalCondition evaluates a condition against the outputs of the given parent processes
+func EvalCondition(condition string, parents []*Process) (bool, error) {
+	expr, err := parseCondition(condition)
+	if err != nil {
+		return false, err
+	}
+
+	output := make([]interface{}, 0)
+	ctx := make(map[string]interface{})
+	for _, parent := range parents {
+		output = append(output, parent.Output...)
+		// Instances of a map node share the node name, so their outputs are collected
+		parentOutput := make([]interface{}, 0)
+		if node, ok := ctx[parent.FunctionSpec.NodeName].(map[string]interface{}); ok {
+			parentOutput = node["output"].([]interface{})
+		}
+		parentOutput = append(parentOutput, parent.Output...)
+		ctx[parent.FunctionSpec.NodeName] = map[string]interface{}{"output": parentOutput}
+	}
+	ctx[CONDITION_OUTPUT] = output
+
+	value, err := expr.eval(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return isTruthy(value), nil
+}
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createConditionTestParents() []*Process {
+	funcSpec1 := CreateEmptyFunctionSpec()
+	funcSpec1.NodeName = "train"
+	parent1 := CreateProcess(funcSpec1)
+	parent1.Output = []interface{}{map[string]interface{}{"drift": 0.4, "model": "m1"}, "ok"}
+
+	funcSpec2 := CreateEmptyFunctionSpec()
+	funcSpec2.NodeName = "evaluate"
+	parent2 := CreateProcess(funcSpec2)
+	parent2.Output = []interface{}{"0.7", true}
+
+	return []*Process{parent1, parent2}
+}
+
+func TestEvalCondition(t *testing.T) {
+	parents := createConditionTestParents()
+
+	conditions := map[string]bool{
+		"train.output[0].drift > 0.3":                       true,
+		"train.output[0].drift > 0.5":                       false,
+		"train.output[0].drift >= 0.4 && output[1] == 'ok'": true,
+		"train.output[0].model == \"m1\"":                   true,
+		"train.output[0].model != \"m1\"":                   false,
+		"evaluate.output[0] < 1":                            true, // Numeric strings are compared as numbers
+		"evaluate.output[0] > 1 || evaluate.output[1]":      true,
+		"!evaluate.output[1]":                               false,
+		"output[2] == 0.7":                                  true, // Outputs of all parents are concatenated
+		"(output[2] > 1 || output[3]) && !false":            true,
+		"output[1] <= 'ok'":                                 true,
+		"train.output[0].drift == -1":                       false,
+	}
+
+	for condition, expected := range conditions {
+		result, err := EvalCondition(condition, parents)
+		assert.Nil(t, err, condition)
+		assert.Equal(t, expected, result, condition)
+	}
+}
+
+func TestEvalConditionMapInstances(t *testing.T) {
+	var parents []*Process
+	for i := 0; i < 3; i++ {
+		funcSpec := CreateEmptyFunctionSpec()
+		funcSpec.NodeName = "train"
+		parent := CreateProcess(funcSpec)
+		parent.Output = []interface{}{i}
+		parents = append(parents, parent)
+	}
+
+	// Instances of a map node share the node name, their outputs are collected
+	result, err := EvalCondition("train.output[0] == 0 && train.output[2] == 2", parents)
+	assert.Nil(t, err)
+	assert.True(t, result)
+}
+
+func TestEvalConditionErrors(t *testing.T) {
+	parents := createConditionTestParents()
+
+	invalidConditions := []string{
+		"output[10] > 1",            // Out of range
+		"unknown.output[0] > 1",     // Unknown node
+		"train.output[0].speed > 1", // Unknown field
+		"train.output[1].drift",     // Not an object
+		"train.output[0] > 1",       // Cannot compare object with number
+		"output[0 > 1",
+		"output[0] = 1",
+		"output[0] & 1",
+		"output[0] > ",
+		"(output[0] > 1",
+		"'unterminated",
+		"output[0] > 1 1",
+		"output[0] # 1",
+	}
+
+	for _, condition := range invalidConditions {
+		_, err := EvalCondition(condition, parents)
+		assert.NotNil(t, err, condition)
+	}
+}
+
+func TestVerifyCondition(t *testing.T) {
+	assert.Nil(t, VerifyCondition("train.output[0].drift > 0.3"))
+	assert.Nil(t, VerifyCondition("unknown.output[10] > 1")) // Only the syntax is verified
+	assert.NotNil(t, VerifyCondition("output[0] >"))
+}
@@ -18,6 +18,7 @@ type Cron struct {
 	PrevProcessGraphID      string    `json:"prevprocessgraphid"`
 	WaitForPrevProcessGraph bool      `json:"waitforprevprocessgraph"`
 	CheckerPeriod           int       `json:"checkerperiod"`
+	Paused                  bool      `json:"paused"`
 }
 
 func CreateCron(colonyID string, name string, cronExpression string, interval int, random bool, workflowSpec string) *Cron {
@@ -91,7 +92,8 @@ func (cron *Cron) Equals(cron2 *Cron) bool {
 		cron.WorkflowSpec != cron2.WorkflowSpec ||
 		cron.PrevProcessGraphID != cron2.PrevProcessGraphID ||
 		cron.WaitForPrevProcessGraph != cron2.WaitForPrevProcessGraph ||
-		cron.CheckerPeriod != cron2.CheckerPeriod {
+		cron.CheckerPeriod != cron2.CheckerPeriod ||
+		cron.Paused != cron2.Paused {
 		same = false
 	}
 
@@ -22,6 +22,10 @@ func TestIsCronEquals(t *testing.T) {
 	assert.True(t, cron1.Equals(cron1))
 	assert.False(t, cron1.Equals(cron2))
 	assert.False(t, cron1.Equals(cron3))
+
+	cron4 := *cron1
+	cron4.Paused = true
+	assert.False(t, cron1.Equals(&cron4))
 }
 
 func TestIsCronArraysEquals(t *testing.T) {
@@ -57,6 +61,7 @@ func TestIsCronArraysEquals(t *testing.T) {
 func TestCronToJSON(t *testing.T) {
 	cron := CreateCron(GenerateRandomID(), "test_name1", "* * * * * *", 0, false, "workflow1")
 	cron.CheckerPeriod = 100
+	cron.Paused = true
 	jsonStr, err := cron.ToJSON()
 	assert.Nil(t, err)
 
@@ -2,24 +2,130 @@ package core
 
 import (
 	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// Error codes sent in failure replies, clients should use these instead of matching error messages
+const (
+	ERROR_NOT_FOUND         = "notfound"
+	ERROR_UNAUTHORIZED      = "unauthorized"
+	ERROR_NO_WORK_AVAILABLE = "noworkavailable"
+	ERROR_CONFLICT          = "conflict"
+	ERROR_QUOTA_EXCEEDED    = "quotaexceeded"
+	ERROR_VALIDATION        = "validation"
+	ERROR_INTERNAL          = "internal"
+)
+
+// Errors to compare against using errors.Is, e.g. errors.Is(err, core.ErrNotFound)
+var (
+	ErrNotFound        = &ColoniesError{Code: ERROR_NOT_FOUND, Message: "Not found"}
+	ErrUnauthorized    = &ColoniesError{Code: ERROR_UNAUTHORIZED, Message: "Unauthorized"}
+	ErrNoWorkAvailable = &ColoniesError{Code: ERROR_NO_WORK_AVAILABLE, Message: "No work available"}
+	ErrConflict        = &ColoniesError{Code: ERROR_CONFLICT, Message: "Conflict"}
+	ErrQuotaExceeded   = &ColoniesError{Code: ERROR_QUOTA_EXCEEDED, Message: "Quota exceeded"}
+	ErrValidation      = &ColoniesError{Code: ERROR_VALIDATION, Message: "Validation failed"}
+	ErrInternal        = &ColoniesError{Code: ERROR_INTERNAL, Message: "Internal error"}
 )
 
 type ColoniesError struct {
 	Status  int
+	Code    string
 	Message string
 }
 
+func CreateError(code string, message string) *ColoniesError {
+	return &ColoniesError{Status: ErrorCodeToStatus(code), Code: code, Message: message}
+}
+
 func (e *ColoniesError) Error() string {
 	return e.Message
 }
 
+// Is makes errors.Is match errors with the same error code
+func (e *ColoniesError) Is(target error) bool {
+	t, ok := target.(*ColoniesError)
+	if !ok {
+		return false
+	}
+
+	return t.Code != "" && t.Code == e.Code
+}
+
+// ErrorCode returns the error code of err, or an empty string if err has no error code
+func ErrorCode(err error) string {
+	var coloniesErr *ColoniesError
+	if errors.As(err, &coloniesErr) {
+		return coloniesErr.Code
+	}
+
+	return ""
+}
+
+// ErrorCodeToStatus returns the HTTP status code used for an error code
+func ErrorCodeToStatus(code string) int {
+	switch code {
+	case ERROR_NOT_FOUND, ERROR_NO_WORK_AVAILABLE:
+		return http.StatusNotFound
+	case ERROR_UNAUTHORIZED:
+		return http.StatusForbidden
+	case ERROR_CONFLICT:
+		return http.StatusConflict
+	case ERROR_QUOTA_EXCEEDED:
+		return http.StatusTooManyRequests
+	case ERROR_VALIDATION:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// StatusToErrorCode returns the error code used for a HTTP status code
+func StatusToErrorCode(status int) string {
+	switch status {
+	case http.StatusNotFound:
+		return ERROR_NOT_FOUND
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ERROR_UNAUTHORIZED
+	case http.StatusConflict:
+		return ERROR_CONFLICT
+	case http.StatusTooManyRequests, http.StatusRequestEntityTooLarge:
+		return ERROR_QUOTA_EXCEEDED
+	case http.StatusBadRequest:
+		return ERROR_VALIDATION
+	default:
+		return ERROR_INTERNAL
+	}
+}
+
 type Failure struct {
 	Status  int    `json:"status"`
+	Code    string `json:"code"`
 	Message string `json:"message"`
 }
 
 func CreateFailure(status int, message string) *Failure {
-	return &Failure{Status: status, Message: message}
+	return &Failure{Status: status, Code: StatusToErrorCode(status), Message: message}
+}
+
+func CreateFailureWithCode(status int, code string, message string) *Failure {
+	return &Failure{Status: status, Code: code, Message: message}
+}
+
+// CreateFailureFromError creates a failure from err, the error code of err is used if err has one, otherwise the
+// error code is derived from the status
+func CreateFailureFromError(err error, status int) *Failure {
+	code := ErrorCode(err)
+	if code == "" {
+		return CreateFailure(status, err.Error())
+	}
+
+	return CreateFailureWithCode(ErrorCodeToStatus(code), code, err.Error())
+}
+
+// ToError converts a failure reply to an error that can be matched with errors.Is and errors.As
+func (failure *Failure) ToError() *ColoniesError {
+	return &ColoniesError{Status: failure.Status, Code: failure.Code, Message: failure.Message}
 }
 
 func ConvertJSONToFailure(jsonString string) (*Failure, error) {
@@ -38,6 +144,7 @@ func (failure *Failure) Equals(failure2 *Failure) bool {
 	}
 
 	if failure.Status == failure2.Status &&
+		failure.Code == failure2.Code &&
 		failure.Message == failure2.Message {
 		return true
 	}
@@ -1,6 +1,9 @@
 package core
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -41,3 +44,37 @@ func TestFailureParseJSON(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, failure2.Equals(failure1))
 }
+
+func TestFailureErrorCodes(t *testing.T) {
+	failure := CreateFailure(http.StatusNotFound, "error_msg")
+	assert.Equal(t, ERROR_NOT_FOUND, failure.Code)
+
+	failure = CreateFailure(http.StatusForbidden, "error_msg")
+	assert.Equal(t, ERROR_UNAUTHORIZED, failure.Code)
+
+	failure = CreateFailureFromError(errors.New("error_msg"), http.StatusBadRequest)
+	assert.Equal(t, http.StatusBadRequest, failure.Status)
+	assert.Equal(t, ERROR_VALIDATION, failure.Code)
+
+	// The error code of a typed error overrides the status
+	failure = CreateFailureFromError(CreateError(ERROR_NO_WORK_AVAILABLE, "error_msg"), http.StatusBadRequest)
+	assert.Equal(t, http.StatusNotFound, failure.Status)
+	assert.Equal(t, ERROR_NO_WORK_AVAILABLE, failure.Code)
+	assert.Equal(t, "error_msg", failure.Message)
+
+	err := failure.ToError()
+	assert.Equal(t, "error_msg", err.Error())
+	assert.True(t, errors.Is(err, ErrNoWorkAvailable))
+	assert.False(t, errors.Is(err, ErrNotFound))
+
+	wrappedErr := fmt.Errorf("wrapped: %w", err)
+	assert.True(t, errors.Is(wrappedErr, ErrNoWorkAvailable))
+	assert.Equal(t, ERROR_NO_WORK_AVAILABLE, ErrorCode(wrappedErr))
+
+	var coloniesErr *ColoniesError
+	assert.True(t, errors.As(wrappedErr, &coloniesErr))
+	assert.Equal(t, http.StatusNotFound, coloniesErr.Status)
+
+	assert.Equal(t, "", ErrorCode(errors.New("error_msg")))
+	assert.False(t, errors.Is(&ColoniesError{Message: "error_msg"}, &ColoniesError{Message: "error_msg"}))
+}
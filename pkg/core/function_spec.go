@@ -2,6 +2,7 @@ package core
 
 import (
 	"encoding/json"
+	"errors"
 )
 
 type Conditions struct {
@@ -11,17 +12,79 @@ type Conditions struct {
 	Dependencies []string `json:"dependencies"`
 }
 
+// EnvVars are the environment variables of a function. A value can reference a colony secret, see CreateSecretRef,
+// in JSON such a value is written as {"secretref": "name"}.
+type EnvVars map[string]string
+
+type secretRefJSON struct {
+	SecretRef string `json:"secretref"`
+}
+
+func (env EnvVars) MarshalJSON() ([]byte, error) {
+	if env == nil {
+		return []byte("null"), nil
+	}
+
+	values := make(map[string]interface{}, len(env))
+	for key, value := range env {
+		if name, ok := ParseSecretRef(value); ok {
+			values[key] = secretRefJSON{SecretRef: name}
+		} else {
+			values[key] = value
+		}
+	}
+
+	return json.Marshal(values)
+}
+
+func (env *EnvVars) UnmarshalJSON(data []byte) error {
+	var values map[string]json.RawMessage
+	err := json.Unmarshal(data, &values)
+	if err != nil {
+		return err
+	}
+
+	if values == nil {
+		*env = nil
+		return nil
+	}
+
+	parsedEnv := make(EnvVars, len(values))
+	for key, rawValue := range values {
+		var value string
+		if err := json.Unmarshal(rawValue, &value); err == nil {
+			parsedEnv[key] = value
+			continue
+		}
+
+		var ref secretRefJSON
+		if err := json.Unmarshal(rawValue, &ref); err != nil || ref.SecretRef == "" {
+			return errors.New("Invalid value of env variable " + key + ", must be a string or a secret reference")
+		}
+		parsedEnv[key] = CreateSecretRef(ref.SecretRef)
+	}
+	*env = parsedEnv
+
+	return nil
+}
+
 type FunctionSpec struct {
-	NodeName    string            `json:"nodename"`
-	FuncName    string            `json:"funcname"`
-	Args        []interface{}     `json:"args"`
-	Priority    int               `json:"priority"`
-	MaxWaitTime int               `json:"maxwaittime"`
-	MaxExecTime int               `json:"maxexectime"`
-	MaxRetries  int               `json:"maxretries"`
-	Conditions  Conditions        `json:"conditions"`
-	Label       string            `json:"label"`
-	Env         map[string]string `json:"env"`
+	NodeName     string            `json:"nodename"`
+	FuncName     string            `json:"funcname"`
+	Args         []interface{}     `json:"args"`
+	Priority     int               `json:"priority"`
+	MaxWaitTime  int               `json:"maxwaittime"`
+	MaxExecTime  int               `json:"maxexectime"`
+	MaxRetries   int               `json:"maxretries"`
+	Conditions   Conditions        `json:"conditions"`
+	Label        string            `json:"label"`
+	Env          EnvVars           `json:"env"`
+	Condition    string            `json:"condition"`
+	Map          bool              `json:"map"`
+	MaxParallel  int               `json:"maxparallel"`
+	Workflow     *WorkflowSpec     `json:"workflow"`
+	Inputs       map[string]string `json:"inputs"`
+	AllowFailure bool              `json:"allowfailure"`
 }
 
 func CreateEmptyFunctionSpec() *FunctionSpec {
@@ -77,7 +140,18 @@ func (funcSpec *FunctionSpec) Equals(funcSpec2 *FunctionSpec) bool {
 		funcSpec.Conditions.ColonyID != funcSpec2.Conditions.ColonyID ||
 		funcSpec.Conditions.ExecutorType != funcSpec2.Conditions.ExecutorType ||
 		funcSpec.Priority != funcSpec2.Priority ||
-		funcSpec.Label != funcSpec2.Label {
+		funcSpec.Label != funcSpec2.Label ||
+		funcSpec.Condition != funcSpec2.Condition ||
+		funcSpec.Map != funcSpec2.Map ||
+		funcSpec.MaxParallel != funcSpec2.MaxParallel {
+		same = false
+	}
+
+	if funcSpec.Workflow != nil && funcSpec2.Workflow == nil {
+		same = false
+	} else if funcSpec.Workflow == nil && funcSpec2.Workflow != nil {
+		same = false
+	} else if funcSpec.Workflow != nil && !funcSpec.Workflow.Equals(funcSpec2.Workflow) {
 		same = false
 	}
 
@@ -152,6 +226,20 @@ func (funcSpec *FunctionSpec) Equals(funcSpec2 *FunctionSpec) bool {
 		}
 	}
 
+	if funcSpec.AllowFailure != funcSpec2.AllowFailure {
+		same = false
+	}
+
+	if len(funcSpec.Inputs) != len(funcSpec2.Inputs) {
+		same = false
+	} else {
+		for k, v := range funcSpec.Inputs {
+			if v2, ok := funcSpec2.Inputs[k]; !ok || v != v2 {
+				same = false
+			}
+		}
+	}
+
 	return same
 }
 
@@ -75,3 +75,41 @@ func TestFunctionSpecEquals(t *testing.T) {
 	assert.False(t, functionSpec1.Equals(nil))
 	assert.False(t, functionSpec1.Equals(functionSpec2))
 }
+
+func TestFunctionSpecWithWorkflow(t *testing.T) {
+	subFuncSpec := CreateEmptyFunctionSpec()
+	subFuncSpec.NodeName = "sub_task"
+
+	funcSpec := CreateEmptyFunctionSpec()
+	funcSpec.NodeName = "task"
+	funcSpec.MaxWaitTime = -1
+	funcSpec.Workflow = CreateWorkflowSpec(GenerateRandomID())
+	funcSpec.Workflow.AddFunctionSpec(subFuncSpec)
+
+	jsonStr, err := funcSpec.ToJSON()
+	assert.Nil(t, err)
+
+	funcSpec2, err := ConvertJSONToFunctionSpec(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, funcSpec.Equals(funcSpec2))
+
+	funcSpec2.Workflow = nil
+	assert.False(t, funcSpec.Equals(funcSpec2))
+}
+
+func TestFunctionSpecWithInputs(t *testing.T) {
+	funcSpec := CreateEmptyFunctionSpec()
+	funcSpec.NodeName = "evaluate"
+	funcSpec.MaxWaitTime = -1
+	funcSpec.Inputs = map[string]string{"model": "train.out.model"}
+
+	jsonStr, err := funcSpec.ToJSON()
+	assert.Nil(t, err)
+
+	funcSpec2, err := ConvertJSONToFunctionSpec(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, funcSpec.Equals(funcSpec2))
+
+	funcSpec2.Inputs["model"] = "train.out.weights"
+	assert.False(t, funcSpec.Equals(funcSpec2))
+}
@@ -16,6 +16,7 @@ type Generator struct {
 	LastRun       time.Time `json:"lastrun"`
 	QueueSize     int       `json:"queuesize"`
 	CheckerPeriod int       `json:"checkerperiod"`
+	Paused        bool      `json:"paused"`
 }
 
 func CreateGenerator(colonyID string, name string, workflowSpec string, trigger int, timeout int) *Generator {
@@ -94,7 +95,8 @@ func (generator *Generator) Equals(generator2 *Generator) bool {
 		generator.Trigger != generator2.Trigger ||
 		generator.Timeout != generator2.Timeout ||
 		generator.CheckerPeriod != generator2.CheckerPeriod ||
-		generator.QueueSize != generator2.QueueSize {
+		generator.QueueSize != generator2.QueueSize ||
+		generator.Paused != generator2.Paused {
 		same = false
 	}
 
@@ -21,6 +21,7 @@ func TestCreateGenerator(t *testing.T) {
 	generator.ID = GenerateRandomID()
 	generator.QueueSize = 100
 	generator.CheckerPeriod = 200
+	generator.Paused = true
 	jsonStr, err = generator.ToJSON()
 	assert.Nil(t, err)
 
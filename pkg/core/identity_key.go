@@ -0,0 +1,66 @@
+package core
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const KEY_ROTATION_PROOF_PREFIX = "rotatekey:"
+
+// IdentityKey maps the Id derived from a rotated private key to the stable Id of a colony or executor. Colony and
+// executor Ids are derived from their original private keys, after a rotation the Id remains the same but requests
+// must be signed with the new private key.
+type IdentityKey struct {
+	KeyID      string    `json:"keyid"`
+	IdentityID string    `json:"identityid"`
+	ColonyID   string    `json:"colonyid"`
+	Rotated    time.Time `json:"rotated"`
+}
+
+func CreateIdentityKey(keyID string, identityID string, colonyID string) *IdentityKey {
+	return &IdentityKey{
+		KeyID:      keyID,
+		IdentityID: identityID,
+		ColonyID:   colonyID,
+		Rotated:    time.Now(),
+	}
+}
+
+// KeyRotationProof returns the data the new private key signs to prove that it is held by the one rotating the key
+func KeyRotationProof(identityID string) string {
+	return KEY_ROTATION_PROOF_PREFIX + identityID
+}
+
+func ConvertJSONToIdentityKey(jsonString string) (*IdentityKey, error) {
+	var identityKey *IdentityKey
+	err := json.Unmarshal([]byte(jsonString), &identityKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return identityKey, nil
+}
+
+func (identityKey *IdentityKey) Equals(identityKey2 *IdentityKey) bool {
+	if identityKey2 == nil {
+		return false
+	}
+
+	if identityKey.KeyID != identityKey2.KeyID ||
+		identityKey.IdentityID != identityKey2.IdentityID ||
+		identityKey.ColonyID != identityKey2.ColonyID ||
+		identityKey.Rotated.Unix() != identityKey2.Rotated.Unix() {
+		return false
+	}
+
+	return true
+}
+
+func (identityKey *IdentityKey) ToJSON() (string, error) {
+	jsonBytes, err := json.MarshalIndent(identityKey, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
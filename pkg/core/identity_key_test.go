@@ -0,0 +1,42 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateIdentityKey(t *testing.T) {
+	keyID := GenerateRandomID()
+	identityID := GenerateRandomID()
+	colonyID := GenerateRandomID()
+
+	identityKey := CreateIdentityKey(keyID, identityID, colonyID)
+	assert.Equal(t, identityKey.KeyID, keyID)
+	assert.Equal(t, identityKey.IdentityID, identityID)
+	assert.Equal(t, identityKey.ColonyID, colonyID)
+	assert.Equal(t, KeyRotationProof(identityID), KEY_ROTATION_PROOF_PREFIX+identityID)
+}
+
+func TestIdentityKeyToJSON(t *testing.T) {
+	identityKey := CreateIdentityKey(GenerateRandomID(), GenerateRandomID(), GenerateRandomID())
+
+	jsonStr, err := identityKey.ToJSON()
+	assert.Nil(t, err)
+
+	identityKey2, err := ConvertJSONToIdentityKey(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, identityKey.Equals(identityKey2))
+
+	_, err = ConvertJSONToIdentityKey("invalid json")
+	assert.NotNil(t, err)
+}
+
+func TestIdentityKeyEquals(t *testing.T) {
+	identityKey1 := CreateIdentityKey(GenerateRandomID(), GenerateRandomID(), GenerateRandomID())
+	identityKey2 := CreateIdentityKey(GenerateRandomID(), identityKey1.IdentityID, identityKey1.ColonyID)
+
+	assert.True(t, identityKey1.Equals(identityKey1))
+	assert.False(t, identityKey1.Equals(identityKey2))
+	assert.False(t, identityKey1.Equals(nil))
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"errors"
+	"strings"
+)
+
+// An input binding selects data produced by a parent process, e.g.
+//
+//	train.out.model
+//	preprocess.out
+//
+// <nodename>.out refers to the output array of the parent, while <nodename>.out.<name> refers to a named output.
+// If several parents have the same node name, e.g. instances of a map node, the values are collected in an array.
+
+type inputBinding struct {
+	nodeName string
+	name     string
+}
+
+func parseInputBinding(binding string) (*inputBinding, error) {
+	parts := strings.SplitN(binding, ".", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] != "out" {
+		return nil, errors.New("Invalid input binding <" + binding + ">, expected <nodename>.out or <nodename>.out.<name>")
+	}
+
+	if len(parts) == 3 {
+		if parts[2] == "" {
+			return nil, errors.New("Invalid input binding <" + binding + ">, output name is empty")
+		}
+		return &inputBinding{nodeName: parts[0], name: parts[2]}, nil
+	}
+
+	return &inputBinding{nodeName: parts[0]}, nil
+}
+
+// VerifyInputBinding returns the node name referenced by an input binding, or an error if the binding is invalid
+func VerifyInputBinding(binding string) (string, error) {
+	parsedBinding, err := parseInputBinding(binding)
+	if err != nil {
+		return "", err
+	}
+
+	return parsedBinding.nodeName, nil
+}
+
+// ResolveInputBindings resolves the input bindings of a function spec against the outputs of the parents
+func ResolveInputBindings(inputs map[string]string, parents []*Process) (map[string]interface{}, error) {
+	namedInput := make(map[string]interface{})
+	for name, binding := range inputs {
+		parsedBinding, err := parseInputBinding(binding)
+		if err != nil {
+			return nil, err
+		}
+
+		var matches []*Process
+		for _, parent := range parents {
+			if parent.FunctionSpec.NodeName == parsedBinding.nodeName {
+				matches = append(matches, parent)
+			}
+		}
+		if len(matches) == 0 {
+			return nil, errors.New("Failed to resolve input <" + name + ">, <" + parsedBinding.nodeName + "> is not a parent")
+		}
+
+		if parsedBinding.name == "" {
+			output := make([]interface{}, 0)
+			for _, match := range matches {
+				output = append(output, match.Output...)
+			}
+			namedInput[name] = output
+			continue
+		}
+
+		var values []interface{}
+		for _, match := range matches {
+			value, ok := match.NamedOutput[parsedBinding.name]
+			if !ok {
+				return nil, errors.New("Failed to resolve input <" + name + ">, <" + parsedBinding.nodeName + "> has no output named <" + parsedBinding.name + ">")
+			}
+			values = append(values, value)
+		}
+		if len(values) == 1 {
+			namedInput[name] = values[0]
+		} else {
+			namedInput[name] = values
+		}
+	}
+
+	return namedInput, nil
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createInputBindingTestProcess(nodeName string, output []interface{}, namedOutput map[string]interface{}) *Process {
+	funcSpec := CreateEmptyFunctionSpec()
+	funcSpec.NodeName = nodeName
+	process := CreateProcess(funcSpec)
+	process.Output = output
+	process.NamedOutput = namedOutput
+	return process
+}
+
+func TestVerifyInputBinding(t *testing.T) {
+	nodeName, err := VerifyInputBinding("train.out.model")
+	assert.Nil(t, err)
+	assert.Equal(t, nodeName, "train")
+
+	nodeName, err = VerifyInputBinding("train.out")
+	assert.Nil(t, err)
+	assert.Equal(t, nodeName, "train")
+
+	_, err = VerifyInputBinding("train")
+	assert.NotNil(t, err)
+	_, err = VerifyInputBinding("train.output.model")
+	assert.NotNil(t, err)
+	_, err = VerifyInputBinding("train.out.")
+	assert.NotNil(t, err)
+	_, err = VerifyInputBinding(".out.model")
+	assert.NotNil(t, err)
+}
+
+func TestResolveInputBindings(t *testing.T) {
+	train := createInputBindingTestProcess("train", []interface{}{"log"}, map[string]interface{}{"model": "model.bin"})
+	fetch := createInputBindingTestProcess("fetch", []interface{}{"data1", "data2"}, map[string]interface{}{})
+	parents := []*Process{train, fetch}
+
+	namedInput, err := ResolveInputBindings(map[string]string{"model": "train.out.model", "data": "fetch.out"}, parents)
+	assert.Nil(t, err)
+	assert.Equal(t, namedInput["model"], "model.bin")
+	assert.Equal(t, namedInput["data"], []interface{}{"data1", "data2"})
+
+	_, err = ResolveInputBindings(map[string]string{"model": "train.out.weights"}, parents)
+	assert.NotNil(t, err) // No such named output
+
+	_, err = ResolveInputBindings(map[string]string{"model": "evaluate.out.model"}, parents)
+	assert.NotNil(t, err) // Not a parent
+}
+
+func TestResolveInputBindingsMapInstances(t *testing.T) {
+	instance1 := createInputBindingTestProcess("train", []interface{}{"a"}, map[string]interface{}{"model": "model1.bin"})
+	instance2 := createInputBindingTestProcess("train", []interface{}{"b"}, map[string]interface{}{"model": "model2.bin"})
+	parents := []*Process{instance1, instance2}
+
+	namedInput, err := ResolveInputBindings(map[string]string{"models": "train.out.model", "out": "train.out"}, parents)
+	assert.Nil(t, err)
+	assert.Equal(t, namedInput["models"], []interface{}{"model1.bin", "model2.bin"})
+	assert.Equal(t, namedInput["out"], []interface{}{"a", "b"})
+}
@@ -0,0 +1,113 @@
+package core
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/security/crypto"
+	"github.com/google/uuid"
+)
+
+// Log is a chunk of output streamed by the executor running a process, the chunks of a process
+// together form a byte stream and Offset is the position of the chunk in that stream
+type Log struct {
+	ID         string    `json:"logid"`
+	ProcessID  string    `json:"processid"`
+	ColonyID   string    `json:"colonyid"`
+	ExecutorID string    `json:"executorid"`
+	Offset     int64     `json:"offset"`
+	Message    string    `json:"message"`
+	Time       time.Time `json:"time"`
+}
+
+func CreateLog(process *Process, executorID string, offset int64, message string) *Log {
+	uuid := uuid.New()
+	crypto := crypto.CreateCrypto()
+	id := crypto.GenerateHash(uuid.String())
+
+	return &Log{
+		ID:         id,
+		ProcessID:  process.ID,
+		ColonyID:   process.FunctionSpec.Conditions.ColonyID,
+		ExecutorID: executorID,
+		Offset:     offset,
+		Message:    message,
+		Time:       time.Now(),
+	}
+}
+
+func ConvertJSONToLog(jsonString string) (*Log, error) {
+	var log *Log
+	err := json.Unmarshal([]byte(jsonString), &log)
+	if err != nil {
+		return nil, err
+	}
+
+	return log, nil
+}
+
+func ConvertJSONToLogArray(jsonString string) ([]*Log, error) {
+	var logs []*Log
+	err := json.Unmarshal([]byte(jsonString), &logs)
+	if err != nil {
+		return logs, err
+	}
+
+	return logs, nil
+}
+
+func ConvertLogArrayToJSON(logs []*Log) (string, error) {
+	jsonBytes, err := json.MarshalIndent(logs, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func IsLogArraysEqual(logs1 []*Log, logs2 []*Log) bool {
+	if len(logs1) != len(logs2) {
+		return false
+	}
+
+	// The order matters, logs are sorted by offset
+	for i := range logs1 {
+		if !logs1[i].Equals(logs2[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// End returns the offset of the byte following the chunk, i.e. the offset to use when fetching the next chunk
+func (log *Log) End() int64 {
+	return log.Offset + int64(len(log.Message))
+}
+
+func (log *Log) Equals(log2 *Log) bool {
+	if log2 == nil {
+		return false
+	}
+
+	if log.ID != log2.ID ||
+		log.ProcessID != log2.ProcessID ||
+		log.ColonyID != log2.ColonyID ||
+		log.ExecutorID != log2.ExecutorID ||
+		log.Offset != log2.Offset ||
+		log.Message != log2.Message ||
+		log.Time.Unix() != log2.Time.Unix() {
+		return false
+	}
+
+	return true
+}
+
+func (log *Log) ToJSON() (string, error) {
+	jsonBytes, err := json.MarshalIndent(log, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateLog(t *testing.T) {
+	colonyID := GenerateRandomID()
+	executorID := GenerateRandomID()
+	funcSpec := CreateEmptyFunctionSpec()
+	funcSpec.Conditions.ColonyID = colonyID
+	process := CreateProcess(funcSpec)
+
+	log := CreateLog(process, executorID, 10, "hello\n")
+	assert.Len(t, log.ID, 64)
+	assert.Equal(t, log.ProcessID, process.ID)
+	assert.Equal(t, log.ColonyID, colonyID)
+	assert.Equal(t, log.ExecutorID, executorID)
+	assert.Equal(t, log.Offset, int64(10))
+	assert.Equal(t, log.End(), int64(16))
+}
+
+func TestIsLogEquals(t *testing.T) {
+	process := CreateProcess(CreateEmptyFunctionSpec())
+	log1 := CreateLog(process, GenerateRandomID(), 0, "hello")
+	log2 := CreateLog(process, GenerateRandomID(), 5, "world")
+
+	assert.True(t, log1.Equals(log1))
+	assert.False(t, log1.Equals(log2))
+	assert.False(t, log1.Equals(nil))
+}
+
+func TestLogToJSON(t *testing.T) {
+	log := CreateLog(CreateProcess(CreateEmptyFunctionSpec()), GenerateRandomID(), 0, "hello")
+
+	jsonStr, err := log.ToJSON()
+	assert.Nil(t, err)
+
+	log2, err := ConvertJSONToLog(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, log.Equals(log2))
+
+	_, err = ConvertJSONToLog(jsonStr + "error")
+	assert.NotNil(t, err)
+}
+
+func TestLogArrayToJSON(t *testing.T) {
+	process := CreateProcess(CreateEmptyFunctionSpec())
+	log1 := CreateLog(process, GenerateRandomID(), 0, "hello")
+	log2 := CreateLog(process, GenerateRandomID(), 5, "world")
+
+	logs := []*Log{log1, log2}
+	jsonStr, err := ConvertLogArrayToJSON(logs)
+	assert.Nil(t, err)
+
+	logs2, err := ConvertJSONToLogArray(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, IsLogArraysEqual(logs, logs2))
+	assert.False(t, IsLogArraysEqual(logs, []*Log{log2, log1}))
+}
@@ -14,31 +14,34 @@ const (
 	RUNNING     = 1
 	SUCCESS     = 2
 	FAILED      = 3
+	SKIPPED     = 4
 )
 
 const NOTSET = -1
 
 type Process struct {
-	ID                 string        `json:"processid"`
-	AssignedExecutorID string        `json:"assignedexecutorid"`
-	IsAssigned         bool          `json:"isassigned"`
-	State              int           `json:"state"`
-	PriorityTime       int64         `json:"prioritytime"`
-	SubmissionTime     time.Time     `json:"submissiontime"`
-	StartTime          time.Time     `json:"starttime"`
-	EndTime            time.Time     `json:"endtime"`
-	WaitDeadline       time.Time     `json:"waitdeadline"`
-	ExecDeadline       time.Time     `json:"execdeadline"`
-	Retries            int           `json:"retries"`
-	Attributes         []Attribute   `json:"attributes"`
-	FunctionSpec       FunctionSpec  `json:"spec"`
-	WaitForParents     bool          `json:"waitforparents"`
-	Parents            []string      `json:"parents"`
-	Children           []string      `json:"children"`
-	ProcessGraphID     string        `json:"processgraphid"`
-	Input              []interface{} `json:"in"`
-	Output             []interface{} `json:"out"`
-	Errors             []string      `json:"errors"`
+	ID                 string                 `json:"processid"`
+	AssignedExecutorID string                 `json:"assignedexecutorid"`
+	IsAssigned         bool                   `json:"isassigned"`
+	State              int                    `json:"state"`
+	PriorityTime       int64                  `json:"prioritytime"`
+	SubmissionTime     time.Time              `json:"submissiontime"`
+	StartTime          time.Time              `json:"starttime"`
+	EndTime            time.Time              `json:"endtime"`
+	WaitDeadline       time.Time              `json:"waitdeadline"`
+	ExecDeadline       time.Time              `json:"execdeadline"`
+	Retries            int                    `json:"retries"`
+	Attributes         []Attribute            `json:"attributes"`
+	FunctionSpec       FunctionSpec           `json:"spec"`
+	WaitForParents     bool                   `json:"waitforparents"`
+	Parents            []string               `json:"parents"`
+	Children           []string               `json:"children"`
+	ProcessGraphID     string                 `json:"processgraphid"`
+	Input              []interface{}          `json:"in"`
+	Output             []interface{}          `json:"out"`
+	NamedInput         map[string]interface{} `json:"namedin"`
+	NamedOutput        map[string]interface{} `json:"namedout"`
+	Errors             []string               `json:"errors"`
 }
 
 func CreateProcess(funcSpec *FunctionSpec) *Process {
@@ -55,6 +58,8 @@ func CreateProcess(funcSpec *FunctionSpec) *Process {
 		FunctionSpec: *funcSpec,
 		Input:        make([]interface{}, 0),
 		Output:       make([]interface{}, 0),
+		NamedInput:   make(map[string]interface{}),
+		NamedOutput:  make(map[string]interface{}),
 		Errors:       make([]string, 0),
 	}
 
@@ -121,6 +126,24 @@ func ConvertJSONToProcessArray(jsonString string) ([]*Process, error) {
 	return processes, nil
 }
 
+func isNamedValuesEqual(values1 map[string]interface{}, values2 map[string]interface{}) bool {
+	if len(values1) != len(values2) {
+		return false
+	}
+
+	// Values can be any JSON value, compare the JSON encodings, keys are sorted by json.Marshal
+	json1, err := json.Marshal(values1)
+	if err != nil {
+		return false
+	}
+	json2, err := json.Marshal(values2)
+	if err != nil {
+		return false
+	}
+
+	return string(json1) == string(json2)
+}
+
 func IsProcessArraysEqual(processes1 []*Process, processes2 []*Process) bool {
 	counter := 0
 	for _, process1 := range processes1 {
@@ -196,6 +219,10 @@ func (process *Process) Equals(process2 *Process) bool {
 		return false
 	}
 
+	if !isNamedValuesEqual(process.NamedInput, process2.NamedInput) || !isNamedValuesEqual(process.NamedOutput, process2.NamedOutput) {
+		return false
+	}
+
 	if !IsAttributeArraysEqual(process.Attributes, process2.Attributes) {
 		same = false
 	}
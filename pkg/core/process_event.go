@@ -0,0 +1,121 @@
+package core
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/security/crypto"
+	"github.com/google/uuid"
+)
+
+const (
+	SUBMITTED_EVENT     = "submitted"
+	ASSIGNED_EVENT      = "assigned"
+	UNASSIGNED_EVENT    = "unassigned"
+	RESET_EVENT         = "reset"
+	SUCCESSFUL_EVENT    = "successful"
+	FAILED_EVENT        = "failed"
+	STATE_CHANGED_EVENT = "statechanged"
+)
+
+// ProcessEvent is an entry in the history of a process, one event is stored every time the state of the
+// process changes, events are never updated
+type ProcessEvent struct {
+	ID         string    `json:"processeventid"`
+	ProcessID  string    `json:"processid"`
+	ColonyID   string    `json:"colonyid"`
+	Type       string    `json:"type"`
+	State      int       `json:"state"`
+	ExecutorID string    `json:"executorid"`
+	Message    string    `json:"message"`
+	Time       time.Time `json:"time"`
+}
+
+func CreateProcessEvent(process *Process, eventType string, state int, executorID string, message string) *ProcessEvent {
+	uuid := uuid.New()
+	crypto := crypto.CreateCrypto()
+	id := crypto.GenerateHash(uuid.String())
+
+	return &ProcessEvent{
+		ID:         id,
+		ProcessID:  process.ID,
+		ColonyID:   process.FunctionSpec.Conditions.ColonyID,
+		Type:       eventType,
+		State:      state,
+		ExecutorID: executorID,
+		Message:    message,
+		Time:       time.Now(),
+	}
+}
+
+func ConvertJSONToProcessEvent(jsonString string) (*ProcessEvent, error) {
+	var event *ProcessEvent
+	err := json.Unmarshal([]byte(jsonString), &event)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func ConvertJSONToProcessEventArray(jsonString string) ([]*ProcessEvent, error) {
+	var events []*ProcessEvent
+	err := json.Unmarshal([]byte(jsonString), &events)
+	if err != nil {
+		return events, err
+	}
+
+	return events, nil
+}
+
+func ConvertProcessEventArrayToJSON(events []*ProcessEvent) (string, error) {
+	jsonBytes, err := json.MarshalIndent(events, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func IsProcessEventArraysEqual(events1 []*ProcessEvent, events2 []*ProcessEvent) bool {
+	if len(events1) != len(events2) {
+		return false
+	}
+
+	// The order matters, events are sorted by time
+	for i := range events1 {
+		if !events1[i].Equals(events2[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (event *ProcessEvent) Equals(event2 *ProcessEvent) bool {
+	if event2 == nil {
+		return false
+	}
+
+	if event.ID != event2.ID ||
+		event.ProcessID != event2.ProcessID ||
+		event.ColonyID != event2.ColonyID ||
+		event.Type != event2.Type ||
+		event.State != event2.State ||
+		event.ExecutorID != event2.ExecutorID ||
+		event.Message != event2.Message ||
+		event.Time.Unix() != event2.Time.Unix() {
+		return false
+	}
+
+	return true
+}
+
+func (event *ProcessEvent) ToJSON() (string, error) {
+	jsonBytes, err := json.MarshalIndent(event, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
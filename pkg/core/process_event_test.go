@@ -0,0 +1,62 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateProcessEvent(t *testing.T) {
+	colonyID := GenerateRandomID()
+	executorID := GenerateRandomID()
+	funcSpec := CreateEmptyFunctionSpec()
+	funcSpec.Conditions.ColonyID = colonyID
+	process := CreateProcess(funcSpec)
+
+	event := CreateProcessEvent(process, ASSIGNED_EVENT, RUNNING, executorID, "")
+	assert.Len(t, event.ID, 64)
+	assert.Equal(t, event.ProcessID, process.ID)
+	assert.Equal(t, event.ColonyID, colonyID)
+	assert.Equal(t, event.Type, ASSIGNED_EVENT)
+	assert.Equal(t, event.State, RUNNING)
+	assert.Equal(t, event.ExecutorID, executorID)
+}
+
+func TestIsProcessEventEquals(t *testing.T) {
+	process := CreateProcess(CreateEmptyFunctionSpec())
+	event1 := CreateProcessEvent(process, SUBMITTED_EVENT, WAITING, "", "")
+	event2 := CreateProcessEvent(process, FAILED_EVENT, FAILED, "", "error")
+
+	assert.True(t, event1.Equals(event1))
+	assert.False(t, event1.Equals(event2))
+	assert.False(t, event1.Equals(nil))
+}
+
+func TestProcessEventToJSON(t *testing.T) {
+	event := CreateProcessEvent(CreateProcess(CreateEmptyFunctionSpec()), FAILED_EVENT, FAILED, GenerateRandomID(), "error")
+
+	jsonStr, err := event.ToJSON()
+	assert.Nil(t, err)
+
+	event2, err := ConvertJSONToProcessEvent(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, event.Equals(event2))
+
+	_, err = ConvertJSONToProcessEvent(jsonStr + "error")
+	assert.NotNil(t, err)
+}
+
+func TestProcessEventArrayToJSON(t *testing.T) {
+	process := CreateProcess(CreateEmptyFunctionSpec())
+	event1 := CreateProcessEvent(process, SUBMITTED_EVENT, WAITING, "", "")
+	event2 := CreateProcessEvent(process, ASSIGNED_EVENT, RUNNING, GenerateRandomID(), "")
+
+	events := []*ProcessEvent{event1, event2}
+	jsonStr, err := ConvertProcessEventArrayToJSON(events)
+	assert.Nil(t, err)
+
+	events2, err := ConvertJSONToProcessEventArray(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, IsProcessEventArraysEqual(events, events2))
+	assert.False(t, IsProcessEventArraysEqual(events, []*ProcessEvent{event2, event1}))
+}
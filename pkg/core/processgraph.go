@@ -10,11 +10,24 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// Failure policies of a processgraph. Fail-fast cancels all remaining processes as soon as a process fails,
+// continue lets independent branches run to completion, the processgraph fails once nothing is left to run
+const (
+	FAIL_FAST = "failfast"
+	CONTINUE  = "continue"
+)
+
 type ProcessGraphStorage interface {
 	GetProcessByID(processID string) (*Process, error)
 	SetProcessState(processID string, state int) error
 	SetWaitForParents(processID string, waitForParent bool) error
 	SetProcessGraphState(processGraphID string, state int) error
+	MarkFailed(processID string, errs []string) error
+	AddProcess(process *Process) error
+	SetParents(processID string, parents []string) error
+	SetChildren(processID string, children []string) error
+	ResetProcess(process *Process) error
+	FindProcessGraphsByParentProcessID(parentProcessID string) ([]*ProcessGraph, error)
 }
 
 type Edge struct {
@@ -47,18 +60,22 @@ type Node struct {
 }
 
 type ProcessGraph struct {
-	storage        ProcessGraphStorage
-	ID             string    `json:"processgraphid"`
-	ColonyID       string    `json:"colonyid"`
-	Roots          []string  `json:"rootprocessids"`
-	State          int       `json:"state"`
-	SubmissionTime time.Time `json:"submissiontime"`
-	StartTime      time.Time `json:"starttime"`
-	EndTime        time.Time `json:"endtime"`
-	ProcessIDs     []string  `json:"processids"`
-	Nodes          []Node    `json:"nodes"`
-	Edges          []Edge    `json:"edges"`
-	nodesMap       map[string]*Node
+	storage         ProcessGraphStorage
+	ID              string    `json:"processgraphid"`
+	ColonyID        string    `json:"colonyid"`
+	Roots           []string  `json:"rootprocessids"`
+	State           int       `json:"state"`
+	SubmissionTime  time.Time `json:"submissiontime"`
+	StartTime       time.Time `json:"starttime"`
+	EndTime         time.Time `json:"endtime"`
+	ProcessIDs      []string  `json:"processids"`
+	ParentProcessID string    `json:"parentprocessid"`
+	Nodes           []Node    `json:"nodes"`
+	Edges           []Edge    `json:"edges"`
+	FailurePolicy   string    `json:"failurepolicy"`
+	Deadline        time.Time `json:"deadline"`
+	nodesMap        map[string]*Node
+	released        []*Process
 }
 
 func CreateProcessGraph(colonyID string) (*ProcessGraph, error) {
@@ -142,80 +159,389 @@ func (graph *ProcessGraph) SetStorage(storage ProcessGraphStorage) {
 }
 
 func (graph *ProcessGraph) Resolve() error {
+	graph.released = nil
+
+	if graph.DeadlineExceeded() {
+		err := graph.cancel("Workflow deadline exceeded")
+		if err != nil {
+			return err
+		}
+	}
+
+	// Skipping a process may release or skip its children, so keep resolving until nothing changes
+	for {
+		if graph.FailurePolicy != CONTINUE {
+			failedProcess, err := graph.findFailedProcess()
+			if err != nil {
+				return err
+			}
+			if failedProcess != nil {
+				err = graph.cancel("Cancelled since process <" + failedProcess.ID + "> failed")
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		changed := false
+		err := graph.Iterate(func(process *Process) error {
+			if process == nil {
+				errMsg := "Failed to iterate processgraph, process is nil"
+				log.Error(errMsg)
+				return errors.New(errMsg)
+			}
+
+			processChanged, err := graph.resolveProcess(process)
+			if processChanged {
+				changed = true
+			}
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if !changed {
+			break
+		}
+	}
+
 	processes := 0
 	failedProcesses := 0
+	allowedFailures := 0
+	waitingProcesses := 0
 	runningProcesses := 0
 	successfulProcesses := 0
-	waitingProcesses := 0
+	skippedProcesses := 0
 
 	err := graph.Iterate(func(process *Process) error {
-		if process == nil {
-			errMsg := "Failed to iterate processgraph, process is nil"
-			log.Error(errMsg)
-			return errors.New(errMsg)
+		processes++
+		switch process.State {
+		case FAILED:
+			if process.FunctionSpec.AllowFailure {
+				allowedFailures++
+			} else {
+				failedProcesses++
+			}
+		case WAITING:
+			waitingProcesses++
+		case RUNNING:
+			runningProcesses++
+		case SUCCESS:
+			successfulProcesses++
+		case SKIPPED:
+			skippedProcesses++
 		}
-		nrParents := len(process.Parents)
-		nrParentsFinished := 0
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 
-		processes++
-		if process.State == FAILED {
-			failedProcesses++
+	finished := successfulProcesses + skippedProcesses + allowedFailures
+	if failedProcesses >= 1 && (graph.FailurePolicy != CONTINUE || waitingProcesses+runningProcesses == 0) {
+		graph.State = FAILED
+	} else if failedProcesses >= 1 {
+		graph.State = RUNNING
+	} else if finished == processes {
+		graph.State = SUCCESS
+	} else if finished > 0 || runningProcesses >= 1 {
+		graph.State = RUNNING
+	} else {
+		graph.State = WAITING
+	}
+
+	graph.storage.SetProcessGraphState(graph.ID, graph.State)
+
+	return err
+}
+
+// resolveProcess releases a process when all its parents are finished. A process is skipped if all its parents
+// were skipped or if its condition evaluates to false, a process with at least one successful parent and the
+// remaining parents skipped is released, which makes it possible to join branches where only one branch runs.
+// A failed parent that is allowed to fail counts as finished, any other failed parent fails the process.
+func (graph *ProcessGraph) resolveProcess(process *Process) (bool, error) {
+	nrParents := len(process.Parents)
+	nrParentsFinished := 0
+	nrParentsSkipped := 0
+	var successfulParents []*Process
+	var failedParent *Process
+
+	for _, parentProcessID := range process.Parents {
+		parent, err := graph.storage.GetProcessByID(parentProcessID)
+		if err != nil {
+			return false, err
 		}
-		if process.State == RUNNING {
-			runningProcesses++
+		if parent.State == SUCCESS {
+			nrParentsFinished++
+			successfulParents = append(successfulParents, parent)
+		} else if parent.State == SKIPPED {
+			nrParentsFinished++
+			nrParentsSkipped++
+		} else if parent.State == FAILED {
+			if parent.FunctionSpec.AllowFailure {
+				nrParentsFinished++
+			} else {
+				failedParent = parent
+			}
 		}
-		if process.State == WAITING {
-			waitingProcesses++
+	}
+
+	if process.State != WAITING {
+		return false, nil
+	}
+
+	if failedParent != nil {
+		process.State = FAILED
+		return true, graph.storage.MarkFailed(process.ID, []string{"Parent process <" + failedParent.ID + "> failed"})
+	}
+
+	if nrParentsFinished != nrParents || !process.WaitForParents {
+		return false, nil
+	}
+
+	skip := nrParents > 0 && nrParentsSkipped == nrParents
+	if !skip && process.FunctionSpec.Condition != "" {
+		result, err := EvalCondition(process.FunctionSpec.Condition, successfulParents)
+		if err != nil {
+			log.WithFields(log.Fields{"ProcessId": process.ID, "Condition": process.FunctionSpec.Condition, "Error": err}).Debug("Failed to evaluate condition")
+			process.State = FAILED
+			return true, graph.storage.MarkFailed(process.ID, []string{err.Error()})
 		}
-		if process.State == SUCCESS {
-			successfulProcesses++
+		skip = !result
+	}
+
+	if skip {
+		process.State = SKIPPED
+		return true, graph.storage.SetProcessState(process.ID, SKIPPED)
+	}
+
+	if process.FunctionSpec.Map {
+		return true, graph.expand(process, successfulParents)
+	}
+
+	limited, err := graph.isParallelismLimited(process, successfulParents)
+	if err != nil || limited {
+		return false, err
+	}
+
+	if process.FunctionSpec.Workflow != nil {
+		// Sub-workflows are never assigned to executors, they run as separate processgraphs
+		process.State = RUNNING
+		err := graph.storage.SetProcessState(process.ID, RUNNING)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	process.WaitForParents = false
+	graph.released = append(graph.released, process)
+	return true, graph.storage.SetWaitForParents(process.ID, false)
+}
+
+// expand replaces a map node with one instance per element in the output of its parents. The instances
+// become the parents of the map node's children, which means that the children will wait for all instances
+// and receive their collected output as input. The map node itself is set to successful once expanded.
+func (graph *ProcessGraph) expand(mapProcess *Process, parents []*Process) error {
+	var items []interface{}
+	for _, parent := range parents {
+		items = append(items, parent.Output...)
+	}
+
+	var instanceIDs []string
+	for _, item := range items {
+		funcSpec := mapProcess.FunctionSpec
+		funcSpec.Map = false
+		funcSpec.Condition = ""
+		instance := CreateProcess(&funcSpec)
+		instance.ProcessGraphID = mapProcess.ProcessGraphID
+		instance.WaitForParents = true
+		instance.Parents = []string{mapProcess.ID}
+		instance.Children = mapProcess.Children
+		instance.Input = []interface{}{item}
+		err := graph.storage.AddProcess(instance)
+		if err != nil {
+			return err
 		}
+		instanceIDs = append(instanceIDs, instance.ID)
+	}
 
-		for _, parentProcessID := range process.Parents {
-			parent, err := graph.storage.GetProcessByID(parentProcessID)
+	log.WithFields(log.Fields{"ProcessId": mapProcess.ID, "Instances": len(instanceIDs)}).Debug("Expanding map node")
+
+	// If there is nothing to map over, the children can just as well depend on the map node
+	if len(instanceIDs) > 0 {
+		for _, childID := range mapProcess.Children {
+			child, err := graph.storage.GetProcessByID(childID)
 			if err != nil {
 				return err
 			}
-			if parent.State == SUCCESS {
-				nrParentsFinished++
-			} else if parent.State == FAILED {
-				// Set all processes in the graph as failed if one process fails
-				err := graph.Iterate(func(process *Process) error {
-					process.State = FAILED
-					err = graph.storage.SetProcessState(process.ID, FAILED)
-					if err != nil {
-						return err
-					}
-					return nil
-				})
-				if err != nil {
-					return err
+			var parents []string
+			for _, parentID := range child.Parents {
+				if parentID == mapProcess.ID {
+					parents = append(parents, instanceIDs...)
+				} else {
+					parents = append(parents, parentID)
 				}
 			}
+			err = graph.storage.SetParents(childID, parents)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := graph.storage.SetChildren(mapProcess.ID, instanceIDs)
+		if err != nil {
+			return err
+		}
+		mapProcess.Children = instanceIDs
+	}
+
+	mapProcess.State = SUCCESS
+	return graph.storage.SetProcessState(mapProcess.ID, SUCCESS)
+}
+
+// isParallelismLimited returns true if a map instance has to wait since the max number of instances
+// of the map node are already queued or running
+func (graph *ProcessGraph) isParallelismLimited(process *Process, parents []*Process) (bool, error) {
+	for _, parent := range parents {
+		if !parent.FunctionSpec.Map || parent.FunctionSpec.MaxParallel <= 0 {
+			continue
+		}
+
+		active := 0
+		for _, instanceID := range parent.Children {
+			if instanceID == process.ID {
+				continue
+			}
+			instance, err := graph.storage.GetProcessByID(instanceID)
+			if err != nil {
+				return false, err
+			}
+			if instance.State == RUNNING || (instance.State == WAITING && !instance.WaitForParents) {
+				active++
+			}
+		}
+		if active >= parent.FunctionSpec.MaxParallel {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Retry resets all failed processes to waiting, except processes that are allowed to fail. Successful processes
+// and their outputs are kept, the reset processes are released by Resolve once their parents have finished.
+// Retry returns the number of reset processes.
+func (graph *ProcessGraph) Retry() (int, error) {
+	retried := 0
+	err := graph.Iterate(func(process *Process) error {
+		if process.State != FAILED || process.FunctionSpec.AllowFailure {
+			return nil
+		}
+
+		err := graph.storage.ResetProcess(process)
+		if err != nil {
+			return err
 		}
-		if nrParentsFinished == nrParents {
-			process.WaitForParents = false
-			graph.storage.SetWaitForParents(process.ID, false)
+		process.State = WAITING
+
+		// Let Resolve release the process, also root processes and sub-workflows
+		process.WaitForParents = true
+		err = graph.storage.SetWaitForParents(process.ID, true)
+		if err != nil {
+			return err
+		}
+
+		retried++
+		return nil
+	})
+	if err != nil {
+		return retried, err
+	}
+
+	return retried, graph.Resolve()
+}
+
+// DeadlineExceeded returns true if the processgraph has a deadline that has passed
+func (graph *ProcessGraph) DeadlineExceeded() bool {
+	return !graph.Deadline.IsZero() && time.Now().After(graph.Deadline)
+}
+
+// findFailedProcess returns a failed process that is not allowed to fail, or nil if there is no such process
+func (graph *ProcessGraph) findFailedProcess() (*Process, error) {
+	var failedProcess *Process
+	err := graph.Iterate(func(process *Process) error {
+		if failedProcess == nil && process.State == FAILED && !process.FunctionSpec.AllowFailure {
+			failedProcess = process
 		}
 		return nil
 	})
+	return failedProcess, err
+}
+
+// cancel fails all waiting and running processes in the processgraph, including the processes of sub-workflows
+// started by running processes
+func (graph *ProcessGraph) cancel(reason string) error {
+	return graph.Iterate(func(process *Process) error {
+		if process.State != WAITING && process.State != RUNNING {
+			return nil
+		}
+		if process.State == RUNNING && process.FunctionSpec.Workflow != nil {
+			err := graph.cancelSubWorkflow(process, reason)
+			if err != nil {
+				return err
+			}
+		}
+		log.WithFields(log.Fields{"ProcessId": process.ID, "ProcessGraphId": graph.ID, "Reason": reason}).Debug("Cancelling process")
+		process.State = FAILED
+		return graph.storage.MarkFailed(process.ID, []string{reason})
+	})
+}
+
+// cancelSubWorkflow cancels the processgraphs of the sub-workflow run by process that have not finished. A
+// process can have several sub-workflow processgraphs since a new one is started every time the process is retried
+func (graph *ProcessGraph) cancelSubWorkflow(process *Process, reason string) error {
+	subProcessGraphs, err := graph.storage.FindProcessGraphsByParentProcessID(process.ID)
 	if err != nil {
 		return err
 	}
 
-	if failedProcesses >= 1 {
-		graph.State = FAILED
-	} else if successfulProcesses == processes {
-		graph.State = SUCCESS
-	} else if successfulProcesses > 0 || runningProcesses >= 1 {
-		graph.State = RUNNING
-	} else {
-		graph.State = WAITING
+	for _, subProcessGraph := range subProcessGraphs {
+		if subProcessGraph.State == SUCCESS || subProcessGraph.State == FAILED {
+			continue
+		}
+
+		subProcessGraph.SetStorage(graph.storage)
+		err = subProcessGraph.cancel(reason)
+		if err != nil {
+			return err
+		}
+
+		subProcessGraph.State = FAILED
+		err = graph.storage.SetProcessGraphState(subProcessGraph.ID, FAILED)
+		if err != nil {
+			return err
+		}
 	}
 
-	graph.storage.SetProcessGraphState(graph.ID, graph.State)
+	return nil
+}
 
-	return err
+// Released returns the processes that were released by the last call to Resolve, processes running
+// sub-workflows are released in the running state
+func (graph *ProcessGraph) Released() []*Process {
+	return graph.released
+}
+
+// LeafOutput returns the output of all successful leaves, which is used as output of a sub-workflow
+func (graph *ProcessGraph) LeafOutput() ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	err := graph.Iterate(func(process *Process) error {
+		if len(process.Children) == 0 && process.State == SUCCESS {
+			output = append(output, process.Output...)
+		}
+		return nil
+	})
+	return output, err
 }
 
 func (graph *ProcessGraph) GetRoot(childProcessID string) (*Process, error) {
@@ -286,19 +612,7 @@ func (graph *ProcessGraph) calcNodes() error {
 			t = "output"
 		}
 
-		background := "#eee8d8"
-		switch process.State {
-		case WAITING:
-			background = "#eee8d8"
-		case RUNNING:
-			background = "#4689cd"
-		case SUCCESS:
-			background = "#92d050"
-		case FAILED:
-			background = "#cb4239"
-		}
-
-		style := Style{Background: background}
+		style := Style{Background: stateColor(process.State)}
 		node := &Node{ID: process.ID, Data: Data{Label: process.FunctionSpec.NodeName}, Position: Position{X: x, Y: y}, Type: t, Style: style}
 		graph.nodesMap[process.ID] = node
 		nodesPerDepth[depth] = append(nodesPerDepth[depth], node)
@@ -353,6 +667,21 @@ func (graph *ProcessGraph) calcNodes() error {
 	return err
 }
 
+func stateColor(state int) string {
+	switch state {
+	case RUNNING:
+		return "#4689cd"
+	case SUCCESS:
+		return "#92d050"
+	case FAILED:
+		return "#cb4239"
+	case SKIPPED:
+		return "#c0c0c0"
+	default:
+		return "#eee8d8"
+	}
+}
+
 func (graph *ProcessGraph) getRoot(childProcessID string, counter int, visited map[string]bool) (*Process, int, error) {
 	process, err := graph.storage.GetProcessByID(childProcessID)
 	if err != nil {
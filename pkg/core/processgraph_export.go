@@ -0,0 +1,121 @@
+package core
+
+import (
+	"strings"
+	"time"
+)
+
+// ToDOT renders the process graph in Graphviz DOT format. Each node shows the node name, state, duration and
+// the name of the assigned executor, executorNames maps executor Ids to names. Edges between processes not
+// included in processes are left out.
+func (graph *ProcessGraph) ToDOT(processes []*Process, executorNames map[string]string) string {
+	var sb strings.Builder
+	sb.WriteString("digraph \"" + graph.ID + "\" {\n")
+	sb.WriteString("    node [shape=box, style=\"rounded,filled\"];\n")
+	for _, process := range processes {
+		label := strings.Join(nodeLabelLines(process, executorNames), "\\n")
+		sb.WriteString("    \"" + process.ID + "\" [label=\"" + label + "\", fillcolor=\"" + stateColor(process.State) + "\"];\n")
+	}
+	for _, edge := range exportEdges(processes) {
+		sb.WriteString("    \"" + edge.Source + "\" -> \"" + edge.Target + "\";\n")
+	}
+	sb.WriteString("}\n")
+
+	return sb.String()
+}
+
+// ToMermaid renders the process graph as a Mermaid flowchart, see ToDOT
+func (graph *ProcessGraph) ToMermaid(processes []*Process, executorNames map[string]string) string {
+	var sb strings.Builder
+	sb.WriteString("flowchart TD\n")
+	for _, process := range processes {
+		label := strings.Join(nodeLabelLines(process, executorNames), "<br/>")
+		sb.WriteString("    p" + process.ID + "[\"" + label + "\"]\n")
+	}
+	for _, edge := range exportEdges(processes) {
+		sb.WriteString("    p" + edge.Source + " --> p" + edge.Target + "\n")
+	}
+	for _, process := range processes {
+		sb.WriteString("    style p" + process.ID + " fill:" + stateColor(process.State) + "\n")
+	}
+
+	return sb.String()
+}
+
+func exportEdges(processes []*Process) []Edge {
+	included := make(map[string]bool)
+	for _, process := range processes {
+		included[process.ID] = true
+	}
+
+	var edges []Edge
+	for _, process := range processes {
+		for _, child := range process.Children {
+			if included[child] {
+				edges = append(edges, Edge{ID: process.ID + "-" + child, Source: process.ID, Target: child})
+			}
+		}
+	}
+
+	return edges
+}
+
+// nodeLabelLines returns the label lines of a node, escaped so that they can be used in both DOT and Mermaid
+func nodeLabelLines(process *Process, executorNames map[string]string) []string {
+	nodeName := process.FunctionSpec.NodeName
+	if nodeName == "" {
+		nodeName = process.ID
+	}
+	lines := []string{escapeLabel(nodeName), stateName(process.State)}
+
+	if !process.StartTime.IsZero() {
+		var duration time.Duration
+		if process.State == RUNNING {
+			duration = time.Since(process.StartTime)
+		} else if !process.EndTime.IsZero() {
+			duration = process.EndTime.Sub(process.StartTime)
+		}
+		if duration > 0 {
+			lines = append(lines, formatDuration(duration))
+		}
+	}
+
+	if process.AssignedExecutorID != "" {
+		executorName, ok := executorNames[process.AssignedExecutorID]
+		if !ok {
+			executorName = process.AssignedExecutorID
+		}
+		lines = append(lines, escapeLabel(executorName))
+	}
+
+	return lines
+}
+
+func stateName(state int) string {
+	switch state {
+	case WAITING:
+		return "waiting"
+	case RUNNING:
+		return "running"
+	case SUCCESS:
+		return "successful"
+	case FAILED:
+		return "failed"
+	case SKIPPED:
+		return "skipped"
+	default:
+		return "unknown"
+	}
+}
+
+func formatDuration(duration time.Duration) string {
+	if duration < time.Second {
+		return duration.Round(time.Millisecond).String()
+	}
+	return duration.Round(time.Second).String()
+}
+
+// escapeLabel removes characters that would break a quoted DOT or Mermaid label
+func escapeLabel(str string) string {
+	return strings.NewReplacer("\\", "/", "\"", "'", "<", "(", ">", ")", "\n", " ").Replace(str)
+}
@@ -0,0 +1,67 @@
+package core
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createExportTestProcesses() []*Process {
+	//        task1
+	//        /   \
+	//    task2   task3
+
+	startTime := time.Now()
+	process1 := CreateProcess(&FunctionSpec{NodeName: "task1"})
+	process1.State = SUCCESS
+	process1.StartTime = startTime
+	process1.EndTime = startTime.Add(2 * time.Second)
+	process1.AssignedExecutorID = "executor1_id"
+
+	process2 := CreateProcess(&FunctionSpec{NodeName: "task2"})
+	process2.State = FAILED
+	process2.StartTime = startTime
+	process2.EndTime = startTime.Add(500 * time.Millisecond)
+	process2.AssignedExecutorID = "unknown_executor_id"
+
+	process3 := CreateProcess(&FunctionSpec{NodeName: "task3 \"quoted\""})
+
+	process1.AddChild(process2.ID)
+	process1.AddChild(process3.ID)
+	process2.AddParent(process1.ID)
+	process3.AddParent(process1.ID)
+
+	return []*Process{process1, process2, process3}
+}
+
+func TestProcessGraphToDOT(t *testing.T) {
+	graph, err := CreateProcessGraph(GenerateRandomID())
+	assert.Nil(t, err)
+	processes := createExportTestProcesses()
+
+	dot := graph.ToDOT(processes, map[string]string{"executor1_id": "executor1"})
+	assert.True(t, strings.HasPrefix(dot, "digraph \""+graph.ID+"\" {"))
+	assert.Contains(t, dot, "\""+processes[0].ID+"\" [label=\"task1\\nsuccessful\\n2s\\nexecutor1\", fillcolor=\"#92d050\"];")
+	assert.Contains(t, dot, "\""+processes[1].ID+"\" [label=\"task2\\nfailed\\n500ms\\nunknown_executor_id\", fillcolor=\"#cb4239\"];")
+	assert.Contains(t, dot, "\""+processes[2].ID+"\" [label=\"task3 'quoted'\\nwaiting\", fillcolor=\"#eee8d8\"];")
+	assert.Contains(t, dot, "\""+processes[0].ID+"\" -> \""+processes[1].ID+"\";")
+	assert.Contains(t, dot, "\""+processes[0].ID+"\" -> \""+processes[2].ID+"\";")
+
+	// Edges to processes not included are left out
+	dot = graph.ToDOT(processes[:2], nil)
+	assert.NotContains(t, dot, processes[2].ID)
+}
+
+func TestProcessGraphToMermaid(t *testing.T) {
+	graph, err := CreateProcessGraph(GenerateRandomID())
+	assert.Nil(t, err)
+	processes := createExportTestProcesses()
+
+	mermaid := graph.ToMermaid(processes, map[string]string{"executor1_id": "executor1"})
+	assert.True(t, strings.HasPrefix(mermaid, "flowchart TD\n"))
+	assert.Contains(t, mermaid, "p"+processes[0].ID+"[\"task1<br/>successful<br/>2s<br/>executor1\"]")
+	assert.Contains(t, mermaid, "p"+processes[0].ID+" --> p"+processes[1].ID)
+	assert.Contains(t, mermaid, "style p"+processes[1].ID+" fill:#cb4239")
+}
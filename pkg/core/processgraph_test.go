@@ -2,17 +2,20 @@ package core
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
 type processGraphStorageMock struct {
-	processes map[string]*Process
+	processes     map[string]*Process
+	processGraphs map[string][]*ProcessGraph
 }
 
 func createProcessGraphStorageMock() *processGraphStorageMock {
 	mock := &processGraphStorageMock{}
 	mock.processes = make(map[string]*Process)
+	mock.processGraphs = make(map[string][]*ProcessGraph)
 	return mock
 }
 
@@ -39,9 +42,55 @@ func (mock *processGraphStorageMock) SetWaitForParents(processID string, waitFor
 }
 
 func (mock *processGraphStorageMock) SetProcessGraphState(processGraphID string, state int) error {
+	for _, processGraphs := range mock.processGraphs {
+		for _, processGraph := range processGraphs {
+			if processGraph.ID == processGraphID {
+				processGraph.State = state
+			}
+		}
+	}
+
+	return nil
+}
+
+func (mock *processGraphStorageMock) MarkFailed(processID string, errs []string) error {
+	process := mock.processes[processID]
+	process.State = FAILED
+	process.Errors = errs
+
+	return nil
+}
+
+func (mock *processGraphStorageMock) AddProcess(process *Process) error {
+	mock.processes[process.ID] = process
+
+	return nil
+}
+
+func (mock *processGraphStorageMock) SetParents(processID string, parents []string) error {
+	process := mock.processes[processID]
+	process.Parents = parents
+
+	return nil
+}
+
+func (mock *processGraphStorageMock) SetChildren(processID string, children []string) error {
+	process := mock.processes[processID]
+	process.Children = children
+
+	return nil
+}
+
+func (mock *processGraphStorageMock) ResetProcess(process *Process) error {
+	process.State = WAITING
+
 	return nil
 }
 
+func (mock *processGraphStorageMock) FindProcessGraphsByParentProcessID(parentProcessID string) ([]*ProcessGraph, error) {
+	return mock.processGraphs[parentProcessID], nil
+}
+
 func createProcess() *Process {
 	colonyID := GenerateRandomID()
 	executorType := "test_executor_type"
@@ -783,6 +832,487 @@ func TestProcessGraphResolveMultipleRoots(t *testing.T) {
 	assert.True(t, graph.State == SUCCESS)
 }
 
+func TestProcessGraphResolveCondition(t *testing.T) {
+	process1 := createProcess()
+	process2 := createProcess()
+	process3 := createProcess()
+	process4 := createProcess()
+	process5 := createProcess()
+
+	//        process1
+	//          / \
+	//  process2   process3   (process2 runs if output > 0.5, process3 otherwise)
+	//     |    \ /
+	//  process5 process4     (process5 is skipped with process2, process4 joins the branches)
+
+	process1.FunctionSpec.NodeName = "drift"
+	process2.FunctionSpec.Condition = "drift.output[0] > 0.5"
+	process3.FunctionSpec.Condition = "drift.output[0] <= 0.5"
+
+	process1.AddChild(process2.ID)
+	process1.AddChild(process3.ID)
+	process2.AddParent(process1.ID)
+	process3.AddParent(process1.ID)
+	process2.AddChild(process4.ID)
+	process3.AddChild(process4.ID)
+	process4.AddParent(process2.ID)
+	process4.AddParent(process3.ID)
+	process2.AddChild(process5.ID)
+	process5.AddParent(process2.ID)
+
+	mock := createProcessGraphStorageMock()
+	mock.addProcess(process1)
+	mock.addProcess(process2)
+	mock.addProcess(process3)
+	mock.addProcess(process4)
+	mock.addProcess(process5)
+
+	process2.WaitForParents = true
+	process3.WaitForParents = true
+	process4.WaitForParents = true
+	process5.WaitForParents = true
+
+	graph, err := CreateProcessGraph(GenerateRandomID())
+	assert.Nil(t, err)
+	graph.storage = mock
+	graph.AddRoot(process1.ID)
+
+	process1.State = SUCCESS
+	process1.Output = []interface{}{0.2}
+	err = graph.Resolve()
+	assert.Nil(t, err)
+
+	assert.Equal(t, process2.State, SKIPPED)
+	assert.Equal(t, process5.State, SKIPPED) // All parents skipped
+	assert.Equal(t, process3.State, WAITING)
+	assert.False(t, process3.WaitForParents)
+	assert.True(t, process4.WaitForParents)
+	assert.Equal(t, graph.State, RUNNING)
+
+	process3.State = SUCCESS
+	err = graph.Resolve()
+	assert.Nil(t, err)
+
+	// Process4 has one successful and one skipped parent and should run
+	assert.Equal(t, process4.State, WAITING)
+	assert.False(t, process4.WaitForParents)
+
+	process4.State = SUCCESS
+	err = graph.Resolve()
+	assert.Nil(t, err)
+	assert.Equal(t, graph.State, SUCCESS)
+}
+
+func TestProcessGraphResolveConditionError(t *testing.T) {
+	process1 := createProcess()
+	process2 := createProcess()
+
+	process2.FunctionSpec.Condition = "output[3] > 0.5"
+	process1.AddChild(process2.ID)
+	process2.AddParent(process1.ID)
+
+	mock := createProcessGraphStorageMock()
+	mock.addProcess(process1)
+	mock.addProcess(process2)
+	process2.WaitForParents = true
+
+	graph, err := CreateProcessGraph(GenerateRandomID())
+	assert.Nil(t, err)
+	graph.storage = mock
+	graph.AddRoot(process1.ID)
+
+	process1.State = SUCCESS
+	process1.Output = []interface{}{0.2}
+	err = graph.Resolve()
+	assert.Nil(t, err)
+
+	assert.Equal(t, process2.State, FAILED)
+	assert.Len(t, process2.Errors, 1)
+	assert.Equal(t, graph.State, FAILED)
+}
+
+func createFailurePolicyTestGraph(t *testing.T, failurePolicy string) (*ProcessGraph, *Process, *Process, *Process, *Process) {
+	process1 := createProcess()
+	process2 := createProcess()
+	process3 := createProcess()
+	process4 := createProcess()
+
+	//        process1
+	//          / \
+	//  process2   process3
+	//         |
+	//    process4
+
+	process1.AddChild(process2.ID)
+	process1.AddChild(process3.ID)
+	process2.AddParent(process1.ID)
+	process3.AddParent(process1.ID)
+	process2.AddChild(process4.ID)
+	process4.AddParent(process2.ID)
+
+	mock := createProcessGraphStorageMock()
+	mock.addProcess(process1)
+	mock.addProcess(process2)
+	mock.addProcess(process3)
+	mock.addProcess(process4)
+
+	process2.WaitForParents = true
+	process3.WaitForParents = true
+	process4.WaitForParents = true
+
+	graph, err := CreateProcessGraph(GenerateRandomID())
+	assert.Nil(t, err)
+	graph.storage = mock
+	graph.FailurePolicy = failurePolicy
+	graph.AddRoot(process1.ID)
+
+	process1.State = SUCCESS
+	err = graph.Resolve()
+	assert.Nil(t, err)
+	process2.State = RUNNING
+	process3.State = RUNNING
+
+	return graph, process1, process2, process3, process4
+}
+
+func TestProcessGraphResolveFailFast(t *testing.T) {
+	graph, process1, process2, process3, process4 := createFailurePolicyTestGraph(t, FAIL_FAST)
+
+	process2.State = FAILED
+	err := graph.Resolve()
+	assert.Nil(t, err)
+
+	assert.Equal(t, process1.State, SUCCESS)
+	assert.Equal(t, process3.State, FAILED)
+	assert.Len(t, process3.Errors, 1)
+	assert.Equal(t, process4.State, FAILED)
+	assert.Equal(t, graph.State, FAILED)
+}
+
+func TestProcessGraphResolveContinue(t *testing.T) {
+	graph, _, process2, process3, process4 := createFailurePolicyTestGraph(t, CONTINUE)
+
+	process2.State = FAILED
+	err := graph.Resolve()
+	assert.Nil(t, err)
+
+	// process4 depends on process2, but process3 is allowed to finish
+	assert.Equal(t, process3.State, RUNNING)
+	assert.Equal(t, process4.State, FAILED)
+	assert.Len(t, process4.Errors, 1)
+	assert.Equal(t, graph.State, RUNNING)
+
+	process3.State = SUCCESS
+	err = graph.Resolve()
+	assert.Nil(t, err)
+	assert.Equal(t, graph.State, FAILED)
+}
+
+func TestProcessGraphResolveAllowFailure(t *testing.T) {
+	graph, _, process2, process3, process4 := createFailurePolicyTestGraph(t, FAIL_FAST)
+
+	process2.FunctionSpec.AllowFailure = true
+	process2.State = FAILED
+	err := graph.Resolve()
+	assert.Nil(t, err)
+
+	// A failed parent that is allowed to fail counts as finished
+	assert.Equal(t, process3.State, RUNNING)
+	assert.Equal(t, process4.State, WAITING)
+	assert.False(t, process4.WaitForParents)
+	assert.Equal(t, graph.State, RUNNING)
+
+	process3.State = SUCCESS
+	process4.State = SUCCESS
+	err = graph.Resolve()
+	assert.Nil(t, err)
+	assert.Equal(t, graph.State, SUCCESS)
+}
+
+func TestProcessGraphResolveDeadline(t *testing.T) {
+	graph, process1, process2, process3, process4 := createFailurePolicyTestGraph(t, CONTINUE)
+	assert.False(t, graph.DeadlineExceeded())
+
+	graph.Deadline = time.Now().Add(time.Hour)
+	err := graph.Resolve()
+	assert.Nil(t, err)
+	assert.False(t, graph.DeadlineExceeded())
+	assert.Equal(t, graph.State, RUNNING)
+
+	graph.Deadline = time.Now().Add(-time.Second)
+	assert.True(t, graph.DeadlineExceeded())
+	err = graph.Resolve()
+	assert.Nil(t, err)
+
+	assert.Equal(t, process1.State, SUCCESS)
+	assert.Equal(t, process2.State, FAILED)
+	assert.Equal(t, process3.State, FAILED)
+	assert.Equal(t, process4.State, FAILED)
+	assert.Equal(t, process3.Errors, []string{"Workflow deadline exceeded"})
+	assert.Equal(t, graph.State, FAILED)
+}
+
+func TestProcessGraphRetry(t *testing.T) {
+	graph, process1, process2, process3, process4 := createFailurePolicyTestGraph(t, FAIL_FAST)
+
+	process1.Output = []interface{}{"data"}
+	process2.State = FAILED
+	err := graph.Resolve()
+	assert.Nil(t, err)
+	assert.Equal(t, process3.State, FAILED)
+	assert.Equal(t, process4.State, FAILED)
+	assert.Equal(t, graph.State, FAILED)
+
+	retried, err := graph.Retry()
+	assert.Nil(t, err)
+	assert.Equal(t, retried, 3)
+
+	// process1 is kept, process2 and process3 are released, process4 waits for process2
+	assert.Equal(t, process1.State, SUCCESS)
+	assert.Equal(t, process1.Output, []interface{}{"data"})
+	assert.Equal(t, process2.State, WAITING)
+	assert.False(t, process2.WaitForParents)
+	assert.Equal(t, process3.State, WAITING)
+	assert.False(t, process3.WaitForParents)
+	assert.Equal(t, process4.State, WAITING)
+	assert.True(t, process4.WaitForParents)
+	assert.Len(t, graph.Released(), 2)
+	assert.Equal(t, graph.State, RUNNING)
+}
+
+func TestProcessGraphResolveMap(t *testing.T) {
+	process1 := createProcess()
+	process2 := createProcess()
+	process3 := createProcess()
+
+	//  process1
+	//     |
+	//  process2   (map node, one instance per element in the output of process1, max 2 in parallel)
+	//     |
+	//  process3   (join node, waits for all instances)
+
+	process2.FunctionSpec.NodeName = "train"
+	process2.FunctionSpec.Map = true
+	process2.FunctionSpec.MaxParallel = 2
+
+	process1.AddChild(process2.ID)
+	process2.AddParent(process1.ID)
+	process2.AddChild(process3.ID)
+	process3.AddParent(process2.ID)
+
+	mock := createProcessGraphStorageMock()
+	mock.addProcess(process1)
+	mock.addProcess(process2)
+	mock.addProcess(process3)
+
+	process2.WaitForParents = true
+	process3.WaitForParents = true
+
+	graph, err := CreateProcessGraph(GenerateRandomID())
+	assert.Nil(t, err)
+	graph.storage = mock
+	graph.AddRoot(process1.ID)
+
+	process1.State = SUCCESS
+	process1.Output = []interface{}{"a", "b", "c"}
+	err = graph.Resolve()
+	assert.Nil(t, err)
+
+	assert.Equal(t, process2.State, SUCCESS)
+	assert.Len(t, process2.Children, 3)
+	assert.Equal(t, process3.Parents, process2.Children)
+
+	var instances []*Process
+	for _, instanceID := range process2.Children {
+		instance := mock.processes[instanceID]
+		assert.Equal(t, instance.FunctionSpec.NodeName, "train")
+		assert.False(t, instance.FunctionSpec.Map)
+		assert.Equal(t, instance.Children, []string{process3.ID})
+		instances = append(instances, instance)
+	}
+	assert.Equal(t, instances[0].Input, []interface{}{"a"})
+	assert.Equal(t, instances[1].Input, []interface{}{"b"})
+	assert.Equal(t, instances[2].Input, []interface{}{"c"})
+
+	// Only 2 instances are allowed to run in parallel
+	assert.False(t, instances[0].WaitForParents)
+	assert.False(t, instances[1].WaitForParents)
+	assert.True(t, instances[2].WaitForParents)
+	assert.Len(t, graph.Released(), 2)
+	assert.True(t, process3.WaitForParents)
+
+	instances[0].State = SUCCESS
+	instances[0].Output = []interface{}{1}
+	err = graph.Resolve()
+	assert.Nil(t, err)
+	assert.False(t, instances[2].WaitForParents)
+	assert.Len(t, graph.Released(), 1)
+	assert.True(t, process3.WaitForParents)
+
+	instances[1].State = SUCCESS
+	instances[1].Output = []interface{}{2}
+	instances[2].State = SUCCESS
+	instances[2].Output = []interface{}{3}
+	err = graph.Resolve()
+	assert.Nil(t, err)
+	assert.False(t, process3.WaitForParents)
+
+	process3.State = SUCCESS
+	err = graph.Resolve()
+	assert.Nil(t, err)
+	assert.Equal(t, graph.State, SUCCESS)
+}
+
+func TestProcessGraphResolveMapEmpty(t *testing.T) {
+	process1 := createProcess()
+	process2 := createProcess()
+	process3 := createProcess()
+
+	process2.FunctionSpec.Map = true
+
+	process1.AddChild(process2.ID)
+	process2.AddParent(process1.ID)
+	process2.AddChild(process3.ID)
+	process3.AddParent(process2.ID)
+
+	mock := createProcessGraphStorageMock()
+	mock.addProcess(process1)
+	mock.addProcess(process2)
+	mock.addProcess(process3)
+
+	process2.WaitForParents = true
+	process3.WaitForParents = true
+
+	graph, err := CreateProcessGraph(GenerateRandomID())
+	assert.Nil(t, err)
+	graph.storage = mock
+	graph.AddRoot(process1.ID)
+
+	process1.State = SUCCESS
+	err = graph.Resolve()
+	assert.Nil(t, err)
+
+	// Nothing to map over, the join node can run directly
+	assert.Equal(t, process2.State, SUCCESS)
+	assert.Len(t, process2.Children, 1)
+	assert.False(t, process3.WaitForParents)
+}
+
+func TestProcessGraphResolveSubWorkflow(t *testing.T) {
+	process1 := createProcess()
+	process2 := createProcess()
+
+	process2.FunctionSpec.Workflow = CreateWorkflowSpec(GenerateRandomID())
+	process1.AddChild(process2.ID)
+	process2.AddParent(process1.ID)
+
+	mock := createProcessGraphStorageMock()
+	mock.addProcess(process1)
+	mock.addProcess(process2)
+	process2.WaitForParents = true
+
+	graph, err := CreateProcessGraph(GenerateRandomID())
+	assert.Nil(t, err)
+	graph.storage = mock
+	graph.AddRoot(process1.ID)
+
+	process1.State = SUCCESS
+	err = graph.Resolve()
+	assert.Nil(t, err)
+
+	// The sub-workflow is started by the server, it should never be assigned to an executor
+	assert.Equal(t, process2.State, RUNNING)
+	assert.False(t, process2.WaitForParents)
+	assert.Len(t, graph.Released(), 1)
+	assert.Equal(t, graph.State, RUNNING)
+}
+
+func TestProcessGraphCancelSubWorkflow(t *testing.T) {
+	process1 := createProcess()
+	process2 := createProcess()
+	process2.FunctionSpec.Workflow = CreateWorkflowSpec(GenerateRandomID())
+	subProcess1 := createProcess()
+	subProcess2 := createProcess()
+	subProcess1.AddChild(subProcess2.ID)
+	subProcess2.AddParent(subProcess1.ID)
+	retriedSubProcess := createProcess()
+
+	mock := createProcessGraphStorageMock()
+	mock.addProcess(process1)
+	mock.addProcess(process2)
+	mock.addProcess(subProcess1)
+	mock.addProcess(subProcess2)
+	mock.addProcess(retriedSubProcess)
+
+	graph, err := CreateProcessGraph(GenerateRandomID())
+	assert.Nil(t, err)
+	graph.storage = mock
+	graph.AddRoot(process1.ID)
+	graph.AddRoot(process2.ID)
+
+	subProcessGraph, err := CreateProcessGraph(GenerateRandomID())
+	assert.Nil(t, err)
+	subProcessGraph.ParentProcessID = process2.ID
+	subProcessGraph.State = RUNNING
+	subProcessGraph.AddRoot(subProcess1.ID)
+
+	// A sub-workflow that failed before process2 was retried, it is left as is
+	failedSubProcessGraph, err := CreateProcessGraph(GenerateRandomID())
+	assert.Nil(t, err)
+	failedSubProcessGraph.ParentProcessID = process2.ID
+	failedSubProcessGraph.State = FAILED
+	failedSubProcessGraph.AddRoot(retriedSubProcess.ID)
+	retriedSubProcess.State = FAILED
+	retriedSubProcess.Errors = []string{"error"}
+	mock.processGraphs[process2.ID] = []*ProcessGraph{failedSubProcessGraph, subProcessGraph}
+
+	process1.State = FAILED
+	process2.State = RUNNING
+	subProcess1.State = RUNNING
+	subProcess2.WaitForParents = true
+	err = graph.Resolve()
+	assert.Nil(t, err)
+
+	// The processes of the sub-workflow are cancelled together with the process running it
+	assert.Equal(t, process2.State, FAILED)
+	assert.Equal(t, subProcess1.State, FAILED)
+	assert.Equal(t, subProcess2.State, FAILED)
+	assert.Equal(t, subProcessGraph.State, FAILED)
+	assert.Equal(t, graph.State, FAILED)
+	assert.Equal(t, retriedSubProcess.Errors, []string{"error"})
+}
+
+func TestProcessGraphLeafOutput(t *testing.T) {
+	process1 := createProcess()
+	process2 := createProcess()
+	process3 := createProcess()
+
+	process1.AddChild(process2.ID)
+	process1.AddChild(process3.ID)
+	process2.AddParent(process1.ID)
+	process3.AddParent(process1.ID)
+
+	mock := createProcessGraphStorageMock()
+	mock.addProcess(process1)
+	mock.addProcess(process2)
+	mock.addProcess(process3)
+
+	graph, err := CreateProcessGraph(GenerateRandomID())
+	assert.Nil(t, err)
+	graph.storage = mock
+	graph.AddRoot(process1.ID)
+
+	process1.State = SUCCESS
+	process1.Output = []interface{}{"root"}
+	process2.State = SUCCESS
+	process2.Output = []interface{}{"leaf2"}
+	process3.State = SKIPPED
+
+	output, err := graph.LeafOutput()
+	assert.Nil(t, err)
+	assert.Equal(t, output, []interface{}{"leaf2"})
+}
+
 func TestProcessGraphJSON(t *testing.T) {
 	process1 := createProcess()
 	process2 := createProcess()
@@ -0,0 +1,138 @@
+package core
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/security/crypto"
+	"github.com/google/uuid"
+)
+
+const (
+	CRON_TRIGGER      = "cron"
+	GENERATOR_TRIGGER = "generator"
+)
+
+// RunRecord is an entry in the run history of a cron or a generator, one record is stored every time
+// a cron or generator is triggered, or when a trigger is skipped
+type RunRecord struct {
+	ID             string    `json:"runrecordid"`
+	ColonyID       string    `json:"colonyid"`
+	TriggerID      string    `json:"triggerid"`
+	TriggerType    string    `json:"triggertype"`
+	ScheduledTime  time.Time `json:"scheduledtime"`
+	TriggeredTime  time.Time `json:"triggeredtime"`
+	ProcessGraphID string    `json:"processgraphid"`
+	State          int       `json:"state"`
+	Skipped        bool      `json:"skipped"`
+	Reason         string    `json:"reason"`
+}
+
+func CreateRunRecord(colonyID string, triggerID string, triggerType string, scheduledTime time.Time, processGraphID string) *RunRecord {
+	uuid := uuid.New()
+	crypto := crypto.CreateCrypto()
+	id := crypto.GenerateHash(uuid.String())
+
+	return &RunRecord{
+		ID:             id,
+		ColonyID:       colonyID,
+		TriggerID:      triggerID,
+		TriggerType:    triggerType,
+		ScheduledTime:  scheduledTime,
+		TriggeredTime:  time.Now(),
+		ProcessGraphID: processGraphID,
+		State:          WAITING,
+	}
+}
+
+func CreateSkippedRunRecord(colonyID string, triggerID string, triggerType string, scheduledTime time.Time, reason string) *RunRecord {
+	runRecord := CreateRunRecord(colonyID, triggerID, triggerType, scheduledTime, "")
+	runRecord.Skipped = true
+	runRecord.Reason = reason
+
+	return runRecord
+}
+
+func CreateFailedRunRecord(colonyID string, triggerID string, triggerType string, scheduledTime time.Time, reason string) *RunRecord {
+	runRecord := CreateRunRecord(colonyID, triggerID, triggerType, scheduledTime, "")
+	runRecord.State = FAILED
+	runRecord.Reason = reason
+
+	return runRecord
+}
+
+func ConvertJSONToRunRecord(jsonString string) (*RunRecord, error) {
+	var runRecord *RunRecord
+	err := json.Unmarshal([]byte(jsonString), &runRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return runRecord, nil
+}
+
+func ConvertJSONToRunRecordArray(jsonString string) ([]*RunRecord, error) {
+	var runRecords []*RunRecord
+	err := json.Unmarshal([]byte(jsonString), &runRecords)
+	if err != nil {
+		return runRecords, err
+	}
+
+	return runRecords, nil
+}
+
+func ConvertRunRecordArrayToJSON(runRecords []*RunRecord) (string, error) {
+	jsonBytes, err := json.MarshalIndent(runRecords, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func IsRunRecordArraysEqual(runRecords1 []*RunRecord, runRecords2 []*RunRecord) bool {
+	counter := 0
+	for _, runRecord1 := range runRecords1 {
+		for _, runRecord2 := range runRecords2 {
+			if runRecord1.Equals(runRecord2) {
+				counter++
+			}
+		}
+	}
+
+	if counter == len(runRecords1) && counter == len(runRecords2) {
+		return true
+	}
+
+	return false
+}
+
+func (runRecord *RunRecord) Equals(runRecord2 *RunRecord) bool {
+	if runRecord2 == nil {
+		return false
+	}
+
+	if runRecord.ID != runRecord2.ID ||
+		runRecord.ColonyID != runRecord2.ColonyID ||
+		runRecord.TriggerID != runRecord2.TriggerID ||
+		runRecord.TriggerType != runRecord2.TriggerType ||
+		runRecord.ScheduledTime.Unix() != runRecord2.ScheduledTime.Unix() ||
+		runRecord.TriggeredTime.Unix() != runRecord2.TriggeredTime.Unix() ||
+		runRecord.ProcessGraphID != runRecord2.ProcessGraphID ||
+		runRecord.State != runRecord2.State ||
+		runRecord.Skipped != runRecord2.Skipped ||
+		runRecord.Reason != runRecord2.Reason {
+		return false
+	}
+
+	return true
+}
+
+func (runRecord *RunRecord) ToJSON() (string, error) {
+	jsonBytes, err := json.MarshalIndent(runRecord, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
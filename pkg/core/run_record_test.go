@@ -0,0 +1,65 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateRunRecord(t *testing.T) {
+	colonyID := GenerateRandomID()
+	cronID := GenerateRandomID()
+	processGraphID := GenerateRandomID()
+	scheduledTime := time.Now()
+	runRecord := CreateRunRecord(colonyID, cronID, CRON_TRIGGER, scheduledTime, processGraphID)
+	assert.Len(t, runRecord.ID, 64)
+	assert.Equal(t, runRecord.ColonyID, colonyID)
+	assert.Equal(t, runRecord.TriggerID, cronID)
+	assert.Equal(t, runRecord.TriggerType, CRON_TRIGGER)
+	assert.Equal(t, runRecord.ProcessGraphID, processGraphID)
+	assert.Equal(t, runRecord.State, WAITING)
+	assert.False(t, runRecord.Skipped)
+
+	skippedRunRecord := CreateSkippedRunRecord(colonyID, cronID, CRON_TRIGGER, scheduledTime, "reason")
+	assert.True(t, skippedRunRecord.Skipped)
+	assert.Equal(t, skippedRunRecord.Reason, "reason")
+	assert.Equal(t, skippedRunRecord.ProcessGraphID, "")
+
+	failedRunRecord := CreateFailedRunRecord(colonyID, cronID, CRON_TRIGGER, scheduledTime, "reason")
+	assert.False(t, failedRunRecord.Skipped)
+	assert.Equal(t, failedRunRecord.State, FAILED)
+}
+
+func TestIsRunRecordEquals(t *testing.T) {
+	runRecord1 := CreateRunRecord(GenerateRandomID(), GenerateRandomID(), CRON_TRIGGER, time.Now(), GenerateRandomID())
+	runRecord2 := CreateRunRecord(GenerateRandomID(), GenerateRandomID(), GENERATOR_TRIGGER, time.Now(), GenerateRandomID())
+
+	assert.True(t, runRecord1.Equals(runRecord1))
+	assert.False(t, runRecord1.Equals(runRecord2))
+	assert.False(t, runRecord1.Equals(nil))
+}
+
+func TestRunRecordToJSON(t *testing.T) {
+	runRecord := CreateSkippedRunRecord(GenerateRandomID(), GenerateRandomID(), CRON_TRIGGER, time.Now(), "reason")
+
+	jsonStr, err := runRecord.ToJSON()
+	assert.Nil(t, err)
+
+	runRecord2, err := ConvertJSONToRunRecord(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, runRecord.Equals(runRecord2))
+}
+
+func TestRunRecordArrayToJSON(t *testing.T) {
+	runRecord1 := CreateRunRecord(GenerateRandomID(), GenerateRandomID(), CRON_TRIGGER, time.Now(), GenerateRandomID())
+	runRecord2 := CreateRunRecord(GenerateRandomID(), GenerateRandomID(), GENERATOR_TRIGGER, time.Now(), GenerateRandomID())
+
+	runRecords := []*RunRecord{runRecord1, runRecord2}
+	jsonStr, err := ConvertRunRecordArrayToJSON(runRecords)
+	assert.Nil(t, err)
+
+	runRecords2, err := ConvertJSONToRunRecordArray(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, IsRunRecordArraysEqual(runRecords, runRecords2))
+}
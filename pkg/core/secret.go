@@ -0,0 +1,190 @@
+package core
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/security/crypto"
+	"github.com/google/uuid"
+)
+
+const SECRET_REF_PREFIX = "secretref://"
+
+// REDACTED_ENV_VALUE replaces env values in processes returned to others than the colony owner and the executor the
+// process is assigned to
+const REDACTED_ENV_VALUE = "<redacted>"
+
+// Secret is a named value stored in a colony, e.g. a database password. The value is encrypted by the server before
+// it is stored and is never returned to clients, processes reference secrets in their env using CreateSecretRef and
+// the references are resolved when a process is assigned to an executor. A secret is only resolved for executors
+// of the executor types it has been granted to.
+type Secret struct {
+	ID            string    `json:"secretid"`
+	ColonyID      string    `json:"colonyid"`
+	Name          string    `json:"name"`
+	Value         string    `json:"value"`
+	ExecutorTypes []string  `json:"executortypes"`
+	Added         time.Time `json:"added"`
+}
+
+func CreateSecret(colonyID string, name string, value string, executorTypes []string) *Secret {
+	uuid := uuid.New()
+	crypto := crypto.CreateCrypto()
+	id := crypto.GenerateHash(uuid.String())
+
+	return &Secret{
+		ID:            id,
+		ColonyID:      colonyID,
+		Name:          name,
+		Value:         value,
+		ExecutorTypes: executorTypes,
+		Added:         time.Now(),
+	}
+}
+
+// IsGrantedTo returns true if the secret can be resolved for executors of the executor type
+func (secret *Secret) IsGrantedTo(executorType string) bool {
+	for _, t := range secret.ExecutorTypes {
+		if t == executorType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CreateSecretRef returns an env value that references a secret, in JSON the reference is written as
+// {"secretref": "name"}
+func CreateSecretRef(name string) string {
+	return SECRET_REF_PREFIX + name
+}
+
+// ParseSecretRef returns the secret name of a value created by CreateSecretRef
+func ParseSecretRef(value string) (string, bool) {
+	if !strings.HasPrefix(value, SECRET_REF_PREFIX) {
+		return "", false
+	}
+
+	name := strings.TrimPrefix(value, SECRET_REF_PREFIX)
+	if name == "" {
+		return "", false
+	}
+
+	return name, true
+}
+
+// SecretRefs returns the names of all secrets referenced in env
+func SecretRefs(env map[string]string) []string {
+	var names []string
+	for _, value := range env {
+		if name, ok := ParseSecretRef(value); ok {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// RedactEnv returns a copy of the process where all env values except secret references have been replaced by
+// REDACTED_ENV_VALUE, the process itself is not modified
+func RedactEnv(process *Process) *Process {
+	processCopy := *process
+	redactedProcess := &processCopy
+
+	if process.FunctionSpec.Env != nil {
+		redactedProcess.FunctionSpec.Env = make(EnvVars, len(process.FunctionSpec.Env))
+		for key, value := range process.FunctionSpec.Env {
+			redactedProcess.FunctionSpec.Env[key] = redactEnvValue(value)
+		}
+	}
+
+	if process.Attributes != nil {
+		redactedProcess.Attributes = make([]Attribute, len(process.Attributes))
+		for i, attribute := range process.Attributes {
+			if attribute.AttributeType == ENV {
+				attribute.Value = redactEnvValue(attribute.Value)
+			}
+			redactedProcess.Attributes[i] = attribute
+		}
+	}
+
+	return redactedProcess
+}
+
+func redactEnvValue(value string) string {
+	if _, ok := ParseSecretRef(value); ok {
+		return value
+	}
+
+	return REDACTED_ENV_VALUE
+}
+
+func ConvertJSONToSecret(jsonString string) (*Secret, error) {
+	var secret *Secret
+	err := json.Unmarshal([]byte(jsonString), &secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+func ConvertJSONToSecretArray(jsonString string) ([]*Secret, error) {
+	var secrets []*Secret
+	err := json.Unmarshal([]byte(jsonString), &secrets)
+	if err != nil {
+		return secrets, err
+	}
+
+	return secrets, nil
+}
+
+func ConvertSecretArrayToJSON(secrets []*Secret) (string, error) {
+	jsonBytes, err := json.MarshalIndent(secrets, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func IsSecretArraysEqual(secrets1 []*Secret, secrets2 []*Secret) bool {
+	if len(secrets1) != len(secrets2) {
+		return false
+	}
+
+	for i := range secrets1 {
+		if !secrets1[i].Equals(secrets2[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (secret *Secret) Equals(secret2 *Secret) bool {
+	if secret2 == nil {
+		return false
+	}
+
+	if secret.ID != secret2.ID ||
+		secret.ColonyID != secret2.ColonyID ||
+		secret.Name != secret2.Name ||
+		secret.Value != secret2.Value ||
+		!isStringArraysEqual(secret.ExecutorTypes, secret2.ExecutorTypes) ||
+		secret.Added.Unix() != secret2.Added.Unix() {
+		return false
+	}
+
+	return true
+}
+
+func (secret *Secret) ToJSON() (string, error) {
+	jsonBytes, err := json.MarshalIndent(secret, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
@@ -0,0 +1,122 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSecret(t *testing.T) {
+	colonyID := GenerateRandomID()
+	secret := CreateSecret(colonyID, "db-password", "password", []string{"test_executor_type"})
+	assert.Len(t, secret.ID, 64)
+	assert.Equal(t, secret.ColonyID, colonyID)
+	assert.Equal(t, secret.Name, "db-password")
+	assert.Equal(t, secret.Value, "password")
+}
+
+func TestSecretRef(t *testing.T) {
+	ref := CreateSecretRef("db-password")
+
+	name, ok := ParseSecretRef(ref)
+	assert.True(t, ok)
+	assert.Equal(t, name, "db-password")
+
+	_, ok = ParseSecretRef("db-password")
+	assert.False(t, ok)
+	_, ok = ParseSecretRef(SECRET_REF_PREFIX)
+	assert.False(t, ok)
+
+	env := map[string]string{"DB_PASSWORD": ref, "DB_HOST": "localhost"}
+	assert.Equal(t, SecretRefs(env), []string{"db-password"})
+}
+
+func TestEnvVarsSecretRefJSON(t *testing.T) {
+	funcSpec := CreateEmptyFunctionSpec()
+	funcSpec.Env["DB_HOST"] = "localhost"
+	funcSpec.Env["DB_PASSWORD"] = CreateSecretRef("db-password")
+
+	jsonStr, err := funcSpec.ToJSON()
+	assert.Nil(t, err)
+	assert.Contains(t, jsonStr, `"secretref": "db-password"`)
+
+	funcSpec2, err := ConvertJSONToFunctionSpec(jsonStr)
+	assert.Nil(t, err)
+	assert.Equal(t, funcSpec.Env, funcSpec2.Env)
+
+	funcSpec3, err := ConvertJSONToFunctionSpec(`{"funcname": "test", "env": {"DB_HOST": "localhost", "DB_PASSWORD": {"secretref": "db-password"}}}`)
+	assert.Nil(t, err)
+	assert.Equal(t, funcSpec3.Env["DB_HOST"], "localhost")
+	assert.Equal(t, funcSpec3.Env["DB_PASSWORD"], CreateSecretRef("db-password"))
+
+	_, err = ConvertJSONToFunctionSpec(`{"funcname": "test", "env": {"DB_PASSWORD": {"secret": "db-password"}}}`)
+	assert.NotNil(t, err)
+	_, err = ConvertJSONToFunctionSpec(`{"funcname": "test", "env": {"DB_PASSWORD": 1}}`)
+	assert.NotNil(t, err)
+
+	funcSpec4, err := ConvertJSONToFunctionSpec(`{"funcname": "test", "env": null}`)
+	assert.Nil(t, err)
+	assert.Nil(t, funcSpec4.Env)
+}
+
+func TestIsSecretEquals(t *testing.T) {
+	secret1 := CreateSecret(GenerateRandomID(), "db-password", "password", []string{"test_executor_type"})
+	secret2 := CreateSecret(GenerateRandomID(), "api-key", "key", []string{"test_executor_type"})
+
+	assert.True(t, secret1.Equals(secret1))
+	assert.False(t, secret1.Equals(secret2))
+	assert.False(t, secret1.Equals(nil))
+}
+
+func TestSecretToJSON(t *testing.T) {
+	secret := CreateSecret(GenerateRandomID(), "db-password", "password", []string{"test_executor_type"})
+
+	jsonStr, err := secret.ToJSON()
+	assert.Nil(t, err)
+
+	secret2, err := ConvertJSONToSecret(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, secret.Equals(secret2))
+
+	_, err = ConvertJSONToSecret(jsonStr + "error")
+	assert.NotNil(t, err)
+}
+
+func TestSecretArrayToJSON(t *testing.T) {
+	secret1 := CreateSecret(GenerateRandomID(), "db-password", "password", []string{"test_executor_type"})
+	secret2 := CreateSecret(GenerateRandomID(), "api-key", "key", []string{"test_executor_type"})
+
+	secrets := []*Secret{secret1, secret2}
+	jsonStr, err := ConvertSecretArrayToJSON(secrets)
+	assert.Nil(t, err)
+
+	secrets2, err := ConvertJSONToSecretArray(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, IsSecretArraysEqual(secrets, secrets2))
+	assert.False(t, IsSecretArraysEqual(secrets, []*Secret{secret2, secret1}))
+}
+
+func TestSecretIsGrantedTo(t *testing.T) {
+	secret := CreateSecret(GenerateRandomID(), "db-password", "password", []string{"db-backup", "etl"})
+	assert.True(t, secret.IsGrantedTo("db-backup"))
+	assert.True(t, secret.IsGrantedTo("etl"))
+	assert.False(t, secret.IsGrantedTo("test_executor_type"))
+	assert.False(t, secret.IsGrantedTo(""))
+}
+
+func TestRedactEnv(t *testing.T) {
+	funcSpec := CreateEmptyFunctionSpec()
+	funcSpec.Env["DB_HOST"] = "localhost"
+	funcSpec.Env["DB_PASSWORD"] = CreateSecretRef("db-password")
+	process := CreateProcess(funcSpec)
+	process.Attributes = []Attribute{CreateAttribute(process.ID, GenerateRandomID(), "", ENV, "DB_HOST", "localhost")}
+
+	redactedProcess := RedactEnv(process)
+	assert.Equal(t, redactedProcess.FunctionSpec.Env["DB_HOST"], REDACTED_ENV_VALUE)
+	assert.Equal(t, redactedProcess.FunctionSpec.Env["DB_PASSWORD"], CreateSecretRef("db-password"))
+	assert.Equal(t, redactedProcess.Attributes[0].Value, REDACTED_ENV_VALUE)
+
+	// The original process is not modified
+	assert.Equal(t, process.FunctionSpec.Env["DB_HOST"], "localhost")
+	assert.Equal(t, process.Attributes[0].Value, "localhost")
+}
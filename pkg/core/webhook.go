@@ -0,0 +1,252 @@
+package core
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/security/crypto"
+	"github.com/google/uuid"
+)
+
+const WEBHOOK_SIGNATURE_HEADER = "X-Colonies-Signature"
+const WEBHOOK_EVENT_HEADER = "X-Colonies-Event"
+const WEBHOOK_DELIVERY_HEADER = "X-Colonies-Delivery"
+const WEBHOOK_SIGNATURE_PREFIX = "sha256="
+
+// Webhook is registered by a colony owner to receive colony events as HTTP POST requests. Kinds and Types filter
+// the events in the same way as colony event subscriptions, an empty filter matches all events. The payload is
+// signed with the secret, see SignWebhookPayload.
+type Webhook struct {
+	ID       string    `json:"webhookid"`
+	ColonyID string    `json:"colonyid"`
+	URL      string    `json:"url"`
+	Secret   string    `json:"secret"`
+	Kinds    []string  `json:"kinds"`
+	Types    []string  `json:"types"`
+	Added    time.Time `json:"added"`
+}
+
+// WebhookDelivery records the outcome of delivering an event to a webhook, including all retries
+type WebhookDelivery struct {
+	ID         string    `json:"deliveryid"`
+	WebhookID  string    `json:"webhookid"`
+	ColonyID   string    `json:"colonyid"`
+	EventID    string    `json:"colonyeventid"`
+	Kind       string    `json:"kind"`
+	Type       string    `json:"type"`
+	Attempts   int       `json:"attempts"`
+	StatusCode int       `json:"statuscode"`
+	Success    bool      `json:"success"`
+	Error      string    `json:"error"`
+	Time       time.Time `json:"time"`
+}
+
+func CreateWebhook(colonyID string, url string, secret string, kinds []string, eventTypes []string) *Webhook {
+	uuid := uuid.New()
+	crypto := crypto.CreateCrypto()
+	id := crypto.GenerateHash(uuid.String())
+
+	return &Webhook{
+		ID:       id,
+		ColonyID: colonyID,
+		URL:      url,
+		Secret:   secret,
+		Kinds:    kinds,
+		Types:    eventTypes,
+		Added:    time.Now(),
+	}
+}
+
+func CreateWebhookDelivery(webhook *Webhook, event *ColonyEvent) *WebhookDelivery {
+	uuid := uuid.New()
+	crypto := crypto.CreateCrypto()
+	id := crypto.GenerateHash(uuid.String())
+
+	return &WebhookDelivery{
+		ID:        id,
+		WebhookID: webhook.ID,
+		ColonyID:  webhook.ColonyID,
+		EventID:   event.ID,
+		Kind:      event.Kind,
+		Type:      event.Type,
+		Time:      time.Now(),
+	}
+}
+
+// SignWebhookPayload returns the value of the X-Colonies-Signature header, i.e. sha256= followed by the hex encoded
+// HMAC-SHA256 of the payload
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return WEBHOOK_SIGNATURE_PREFIX + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookPayload can be used by webhook receivers to check that a payload was sent by a Colonies server
+func VerifyWebhookPayload(secret string, payload []byte, signature string) bool {
+	return hmac.Equal([]byte(SignWebhookPayload(secret, payload)), []byte(signature))
+}
+
+func (webhook *Webhook) Matches(event *ColonyEvent) bool {
+	return event.ColonyID == webhook.ColonyID && event.Matches(webhook.Kinds, webhook.Types)
+}
+
+func ConvertJSONToWebhook(jsonString string) (*Webhook, error) {
+	var webhook *Webhook
+	err := json.Unmarshal([]byte(jsonString), &webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+func ConvertJSONToWebhookArray(jsonString string) ([]*Webhook, error) {
+	var webhooks []*Webhook
+	err := json.Unmarshal([]byte(jsonString), &webhooks)
+	if err != nil {
+		return webhooks, err
+	}
+
+	return webhooks, nil
+}
+
+func ConvertWebhookArrayToJSON(webhooks []*Webhook) (string, error) {
+	jsonBytes, err := json.MarshalIndent(webhooks, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func IsWebhookArraysEqual(webhooks1 []*Webhook, webhooks2 []*Webhook) bool {
+	if len(webhooks1) != len(webhooks2) {
+		return false
+	}
+
+	for i := range webhooks1 {
+		if !webhooks1[i].Equals(webhooks2[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isStringArraysEqual(array1 []string, array2 []string) bool {
+	if len(array1) != len(array2) {
+		return false
+	}
+
+	for i := range array1 {
+		if array1[i] != array2[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (webhook *Webhook) Equals(webhook2 *Webhook) bool {
+	if webhook2 == nil {
+		return false
+	}
+
+	if webhook.ID != webhook2.ID ||
+		webhook.ColonyID != webhook2.ColonyID ||
+		webhook.URL != webhook2.URL ||
+		webhook.Secret != webhook2.Secret ||
+		!isStringArraysEqual(webhook.Kinds, webhook2.Kinds) ||
+		!isStringArraysEqual(webhook.Types, webhook2.Types) ||
+		webhook.Added.Unix() != webhook2.Added.Unix() {
+		return false
+	}
+
+	return true
+}
+
+func (webhook *Webhook) ToJSON() (string, error) {
+	jsonBytes, err := json.MarshalIndent(webhook, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func ConvertJSONToWebhookDelivery(jsonString string) (*WebhookDelivery, error) {
+	var delivery *WebhookDelivery
+	err := json.Unmarshal([]byte(jsonString), &delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func ConvertJSONToWebhookDeliveryArray(jsonString string) ([]*WebhookDelivery, error) {
+	var deliveries []*WebhookDelivery
+	err := json.Unmarshal([]byte(jsonString), &deliveries)
+	if err != nil {
+		return deliveries, err
+	}
+
+	return deliveries, nil
+}
+
+func ConvertWebhookDeliveryArrayToJSON(deliveries []*WebhookDelivery) (string, error) {
+	jsonBytes, err := json.MarshalIndent(deliveries, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func IsWebhookDeliveryArraysEqual(deliveries1 []*WebhookDelivery, deliveries2 []*WebhookDelivery) bool {
+	if len(deliveries1) != len(deliveries2) {
+		return false
+	}
+
+	for i := range deliveries1 {
+		if !deliveries1[i].Equals(deliveries2[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (delivery *WebhookDelivery) Equals(delivery2 *WebhookDelivery) bool {
+	if delivery2 == nil {
+		return false
+	}
+
+	if delivery.ID != delivery2.ID ||
+		delivery.WebhookID != delivery2.WebhookID ||
+		delivery.ColonyID != delivery2.ColonyID ||
+		delivery.EventID != delivery2.EventID ||
+		delivery.Kind != delivery2.Kind ||
+		delivery.Type != delivery2.Type ||
+		delivery.Attempts != delivery2.Attempts ||
+		delivery.StatusCode != delivery2.StatusCode ||
+		delivery.Success != delivery2.Success ||
+		delivery.Error != delivery2.Error ||
+		delivery.Time.Unix() != delivery2.Time.Unix() {
+		return false
+	}
+
+	return true
+}
+
+func (delivery *WebhookDelivery) ToJSON() (string, error) {
+	jsonBytes, err := json.MarshalIndent(delivery, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
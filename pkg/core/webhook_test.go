@@ -0,0 +1,104 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	colonyID := GenerateRandomID()
+	webhook := CreateWebhook(colonyID, "https://example.com/hook", "secret", []string{PROCESS_KIND}, []string{FAILED_EVENT})
+	assert.Len(t, webhook.ID, 64)
+	assert.Equal(t, webhook.ColonyID, colonyID)
+	assert.Equal(t, webhook.URL, "https://example.com/hook")
+	assert.Equal(t, webhook.Secret, "secret")
+	assert.Equal(t, webhook.Kinds, []string{PROCESS_KIND})
+	assert.Equal(t, webhook.Types, []string{FAILED_EVENT})
+}
+
+func TestWebhookMatches(t *testing.T) {
+	colonyID := GenerateRandomID()
+	webhook := CreateWebhook(colonyID, "https://example.com/hook", "secret", []string{PROCESS_KIND}, []string{FAILED_EVENT})
+
+	assert.True(t, webhook.Matches(CreateColonyEvent(colonyID, PROCESS_KIND, FAILED_EVENT, GenerateRandomID(), FAILED, "")))
+	assert.False(t, webhook.Matches(CreateColonyEvent(colonyID, PROCESS_KIND, SUCCESSFUL_EVENT, GenerateRandomID(), SUCCESS, "")))
+	assert.False(t, webhook.Matches(CreateColonyEvent(GenerateRandomID(), PROCESS_KIND, FAILED_EVENT, GenerateRandomID(), FAILED, "")))
+}
+
+func TestSignWebhookPayload(t *testing.T) {
+	payload := []byte("{\"kind\": \"process\"}")
+	signature := SignWebhookPayload("secret", payload)
+	assert.Len(t, signature, len(WEBHOOK_SIGNATURE_PREFIX)+64)
+	assert.True(t, VerifyWebhookPayload("secret", payload, signature))
+	assert.False(t, VerifyWebhookPayload("invalid_secret", payload, signature))
+	assert.False(t, VerifyWebhookPayload("secret", []byte("{}"), signature))
+}
+
+func TestIsWebhookEquals(t *testing.T) {
+	colonyID := GenerateRandomID()
+	webhook1 := CreateWebhook(colonyID, "https://example.com/hook1", "secret", nil, nil)
+	webhook2 := CreateWebhook(colonyID, "https://example.com/hook2", "secret", []string{CRON_KIND}, nil)
+
+	assert.True(t, webhook1.Equals(webhook1))
+	assert.False(t, webhook1.Equals(webhook2))
+	assert.False(t, webhook1.Equals(nil))
+}
+
+func TestWebhookToJSON(t *testing.T) {
+	webhook := CreateWebhook(GenerateRandomID(), "https://example.com/hook", "secret", []string{EXECUTOR_KIND}, []string{OFFLINE_EVENT})
+
+	jsonStr, err := webhook.ToJSON()
+	assert.Nil(t, err)
+
+	webhook2, err := ConvertJSONToWebhook(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, webhook.Equals(webhook2))
+
+	_, err = ConvertJSONToWebhook(jsonStr + "error")
+	assert.NotNil(t, err)
+
+	webhooks := []*Webhook{webhook, CreateWebhook(GenerateRandomID(), "https://example.com/hook2", "secret", nil, nil)}
+	jsonStr, err = ConvertWebhookArrayToJSON(webhooks)
+	assert.Nil(t, err)
+
+	webhooks2, err := ConvertJSONToWebhookArray(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, IsWebhookArraysEqual(webhooks, webhooks2))
+
+	_, err = ConvertJSONToWebhookArray(jsonStr + "error")
+	assert.NotNil(t, err)
+}
+
+func TestWebhookDeliveryToJSON(t *testing.T) {
+	webhook := CreateWebhook(GenerateRandomID(), "https://example.com/hook", "secret", nil, nil)
+	event := CreateColonyEvent(webhook.ColonyID, PROCESS_KIND, FAILED_EVENT, GenerateRandomID(), FAILED, "")
+	delivery := CreateWebhookDelivery(webhook, event)
+	assert.Len(t, delivery.ID, 64)
+	assert.Equal(t, delivery.WebhookID, webhook.ID)
+	assert.Equal(t, delivery.EventID, event.ID)
+	delivery.Attempts = 2
+	delivery.StatusCode = 500
+
+	jsonStr, err := delivery.ToJSON()
+	assert.Nil(t, err)
+
+	delivery2, err := ConvertJSONToWebhookDelivery(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, delivery.Equals(delivery2))
+	assert.False(t, delivery.Equals(nil))
+
+	_, err = ConvertJSONToWebhookDelivery(jsonStr + "error")
+	assert.NotNil(t, err)
+
+	deliveries := []*WebhookDelivery{delivery, CreateWebhookDelivery(webhook, event)}
+	jsonStr, err = ConvertWebhookDeliveryArrayToJSON(deliveries)
+	assert.Nil(t, err)
+
+	deliveries2, err := ConvertJSONToWebhookDeliveryArray(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, IsWebhookDeliveryArraysEqual(deliveries, deliveries2))
+
+	_, err = ConvertJSONToWebhookDeliveryArray(jsonStr + "error")
+	assert.NotNil(t, err)
+}
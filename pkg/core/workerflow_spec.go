@@ -5,6 +5,9 @@ import "encoding/json"
 type WorkflowSpec struct {
 	ColonyID      string         `json:"colonyid"`
 	FunctionSpecs []FunctionSpec `json:"functionspecs"`
+	Template      *TemplateRef   `json:"template"`
+	Deadline      int            `json:"deadline"`
+	FailurePolicy string         `json:"failurepolicy"`
 }
 
 func CreateWorkflowSpec(colonyID string) *WorkflowSpec {
@@ -12,6 +15,12 @@ func CreateWorkflowSpec(colonyID string) *WorkflowSpec {
 	return workflowSpec
 }
 
+// CreateWorkflowSpecFromTemplate creates a workflow spec referencing a stored workflow template, the
+// function specs are created by the server when the workflow is submitted
+func CreateWorkflowSpecFromTemplate(colonyID string, name string, version int, params map[string]string) *WorkflowSpec {
+	return &WorkflowSpec{ColonyID: colonyID, Template: &TemplateRef{Name: name, Version: version, Params: params}}
+}
+
 func (workflowSpec *WorkflowSpec) AddFunctionSpec(funcSpec *FunctionSpec) {
 	workflowSpec.FunctionSpecs = append(workflowSpec.FunctionSpecs, *funcSpec)
 }
@@ -28,7 +37,17 @@ func ConvertJSONToWorkflowSpec(jsonString string) (*WorkflowSpec, error) {
 
 func (workflowSpec *WorkflowSpec) Equals(workflowSpec2 *WorkflowSpec) bool {
 	same := true
-	if workflowSpec.ColonyID != workflowSpec2.ColonyID {
+	if workflowSpec.ColonyID != workflowSpec2.ColonyID ||
+		workflowSpec.Deadline != workflowSpec2.Deadline ||
+		workflowSpec.FailurePolicy != workflowSpec2.FailurePolicy {
+		same = false
+	}
+
+	if workflowSpec.Template != nil && workflowSpec2.Template == nil {
+		same = false
+	} else if workflowSpec.Template == nil && workflowSpec2.Template != nil {
+		same = false
+	} else if workflowSpec.Template != nil && !workflowSpec.Template.Equals(workflowSpec2.Template) {
 		same = false
 	}
 
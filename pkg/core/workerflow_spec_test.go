@@ -44,3 +44,28 @@ func TestWorkflowSpecJSON(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, workflowSpec.Equals(workflowSpec2))
 }
+
+func TestWorkflowSpecFailurePolicyJSON(t *testing.T) {
+	workflowSpec := CreateWorkflowSpec(GenerateRandomID())
+	workflowSpec.Deadline = 3600
+	workflowSpec.FailurePolicy = CONTINUE
+
+	funcSpec := CreateEmptyFunctionSpec()
+	funcSpec.NodeName = "task1"
+	funcSpec.AllowFailure = true
+	workflowSpec.AddFunctionSpec(funcSpec)
+
+	jsonStr, err := workflowSpec.ToJSON()
+	assert.Nil(t, err)
+
+	workflowSpec2, err := ConvertJSONToWorkflowSpec(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, workflowSpec.Equals(workflowSpec2))
+	assert.True(t, workflowSpec2.FunctionSpecs[0].AllowFailure)
+
+	workflowSpec2.FailurePolicy = FAIL_FAST
+	assert.False(t, workflowSpec.Equals(workflowSpec2))
+	workflowSpec2.FailurePolicy = CONTINUE
+	workflowSpec2.Deadline = 60
+	assert.False(t, workflowSpec.Equals(workflowSpec2))
+}
@@ -0,0 +1,224 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"time"
+)
+
+var templateParamRegexp = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+type TemplateParameter struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Default     string `json:"default"`
+	Required    bool   `json:"required"`
+}
+
+// TemplateRef references a stored workflow template, version 0 means the latest version
+type TemplateRef struct {
+	Name    string            `json:"name"`
+	Version int               `json:"version"`
+	Params  map[string]string `json:"params"`
+}
+
+type WorkflowTemplate struct {
+	ID         string              `json:"workflowtemplateid"`
+	ColonyID   string              `json:"colonyid"`
+	Name       string              `json:"name"`
+	Version    int                 `json:"version"`
+	Parameters []TemplateParameter `json:"parameters"`
+	Workflow   *WorkflowSpec       `json:"workflow"`
+	Added      time.Time           `json:"added"`
+}
+
+func CreateWorkflowTemplate(colonyID string, name string, parameters []TemplateParameter, workflow *WorkflowSpec) *WorkflowTemplate {
+	if parameters == nil {
+		parameters = make([]TemplateParameter, 0)
+	}
+	return &WorkflowTemplate{ColonyID: colonyID, Name: name, Parameters: parameters, Workflow: workflow}
+}
+
+func ConvertJSONToWorkflowTemplate(jsonString string) (*WorkflowTemplate, error) {
+	var template *WorkflowTemplate
+	err := json.Unmarshal([]byte(jsonString), &template)
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func ConvertWorkflowTemplateArrayToJSON(templates []*WorkflowTemplate) (string, error) {
+	jsonBytes, err := json.MarshalIndent(templates, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func ConvertJSONToWorkflowTemplateArray(jsonString string) ([]*WorkflowTemplate, error) {
+	var templates []*WorkflowTemplate
+	err := json.Unmarshal([]byte(jsonString), &templates)
+	if err != nil {
+		return templates, err
+	}
+
+	return templates, nil
+}
+
+func IsWorkflowTemplateArraysEqual(templates1 []*WorkflowTemplate, templates2 []*WorkflowTemplate) bool {
+	if templates1 == nil || templates2 == nil {
+		return false
+	}
+
+	counter := 0
+	for _, template1 := range templates1 {
+		for _, template2 := range templates2 {
+			if template1.Equals(template2) {
+				counter++
+			}
+		}
+	}
+
+	if counter == len(templates1) && counter == len(templates2) {
+		return true
+	}
+
+	return false
+}
+
+func (template *WorkflowTemplate) Equals(template2 *WorkflowTemplate) bool {
+	if template2 == nil {
+		return false
+	}
+
+	same := true
+	if template.ID != template2.ID ||
+		template.ColonyID != template2.ColonyID ||
+		template.Name != template2.Name ||
+		template.Version != template2.Version ||
+		template.Added.Unix() != template2.Added.Unix() ||
+		len(template.Parameters) != len(template2.Parameters) {
+		same = false
+	} else {
+		for i := range template.Parameters {
+			if template.Parameters[i] != template2.Parameters[i] {
+				same = false
+			}
+		}
+	}
+
+	if template.Workflow != nil && template2.Workflow == nil {
+		same = false
+	} else if template.Workflow == nil && template2.Workflow != nil {
+		same = false
+	} else if template.Workflow != nil && !template.Workflow.Equals(template2.Workflow) {
+		same = false
+	}
+
+	return same
+}
+
+func (ref *TemplateRef) Equals(ref2 *TemplateRef) bool {
+	if ref2 == nil {
+		return false
+	}
+
+	if ref.Name != ref2.Name || ref.Version != ref2.Version || len(ref.Params) != len(ref2.Params) {
+		return false
+	}
+
+	for k, v := range ref.Params {
+		if v2, ok := ref2.Params[k]; !ok || v != v2 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (template *WorkflowTemplate) ToJSON() (string, error) {
+	jsonBytes, err := json.MarshalIndent(template, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+// Instantiate creates a workflow spec from the template, ${param} in function args and env values are replaced
+// by the given params, or by the default value of the parameter if not given
+func (template *WorkflowTemplate) Instantiate(params map[string]string) (*WorkflowSpec, error) {
+	if template.Workflow == nil {
+		return nil, errors.New("Workflow template <" + template.Name + "> has no workflow")
+	}
+
+	values := make(map[string]string)
+	for _, parameter := range template.Parameters {
+		value, ok := params[parameter.Name]
+		if !ok {
+			if parameter.Required {
+				return nil, errors.New("Missing required parameter <" + parameter.Name + "> for workflow template <" + template.Name + ">")
+			}
+			value = parameter.Default
+		}
+		values[parameter.Name] = value
+	}
+
+	for name := range params {
+		if _, ok := values[name]; !ok {
+			return nil, errors.New("Unknown parameter <" + name + "> for workflow template <" + template.Name + ">")
+		}
+	}
+
+	// Make a deep copy so that the template is not modified
+	jsonString, err := template.Workflow.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+	workflowSpec, err := ConvertJSONToWorkflowSpec(jsonString)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowSpec.ColonyID = template.ColonyID
+	substituteTemplateParams(workflowSpec, values)
+
+	return workflowSpec, nil
+}
+
+func substituteTemplateParams(workflowSpec *WorkflowSpec, values map[string]string) {
+	substitute := func(str string) string {
+		return templateParamRegexp.ReplaceAllStringFunc(str, func(match string) string {
+			name := templateParamRegexp.FindStringSubmatch(match)[1]
+			if value, ok := values[name]; ok {
+				return value
+			}
+			return match
+		})
+	}
+
+	if workflowSpec.Template != nil {
+		for k, v := range workflowSpec.Template.Params {
+			workflowSpec.Template.Params[k] = substitute(v)
+		}
+	}
+
+	for i := range workflowSpec.FunctionSpecs {
+		funcSpec := &workflowSpec.FunctionSpecs[i]
+		for j, arg := range funcSpec.Args {
+			if str, ok := arg.(string); ok {
+				funcSpec.Args[j] = substitute(str)
+			}
+		}
+		for k, v := range funcSpec.Env {
+			funcSpec.Env[k] = substitute(v)
+		}
+		if funcSpec.Workflow != nil {
+			substituteTemplateParams(funcSpec.Workflow, values)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestWorkflowTemplate() *WorkflowTemplate {
+	funcSpec1 := CreateEmptyFunctionSpec()
+	funcSpec1.NodeName = "fetch"
+	funcSpec1.Args = []interface{}{"${dataset}", 1.0}
+	funcSpec1.Env["MODE"] = "${mode}"
+
+	funcSpec2 := CreateEmptyFunctionSpec()
+	funcSpec2.NodeName = "train"
+	funcSpec2.Args = []interface{}{"--data=${dataset} --epochs=${epochs}", "${unknown}"}
+	funcSpec2.AddDependency("fetch")
+
+	workflowSpec := CreateWorkflowSpec("")
+	workflowSpec.AddFunctionSpec(funcSpec1)
+	workflowSpec.AddFunctionSpec(funcSpec2)
+
+	parameters := []TemplateParameter{
+		{Name: "dataset", Required: true},
+		{Name: "epochs", Default: "10"},
+		{Name: "mode", Default: "fast", Description: "Execution mode"},
+	}
+
+	return CreateWorkflowTemplate(GenerateRandomID(), "train", parameters, workflowSpec)
+}
+
+func TestWorkflowTemplateJSON(t *testing.T) {
+	template := createTestWorkflowTemplate()
+	template.ID = GenerateRandomID()
+	template.Version = 2
+
+	jsonStr, err := template.ToJSON()
+	assert.Nil(t, err)
+
+	template2, err := ConvertJSONToWorkflowTemplate(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, template.Equals(template2))
+
+	_, err = ConvertJSONToWorkflowTemplate(jsonStr + "error")
+	assert.NotNil(t, err)
+}
+
+func TestWorkflowTemplateArrayJSON(t *testing.T) {
+	template1 := createTestWorkflowTemplate()
+	template2 := createTestWorkflowTemplate()
+	templates := []*WorkflowTemplate{template1, template2}
+
+	jsonStr, err := ConvertWorkflowTemplateArrayToJSON(templates)
+	assert.Nil(t, err)
+
+	templates2, err := ConvertJSONToWorkflowTemplateArray(jsonStr)
+	assert.Nil(t, err)
+	assert.True(t, IsWorkflowTemplateArraysEqual(templates, templates2))
+
+	_, err = ConvertJSONToWorkflowTemplateArray(jsonStr + "error")
+	assert.NotNil(t, err)
+}
+
+func TestWorkflowTemplateEquals(t *testing.T) {
+	template1 := createTestWorkflowTemplate()
+	template2 := createTestWorkflowTemplate()
+
+	assert.True(t, template1.Equals(template1))
+	assert.False(t, template1.Equals(template2))
+	assert.False(t, template1.Equals(nil))
+	assert.False(t, IsWorkflowTemplateArraysEqual(nil, []*WorkflowTemplate{template1}))
+}
+
+func TestWorkflowTemplateInstantiate(t *testing.T) {
+	template := createTestWorkflowTemplate()
+
+	workflowSpec, err := template.Instantiate(map[string]string{"dataset": "mnist"})
+	assert.Nil(t, err)
+	assert.Equal(t, workflowSpec.ColonyID, template.ColonyID)
+	assert.Equal(t, workflowSpec.FunctionSpecs[0].Args, []interface{}{"mnist", float64(1)})
+	assert.Equal(t, workflowSpec.FunctionSpecs[0].Env["MODE"], "fast")
+	assert.Equal(t, workflowSpec.FunctionSpecs[1].Args, []interface{}{"--data=mnist --epochs=10", "${unknown}"})
+
+	workflowSpec, err = template.Instantiate(map[string]string{"dataset": "cifar", "epochs": "5", "mode": "slow"})
+	assert.Nil(t, err)
+	assert.Equal(t, workflowSpec.FunctionSpecs[0].Env["MODE"], "slow")
+	assert.Equal(t, workflowSpec.FunctionSpecs[1].Args[0], "--data=cifar --epochs=5")
+
+	// The template itself should not be modified
+	assert.Equal(t, template.Workflow.FunctionSpecs[0].Args[0], "${dataset}")
+
+	_, err = template.Instantiate(map[string]string{})
+	assert.NotNil(t, err) // Missing required parameter
+
+	_, err = template.Instantiate(map[string]string{"dataset": "mnist", "speed": "1"})
+	assert.NotNil(t, err) // Unknown parameter
+}
+
+func TestWorkflowTemplateInstantiateSubWorkflow(t *testing.T) {
+	template := createTestWorkflowTemplate()
+
+	subFuncSpec := CreateEmptyFunctionSpec()
+	subFuncSpec.NodeName = "sub"
+	subFuncSpec.Args = []interface{}{"${dataset}"}
+	funcSpec := CreateEmptyFunctionSpec()
+	funcSpec.NodeName = "nested"
+	funcSpec.Workflow = CreateWorkflowSpec("")
+	funcSpec.Workflow.AddFunctionSpec(subFuncSpec)
+	template.Workflow.AddFunctionSpec(funcSpec)
+
+	funcSpec2 := CreateEmptyFunctionSpec()
+	funcSpec2.NodeName = "ref"
+	funcSpec2.Workflow = CreateWorkflowSpecFromTemplate("", "other", 1, map[string]string{"input": "${dataset}"})
+	template.Workflow.AddFunctionSpec(funcSpec2)
+
+	workflowSpec, err := template.Instantiate(map[string]string{"dataset": "mnist"})
+	assert.Nil(t, err)
+	assert.Equal(t, workflowSpec.FunctionSpecs[2].Workflow.FunctionSpecs[0].Args[0], "mnist")
+	assert.Equal(t, workflowSpec.FunctionSpecs[3].Workflow.Template.Params["input"], "mnist")
+}
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"encoding/json"
+)
+
+// WorkflowValidation is the result of validating a workflow spec without submitting it
+type WorkflowValidation struct {
+	Problems []string `json:"problems"`
+}
+
+func CreateWorkflowValidation(problems []string) *WorkflowValidation {
+	if problems == nil {
+		problems = make([]string, 0)
+	}
+
+	return &WorkflowValidation{Problems: problems}
+}
+
+func ConvertJSONToWorkflowValidation(jsonString string) (*WorkflowValidation, error) {
+	var validation *WorkflowValidation
+	err := json.Unmarshal([]byte(jsonString), &validation)
+	if err != nil {
+		return nil, err
+	}
+
+	return validation, nil
+}
+
+func (validation *WorkflowValidation) Valid() bool {
+	return len(validation.Problems) == 0
+}
+
+func (validation *WorkflowValidation) Equals(validation2 *WorkflowValidation) bool {
+	if validation2 == nil {
+		return false
+	}
+
+	if len(validation.Problems) != len(validation2.Problems) {
+		return false
+	}
+
+	for i := range validation.Problems {
+		if validation.Problems[i] != validation2.Problems[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (validation *WorkflowValidation) ToJSON() (string, error) {
+	jsonBytes, err := json.MarshalIndent(validation, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
@@ -0,0 +1,34 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowValidation(t *testing.T) {
+	validation := CreateWorkflowValidation([]string{"problem1", "problem2"})
+	assert.False(t, validation.Valid())
+
+	jsonString, err := validation.ToJSON()
+	assert.Nil(t, err)
+
+	validation2, err := ConvertJSONToWorkflowValidation(jsonString + "error")
+	assert.NotNil(t, err)
+
+	validation2, err = ConvertJSONToWorkflowValidation(jsonString)
+	assert.Nil(t, err)
+	assert.True(t, validation.Equals(validation2))
+
+	validation = CreateWorkflowValidation(nil)
+	assert.True(t, validation.Valid())
+	assert.False(t, validation.Equals(validation2))
+}
+
+func TestWorkflowValidationEquals(t *testing.T) {
+	validation := CreateWorkflowValidation([]string{"problem1"})
+
+	assert.True(t, validation.Equals(validation))
+	assert.False(t, validation.Equals(nil))
+	assert.False(t, validation.Equals(CreateWorkflowValidation([]string{"problem2"})))
+}
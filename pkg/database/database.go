@@ -73,6 +73,8 @@ type Database interface {
 	ResetProcess(process *core.Process) error
 	SetInput(processID string, output []interface{}) error
 	SetOutput(processID string, output []interface{}) error
+	SetNamedInput(processID string, namedInput map[string]interface{}) error
+	SetNamedOutput(processID string, namedOutput map[string]interface{}) error
 	SetErrors(processID string, errs []string) error
 	SetProcessState(processID string, state int) error
 	SetParents(processID string, parents []string) error
@@ -114,11 +116,14 @@ type Database interface {
 	// ProcessGraph functions
 	AddProcessGraph(processGraph *core.ProcessGraph) error
 	GetProcessGraphByID(processGraphID string) (*core.ProcessGraph, error)
+	FindProcessGraphsByParentProcessID(parentProcessID string) ([]*core.ProcessGraph, error)
 	SetProcessGraphState(processGraphID string, state int) error
+	ResetProcessGraph(processGraph *core.ProcessGraph) error
 	FindWaitingProcessGraphs(colonyID string, count int) ([]*core.ProcessGraph, error)
 	FindRunningProcessGraphs(colonyID string, count int) ([]*core.ProcessGraph, error)
 	FindSuccessfulProcessGraphs(colonyID string, count int) ([]*core.ProcessGraph, error)
 	FindFailedProcessGraphs(colonyID string, count int) ([]*core.ProcessGraph, error)
+	FindProcessGraphsWithExpiredDeadline() ([]*core.ProcessGraph, error)
 	DeleteProcessGraphByID(processGraphID string) error
 	DeleteAllProcessGraphsByColonyID(colonyID string) error
 	DeleteAllWaitingProcessGraphsByColonyID(colonyID string) error
@@ -138,6 +143,7 @@ type Database interface {
 	AddGenerator(generator *core.Generator) error
 	SetGeneratorLastRun(generatorID string) error
 	SetGeneratorFirstPack(generatorID string) error
+	UpdateGeneratorSettings(generator *core.Generator) error
 	GetGeneratorByID(generatorID string) (*core.Generator, error)
 	GetGeneratorByName(name string) (*core.Generator, error)
 	FindGeneratorsByColonyID(colonyID string, count int) ([]*core.Generator, error)
@@ -156,12 +162,84 @@ type Database interface {
 	// Cron functions
 	AddCron(cron *core.Cron) error
 	UpdateCron(cronID string, nextRun time.Time, lastRun time.Time, lastProcessGraphID string) error
+	UpdateCronSettings(cron *core.Cron) error
 	GetCronByID(cronID string) (*core.Cron, error)
 	FindCronsByColonyID(colonyID string, count int) ([]*core.Cron, error)
 	FindAllCrons() ([]*core.Cron, error)
 	DeleteCronByID(cronID string) error
 	DeleteAllCronsByColonyID(colonyID string) error
 
+	// Process event functions
+	FindProcessEvents(processID string) ([]*core.ProcessEvent, error)
+
+	// Log functions
+	AddLog(log *core.Log) error
+	GetLogSize(processID string) (int64, error)
+	FindLogs(processID string, offset int64, count int) ([]*core.Log, error)
+
+	// Artifact functions
+	AddArtifact(artifact *core.Artifact) error
+	GetArtifactByID(artifactID string) (*core.Artifact, error)
+	FindArtifactsByColonyID(colonyID string, count int) ([]*core.Artifact, error)
+	DeleteArtifactByID(artifactID string) error
+	DeleteAllArtifactsByColonyID(colonyID string) error
+
+	// Webhook functions
+	AddWebhook(webhook *core.Webhook) error
+	GetWebhookByID(webhookID string) (*core.Webhook, error)
+	FindWebhooksByColonyID(colonyID string) ([]*core.Webhook, error)
+	DeleteWebhookByID(webhookID string) error
+	DeleteAllWebhooksByColonyID(colonyID string) error
+	AddWebhookDelivery(delivery *core.WebhookDelivery) error
+	FindWebhookDeliveries(webhookID string, count int) ([]*core.WebhookDelivery, error)
+
+	// Secret functions
+	AddSecret(secret *core.Secret) error
+	GetSecretByName(colonyID string, name string) (*core.Secret, error)
+	FindSecretsByColonyID(colonyID string) ([]*core.Secret, error)
+	DeleteSecretByName(colonyID string, name string) error
+	DeleteAllSecretsByColonyID(colonyID string) error
+
+	// Identity key functions
+	SetIdentityKey(identityKey *core.IdentityKey) error
+	GetIdentityKeyByKeyID(keyID string) (*core.IdentityKey, error)
+	GetIdentityKeyByIdentityID(identityID string) (*core.IdentityKey, error)
+	GetIdentityIDByKeyID(keyID string) (string, bool, error)
+	DeleteIdentityKeyByIdentityID(identityID string) error
+	DeleteAllIdentityKeysByColonyID(colonyID string) error
+
+	// Colony admin functions
+	AddColonyAdmin(admin *core.ColonyAdmin) error
+	GetColonyAdmin(colonyID string, adminID string) (*core.ColonyAdmin, error)
+	FindColonyAdminsByColonyID(colonyID string) ([]*core.ColonyAdmin, error)
+	DeleteColonyAdmin(colonyID string, adminID string) error
+	DeleteAllColonyAdminsByColonyID(colonyID string) error
+
+	// Colony event functions
+	AddColonyEvent(event *core.ColonyEvent) error
+	FindColonyEventsSince(colonyID string, since int64, count int) ([]*core.ColonyEvent, error)
+	GetColonyEventSequence() (int64, error)
+	DeleteAllColonyEventsByColonyID(colonyID string) error
+	PruneColonyEvents(window int64) error
+
+	// Audit log functions
+	AddAuditRecord(auditRecord *core.AuditRecord) error
+	FindAuditLog(colonyID string, count int) ([]*core.AuditRecord, error)
+
+	// Run history functions
+	AddRunRecord(runRecord *core.RunRecord) error
+	FindRunHistory(triggerID string, count int) ([]*core.RunRecord, error)
+	SetRunRecordStateByProcessGraphID(processGraphID string, state int) error
+	DeleteRunHistoryByTriggerID(triggerID string) error
+
+	// Workflow template functions
+	AddWorkflowTemplate(template *core.WorkflowTemplate) error
+	GetWorkflowTemplateByID(templateID string) (*core.WorkflowTemplate, error)
+	GetWorkflowTemplate(colonyID string, name string, version int) (*core.WorkflowTemplate, error)
+	FindWorkflowTemplatesByColonyID(colonyID string) ([]*core.WorkflowTemplate, error)
+	DeleteWorkflowTemplate(colonyID string, name string, version int) error
+	DeleteAllWorkflowTemplatesByColonyID(colonyID string) error
+
 	// Distributed locking
 	Lock(timeout int) error
 	Unlock() error
@@ -0,0 +1,102 @@
+package postgresql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+func (db *PQDatabase) AddArtifact(artifact *core.Artifact) error {
+	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `ARTIFACTS (ARTIFACT_ID, COLONY_ID, NAME, HASH, SIZE, ARCHIVE, EXECUTOR_ID, ADDED) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err := db.postgresql.Exec(sqlStatement, artifact.ID, artifact.ColonyID, artifact.Name, artifact.Hash, artifact.Size, artifact.Archive, artifact.ExecutorID, artifact.Added)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) parseArtifacts(rows *sql.Rows) ([]*core.Artifact, error) {
+	var artifacts []*core.Artifact
+
+	for rows.Next() {
+		var artifactID string
+		var colonyID string
+		var name string
+		var hash string
+		var size int64
+		var archive bool
+		var executorID string
+		var added time.Time
+		if err := rows.Scan(&artifactID, &colonyID, &name, &hash, &size, &archive, &executorID, &added); err != nil {
+			return nil, err
+		}
+
+		artifact := &core.Artifact{
+			ID:         artifactID,
+			ColonyID:   colonyID,
+			Name:       name,
+			Hash:       hash,
+			Size:       size,
+			Archive:    archive,
+			ExecutorID: executorID,
+			Added:      added}
+
+		artifacts = append(artifacts, artifact)
+	}
+
+	return artifacts, nil
+}
+
+func (db *PQDatabase) GetArtifactByID(artifactID string) (*core.Artifact, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `ARTIFACTS WHERE ARTIFACT_ID=$1`
+	rows, err := db.postgresql.Query(sqlStatement, artifactID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	artifacts, err := db.parseArtifacts(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(artifacts) == 0 {
+		return nil, nil
+	}
+
+	return artifacts[0], nil
+}
+
+// FindArtifactsByColonyID returns the artifacts of a colony, newest first
+func (db *PQDatabase) FindArtifactsByColonyID(colonyID string, count int) ([]*core.Artifact, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `ARTIFACTS WHERE COLONY_ID=$1 ORDER BY ADDED DESC LIMIT $2`
+	rows, err := db.postgresql.Query(sqlStatement, colonyID, count)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return db.parseArtifacts(rows)
+}
+
+func (db *PQDatabase) DeleteArtifactByID(artifactID string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `ARTIFACTS WHERE ARTIFACT_ID=$1`
+	_, err := db.postgresql.Exec(sqlStatement, artifactID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) DeleteAllArtifactsByColonyID(colonyID string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `ARTIFACTS WHERE COLONY_ID=$1`
+	_, err := db.postgresql.Exec(sqlStatement, colonyID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package postgresql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArtifactsClosedDB(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	db.Close()
+
+	artifact := core.CreateArtifact(core.GenerateRandomID(), "model.bin", core.GenerateRandomID(), 1024, false)
+	err = db.AddArtifact(artifact)
+	assert.NotNil(t, err)
+
+	_, err = db.GetArtifactByID("invalid_id")
+	assert.NotNil(t, err)
+
+	_, err = db.FindArtifactsByColonyID("invalid_id", 1)
+	assert.NotNil(t, err)
+
+	err = db.DeleteArtifactByID("invalid_id")
+	assert.NotNil(t, err)
+
+	err = db.DeleteAllArtifactsByColonyID("invalid_id")
+	assert.NotNil(t, err)
+}
+
+func TestAddArtifact(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+
+	artifact1 := core.CreateArtifact(colonyID, "model.bin", core.GenerateRandomID(), 1024, false)
+	artifact1.ExecutorID = core.GenerateRandomID()
+	err = db.AddArtifact(artifact1)
+	assert.Nil(t, err)
+
+	artifact2 := core.CreateArtifact(colonyID, "data", core.GenerateRandomID(), 2048, true)
+	artifact2.Added = time.Now().Add(1 * time.Second)
+	err = db.AddArtifact(artifact2)
+	assert.Nil(t, err)
+
+	artifactFromDB, err := db.GetArtifactByID(artifact1.ID)
+	assert.Nil(t, err)
+	assert.True(t, artifact1.Equals(artifactFromDB))
+
+	artifactFromDB, err = db.GetArtifactByID(core.GenerateRandomID())
+	assert.Nil(t, err)
+	assert.Nil(t, artifactFromDB)
+
+	// Newest first
+	artifacts, err := db.FindArtifactsByColonyID(colonyID, 100)
+	assert.Nil(t, err)
+	assert.True(t, core.IsArtifactArraysEqual(artifacts, []*core.Artifact{artifact2, artifact1}))
+
+	artifacts, err = db.FindArtifactsByColonyID(colonyID, 1)
+	assert.Nil(t, err)
+	assert.Len(t, artifacts, 1)
+}
+
+func TestDeleteArtifacts(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colony := core.CreateColony(core.GenerateRandomID(), "test_colony_name")
+	err = db.AddColony(colony)
+	assert.Nil(t, err)
+
+	artifact1 := core.CreateArtifact(colony.ID, "model.bin", core.GenerateRandomID(), 1024, false)
+	err = db.AddArtifact(artifact1)
+	assert.Nil(t, err)
+
+	artifact2 := core.CreateArtifact(colony.ID, "data", core.GenerateRandomID(), 2048, true)
+	err = db.AddArtifact(artifact2)
+	assert.Nil(t, err)
+
+	err = db.DeleteArtifactByID(artifact1.ID)
+	assert.Nil(t, err)
+
+	artifactFromDB, err := db.GetArtifactByID(artifact1.ID)
+	assert.Nil(t, err)
+	assert.Nil(t, artifactFromDB)
+
+	// Artifacts are deleted when the colony is deleted
+	err = db.DeleteColonyByID(colony.ID)
+	assert.Nil(t, err)
+
+	artifacts, err := db.FindArtifactsByColonyID(colony.ID, 100)
+	assert.Nil(t, err)
+	assert.Len(t, artifacts, 0)
+}
@@ -0,0 +1,67 @@
+package postgresql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/lib/pq"
+)
+
+func (db *PQDatabase) AddAuditRecord(auditRecord *core.AuditRecord) error {
+	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `AUDITLOG (AUDITRECORD_ID, COLONY_ID, RECOVERED_ID, PAYLOAD_TYPE, TARGET_IDS, STATUS, ERROR, TIME) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err := db.postgresql.Exec(sqlStatement, auditRecord.ID, auditRecord.ColonyID, auditRecord.RecoveredID, auditRecord.PayloadType, pq.Array(auditRecord.TargetIDs), auditRecord.Status, auditRecord.Error, auditRecord.Time)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) parseAuditRecords(rows *sql.Rows) ([]*core.AuditRecord, error) {
+	var auditRecords []*core.AuditRecord
+
+	for rows.Next() {
+		var auditRecordID string
+		var colonyID string
+		var recoveredID string
+		var payloadType string
+		var targetIDs []string
+		var status int
+		var errMsg string
+		var auditTime time.Time
+		if err := rows.Scan(&auditRecordID, &colonyID, &recoveredID, &payloadType, pq.Array(&targetIDs), &status, &errMsg, &auditTime); err != nil {
+			return nil, err
+		}
+
+		if targetIDs == nil {
+			targetIDs = make([]string, 0)
+		}
+
+		auditRecord := &core.AuditRecord{
+			ID:          auditRecordID,
+			ColonyID:    colonyID,
+			RecoveredID: recoveredID,
+			PayloadType: payloadType,
+			TargetIDs:   targetIDs,
+			Status:      status,
+			Error:       errMsg,
+			Time:        auditTime}
+
+		auditRecords = append(auditRecords, auditRecord)
+	}
+
+	return auditRecords, nil
+}
+
+// FindAuditLog returns the latest audit records of a colony, newest first
+func (db *PQDatabase) FindAuditLog(colonyID string, count int) ([]*core.AuditRecord, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `AUDITLOG WHERE COLONY_ID=$1 ORDER BY TIME DESC LIMIT $2`
+	rows, err := db.postgresql.Query(sqlStatement, colonyID, count)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return db.parseAuditRecords(rows)
+}
@@ -0,0 +1,57 @@
+package postgresql
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLogClosedDB(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	db.Close()
+
+	auditRecord := core.CreateAuditRecord(core.GenerateRandomID(), core.GenerateRandomID(), "deleteprocessmsg", nil, http.StatusOK, "")
+	err = db.AddAuditRecord(auditRecord)
+	assert.NotNil(t, err)
+
+	_, err = db.FindAuditLog("invalid_id", 1)
+	assert.NotNil(t, err)
+}
+
+func TestAddAuditRecord(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+	executorID := core.GenerateRandomID()
+
+	auditRecord1 := core.CreateAuditRecord(colonyID, executorID, "deleteprocessmsg", []string{"processid=" + core.GenerateRandomID()}, http.StatusOK, "")
+	err = db.AddAuditRecord(auditRecord1)
+	assert.Nil(t, err)
+
+	auditRecord2 := core.CreateAuditRecord(colonyID, executorID, "approveexecutormsg", nil, http.StatusForbidden, "Access denied")
+	auditRecord2.Time = time.Now().Add(1 * time.Second)
+	err = db.AddAuditRecord(auditRecord2)
+	assert.Nil(t, err)
+
+	auditRecord3 := core.CreateAuditRecord(core.GenerateRandomID(), executorID, "deleteprocessmsg", nil, http.StatusOK, "")
+	err = db.AddAuditRecord(auditRecord3)
+	assert.Nil(t, err)
+
+	auditLog, err := db.FindAuditLog(colonyID, 100)
+	assert.Nil(t, err)
+	assert.Len(t, auditLog, 2)
+	assert.True(t, auditLog[0].Equals(auditRecord2)) // Newest first
+	assert.True(t, auditLog[1].Equals(auditRecord1))
+
+	auditLog, err = db.FindAuditLog(colonyID, 1)
+	assert.Nil(t, err)
+	assert.Len(t, auditLog, 1)
+}
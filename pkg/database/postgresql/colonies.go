@@ -113,6 +113,16 @@ func (db *PQDatabase) DeleteColonyByID(colonyID string) error {
 		return err
 	}
 
+	err = db.deleteProcessEventsByColonyID(colonyID)
+	if err != nil {
+		return err
+	}
+
+	err = db.deleteLogsByColonyID(colonyID)
+	if err != nil {
+		return err
+	}
+
 	err = db.DeleteAllGeneratorsByColonyID(colonyID)
 	if err != nil {
 		return err
@@ -128,6 +138,41 @@ func (db *PQDatabase) DeleteColonyByID(colonyID string) error {
 		return err
 	}
 
+	err = db.DeleteAllWorkflowTemplatesByColonyID(colonyID)
+	if err != nil {
+		return err
+	}
+
+	err = db.DeleteAllArtifactsByColonyID(colonyID)
+	if err != nil {
+		return err
+	}
+
+	err = db.DeleteAllWebhooksByColonyID(colonyID)
+	if err != nil {
+		return err
+	}
+
+	err = db.DeleteAllColonyEventsByColonyID(colonyID)
+	if err != nil {
+		return err
+	}
+
+	err = db.DeleteAllSecretsByColonyID(colonyID)
+	if err != nil {
+		return err
+	}
+
+	err = db.DeleteAllIdentityKeysByColonyID(colonyID)
+	if err != nil {
+		return err
+	}
+
+	err = db.DeleteAllColonyAdminsByColonyID(colonyID)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
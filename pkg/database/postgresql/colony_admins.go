@@ -0,0 +1,95 @@
+package postgresql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+func (db *PQDatabase) AddColonyAdmin(admin *core.ColonyAdmin) error {
+	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `COLONYADMINS (COLONY_ID, ADMIN_ID, NAME, ADDED_BY, ADDED) VALUES ($1, $2, $3, $4, $5)`
+	_, err := db.postgresql.Exec(sqlStatement, admin.ColonyID, admin.AdminID, admin.Name, admin.AddedBy, admin.Added)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) parseColonyAdmins(rows *sql.Rows) ([]*core.ColonyAdmin, error) {
+	var admins []*core.ColonyAdmin
+
+	for rows.Next() {
+		var colonyID string
+		var adminID string
+		var name string
+		var addedBy string
+		var added time.Time
+		if err := rows.Scan(&colonyID, &adminID, &name, &addedBy, &added); err != nil {
+			return nil, err
+		}
+
+		admin := &core.ColonyAdmin{
+			ColonyID: colonyID,
+			AdminID:  adminID,
+			Name:     name,
+			AddedBy:  addedBy,
+			Added:    added}
+
+		admins = append(admins, admin)
+	}
+
+	return admins, nil
+}
+
+func (db *PQDatabase) GetColonyAdmin(colonyID string, adminID string) (*core.ColonyAdmin, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `COLONYADMINS WHERE COLONY_ID=$1 AND ADMIN_ID=$2`
+	rows, err := db.postgresql.Query(sqlStatement, colonyID, adminID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	admins, err := db.parseColonyAdmins(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(admins) == 0 {
+		return nil, nil
+	}
+
+	return admins[0], nil
+}
+
+func (db *PQDatabase) FindColonyAdminsByColonyID(colonyID string) ([]*core.ColonyAdmin, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `COLONYADMINS WHERE COLONY_ID=$1 ORDER BY ADDED`
+	rows, err := db.postgresql.Query(sqlStatement, colonyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return db.parseColonyAdmins(rows)
+}
+
+func (db *PQDatabase) DeleteColonyAdmin(colonyID string, adminID string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `COLONYADMINS WHERE COLONY_ID=$1 AND ADMIN_ID=$2`
+	_, err := db.postgresql.Exec(sqlStatement, colonyID, adminID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) DeleteAllColonyAdminsByColonyID(colonyID string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `COLONYADMINS WHERE COLONY_ID=$1`
+	_, err := db.postgresql.Exec(sqlStatement, colonyID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package postgresql
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColonyAdminsClosedDB(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	db.Close()
+
+	err = db.AddColonyAdmin(core.CreateColonyAdmin(core.GenerateRandomID(), core.GenerateRandomID(), "alice"))
+	assert.NotNil(t, err)
+
+	_, err = db.GetColonyAdmin("invalid_id", "invalid_id")
+	assert.NotNil(t, err)
+
+	_, err = db.FindColonyAdminsByColonyID("invalid_id")
+	assert.NotNil(t, err)
+
+	err = db.DeleteColonyAdmin("invalid_id", "invalid_id")
+	assert.NotNil(t, err)
+
+	err = db.DeleteAllColonyAdminsByColonyID("invalid_id")
+	assert.NotNil(t, err)
+}
+
+func TestAddColonyAdmin(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+
+	admin1 := core.CreateColonyAdmin(colonyID, core.GenerateRandomID(), "alice")
+	admin1.AddedBy = colonyID
+	err = db.AddColonyAdmin(admin1)
+	assert.Nil(t, err)
+
+	admin2 := core.CreateColonyAdmin(colonyID, core.GenerateRandomID(), "bob")
+	admin2.AddedBy = admin1.AdminID
+	err = db.AddColonyAdmin(admin2)
+	assert.Nil(t, err)
+
+	// Adding the same admin twice should not work
+	err = db.AddColonyAdmin(admin1)
+	assert.NotNil(t, err)
+
+	adminFromDB, err := db.GetColonyAdmin(colonyID, admin1.AdminID)
+	assert.Nil(t, err)
+	assert.True(t, admin1.Equals(adminFromDB))
+
+	adminFromDB, err = db.GetColonyAdmin(core.GenerateRandomID(), admin1.AdminID)
+	assert.Nil(t, err)
+	assert.Nil(t, adminFromDB)
+
+	admins, err := db.FindColonyAdminsByColonyID(colonyID)
+	assert.Nil(t, err)
+	assert.Len(t, admins, 2)
+}
+
+func TestDeleteColonyAdmin(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+	admin1 := core.CreateColonyAdmin(colonyID, core.GenerateRandomID(), "alice")
+	err = db.AddColonyAdmin(admin1)
+	assert.Nil(t, err)
+
+	admin2 := core.CreateColonyAdmin(colonyID, core.GenerateRandomID(), "bob")
+	err = db.AddColonyAdmin(admin2)
+	assert.Nil(t, err)
+
+	colonyID2 := core.GenerateRandomID()
+	admin3 := core.CreateColonyAdmin(colonyID2, core.GenerateRandomID(), "carol")
+	err = db.AddColonyAdmin(admin3)
+	assert.Nil(t, err)
+
+	err = db.DeleteColonyAdmin(colonyID, admin1.AdminID)
+	assert.Nil(t, err)
+
+	adminFromDB, err := db.GetColonyAdmin(colonyID, admin1.AdminID)
+	assert.Nil(t, err)
+	assert.Nil(t, adminFromDB)
+
+	err = db.DeleteAllColonyAdminsByColonyID(colonyID)
+	assert.Nil(t, err)
+
+	admins, err := db.FindColonyAdminsByColonyID(colonyID)
+	assert.Nil(t, err)
+	assert.Len(t, admins, 0)
+
+	admins, err = db.FindColonyAdminsByColonyID(colonyID2)
+	assert.Nil(t, err)
+	assert.Len(t, admins, 1)
+}
@@ -0,0 +1,105 @@
+package postgresql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+// AddColonyEvent stores the event and sets its sequence number, sequence numbers are assigned by the database so
+// that they increase monotonically across all Colonies servers in a cluster
+func (db *PQDatabase) AddColonyEvent(event *core.ColonyEvent) error {
+	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `COLONYEVENTS (EVENT_ID, COLONY_ID, KIND, TYPE, TARGET_ID, STATE, EXECUTOR_ID, TIME) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING SEQUENCE`
+	var sequence int64
+	err := db.postgresql.QueryRow(sqlStatement, event.ID, event.ColonyID, event.Kind, event.Type, event.TargetID, event.State, event.ExecutorID, event.Time).Scan(&sequence)
+	if err != nil {
+		return err
+	}
+
+	event.Sequence = sequence
+
+	return nil
+}
+
+func (db *PQDatabase) parseColonyEvents(rows *sql.Rows) ([]*core.ColonyEvent, error) {
+	var events []*core.ColonyEvent
+
+	for rows.Next() {
+		var sequence int64
+		var eventID string
+		var colonyID string
+		var kind string
+		var eventType string
+		var targetID string
+		var state int
+		var executorID string
+		var t time.Time
+		if err := rows.Scan(&sequence, &eventID, &colonyID, &kind, &eventType, &targetID, &state, &executorID, &t); err != nil {
+			return nil, err
+		}
+
+		event := &core.ColonyEvent{
+			ID:         eventID,
+			Sequence:   sequence,
+			ColonyID:   colonyID,
+			Kind:       kind,
+			Type:       eventType,
+			TargetID:   targetID,
+			State:      state,
+			ExecutorID: executorID,
+			Time:       t}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// FindColonyEventsSince returns at most count events with a sequence number greater than since, oldest first
+func (db *PQDatabase) FindColonyEventsSince(colonyID string, since int64, count int) ([]*core.ColonyEvent, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `COLONYEVENTS WHERE COLONY_ID=$1 AND SEQUENCE>$2 ORDER BY SEQUENCE ASC LIMIT $3`
+	rows, err := db.postgresql.Query(sqlStatement, colonyID, since, count)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return db.parseColonyEvents(rows)
+}
+
+// GetColonyEventSequence returns the sequence number of the last stored event in any colony, or 0 if no event has
+// been stored. Sequence numbers are shared by all colonies and are never reused, also not after events are pruned.
+func (db *PQDatabase) GetColonyEventSequence() (int64, error) {
+	sqlStatement := `SELECT CASE WHEN IS_CALLED THEN LAST_VALUE ELSE 0 END FROM ` + db.dbPrefix + `COLONYEVENTS_SEQUENCE_SEQ`
+	var sequence int64
+	err := db.postgresql.QueryRow(sqlStatement).Scan(&sequence)
+	if err != nil {
+		return 0, err
+	}
+
+	return sequence, nil
+}
+
+func (db *PQDatabase) DeleteAllColonyEventsByColonyID(colonyID string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `COLONYEVENTS WHERE COLONY_ID=$1`
+	_, err := db.postgresql.Exec(sqlStatement, colonyID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PruneColonyEvents deletes events older than window seconds, subscriptions can only be resumed within the window
+func (db *PQDatabase) PruneColonyEvents(window int64) error {
+	_, timestamp := db.calcTimestamp(window)
+
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `COLONYEVENTS WHERE TIME<$1`
+	_, err := db.postgresql.Exec(sqlStatement, timestamp)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
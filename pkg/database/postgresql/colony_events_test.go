@@ -0,0 +1,132 @@
+package postgresql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColonyEventsClosedDB(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	db.Close()
+
+	event := core.CreateColonyEvent(core.GenerateRandomID(), core.PROCESS_KIND, core.FAILED_EVENT, core.GenerateRandomID(), core.FAILED, "")
+	err = db.AddColonyEvent(event)
+	assert.NotNil(t, err)
+
+	_, err = db.FindColonyEventsSince("invalid_id", 0, 1)
+	assert.NotNil(t, err)
+
+	err = db.DeleteAllColonyEventsByColonyID("invalid_id")
+	assert.NotNil(t, err)
+
+	err = db.PruneColonyEvents(1)
+	assert.NotNil(t, err)
+}
+
+func TestAddColonyEvent(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+
+	event1 := core.CreateColonyEvent(colonyID, core.PROCESS_KIND, core.SUBMITTED_EVENT, core.GenerateRandomID(), core.WAITING, "")
+	err = db.AddColonyEvent(event1)
+	assert.Nil(t, err)
+	assert.Greater(t, event1.Sequence, int64(0))
+
+	event2 := core.CreateColonyEvent(colonyID, core.EXECUTOR_KIND, core.ADDED_EVENT, core.GenerateRandomID(), core.PENDING, "")
+	err = db.AddColonyEvent(event2)
+	assert.Nil(t, err)
+	assert.Greater(t, event2.Sequence, event1.Sequence)
+
+	event3 := core.CreateColonyEvent(core.GenerateRandomID(), core.EXECUTOR_KIND, core.ADDED_EVENT, core.GenerateRandomID(), core.PENDING, "")
+	err = db.AddColonyEvent(event3)
+	assert.Nil(t, err)
+
+	events, err := db.FindColonyEventsSince(colonyID, 0, 100)
+	assert.Nil(t, err)
+	assert.Len(t, events, 2)
+	assert.True(t, events[0].Equals(event1))
+	assert.True(t, events[1].Equals(event2))
+
+	events, err = db.FindColonyEventsSince(colonyID, event1.Sequence, 100)
+	assert.Nil(t, err)
+	assert.Len(t, events, 1)
+	assert.True(t, events[0].Equals(event2))
+
+	events, err = db.FindColonyEventsSince(colonyID, 0, 1)
+	assert.Nil(t, err)
+	assert.Len(t, events, 1)
+
+	err = db.DeleteAllColonyEventsByColonyID(colonyID)
+	assert.Nil(t, err)
+
+	events, err = db.FindColonyEventsSince(colonyID, 0, 100)
+	assert.Nil(t, err)
+	assert.Len(t, events, 0)
+
+	events, err = db.FindColonyEventsSince(event3.ColonyID, 0, 100)
+	assert.Nil(t, err)
+	assert.Len(t, events, 1)
+}
+
+func TestGetColonyEventSequence(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	sequence, err := db.GetColonyEventSequence()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), sequence)
+
+	colonyID := core.GenerateRandomID()
+	event := core.CreateColonyEvent(colonyID, core.PROCESS_KIND, core.SUBMITTED_EVENT, core.GenerateRandomID(), core.WAITING, "")
+	err = db.AddColonyEvent(event)
+	assert.Nil(t, err)
+
+	sequence, err = db.GetColonyEventSequence()
+	assert.Nil(t, err)
+	assert.Equal(t, event.Sequence, sequence)
+
+	// The sequence number is kept when events are deleted
+	err = db.DeleteAllColonyEventsByColonyID(colonyID)
+	assert.Nil(t, err)
+
+	sequence, err = db.GetColonyEventSequence()
+	assert.Nil(t, err)
+	assert.Equal(t, event.Sequence, sequence)
+}
+
+func TestPruneColonyEvents(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+
+	oldEvent := core.CreateColonyEvent(colonyID, core.PROCESS_KIND, core.SUBMITTED_EVENT, core.GenerateRandomID(), core.WAITING, "")
+	oldEvent.Time = time.Now().Add(-2 * time.Hour)
+	err = db.AddColonyEvent(oldEvent)
+	assert.Nil(t, err)
+
+	event := core.CreateColonyEvent(colonyID, core.PROCESS_KIND, core.SUBMITTED_EVENT, core.GenerateRandomID(), core.WAITING, "")
+	err = db.AddColonyEvent(event)
+	assert.Nil(t, err)
+
+	err = db.PruneColonyEvents(3600)
+	assert.Nil(t, err)
+
+	events, err := db.FindColonyEventsSince(colonyID, 0, 100)
+	assert.Nil(t, err)
+	assert.Len(t, events, 1)
+	assert.True(t, events[0].Equals(event))
+}
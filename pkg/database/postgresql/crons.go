@@ -8,8 +8,8 @@ import (
 )
 
 func (db *PQDatabase) AddCron(cron *core.Cron) error {
-	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `CRONS (CRON_ID, COLONY_ID, NAME, CRON_EXPR, INTERVAL, RANDOM, NEXT_RUN, LAST_RUN, WORKFLOW_SPEC, PREV_PROCESSGRAPH_ID, WAIT_FOR_PREV_PROCESSGRAPH) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
-	_, err := db.postgresql.Exec(sqlStatement, cron.ID, cron.ColonyID, cron.Name, cron.CronExpression, cron.Interval, cron.Random, cron.NextRun, cron.LastRun, cron.WorkflowSpec, cron.PrevProcessGraphID, cron.WaitForPrevProcessGraph)
+	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `CRONS (CRON_ID, COLONY_ID, NAME, CRON_EXPR, INTERVAL, RANDOM, NEXT_RUN, LAST_RUN, WORKFLOW_SPEC, PREV_PROCESSGRAPH_ID, WAIT_FOR_PREV_PROCESSGRAPH, PAUSED) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+	_, err := db.postgresql.Exec(sqlStatement, cron.ID, cron.ColonyID, cron.Name, cron.CronExpression, cron.Interval, cron.Random, cron.NextRun, cron.LastRun, cron.WorkflowSpec, cron.PrevProcessGraphID, cron.WaitForPrevProcessGraph, cron.Paused)
 	if err != nil {
 		return err
 	}
@@ -27,6 +27,18 @@ func (db *PQDatabase) UpdateCron(cronID string, nextRun time.Time, lastRun time.
 	return nil
 }
 
+// UpdateCronSettings replaces the user configurable settings of a cron. NextRun is reset so that
+// the next run is recalculated, LastRun and PrevProcessGraphID are kept.
+func (db *PQDatabase) UpdateCronSettings(cron *core.Cron) error {
+	sqlStatement := `UPDATE  ` + db.dbPrefix + `CRONS SET CRON_EXPR=$1, INTERVAL=$2, RANDOM=$3, WORKFLOW_SPEC=$4, WAIT_FOR_PREV_PROCESSGRAPH=$5, PAUSED=$6, NEXT_RUN=$7 WHERE CRON_ID=$8`
+	_, err := db.postgresql.Exec(sqlStatement, cron.CronExpression, cron.Interval, cron.Random, cron.WorkflowSpec, cron.WaitForPrevProcessGraph, cron.Paused, time.Time{}, cron.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (db *PQDatabase) parseCrons(rows *sql.Rows) ([]*core.Cron, error) {
 	var crons []*core.Cron
 
@@ -42,12 +54,13 @@ func (db *PQDatabase) parseCrons(rows *sql.Rows) ([]*core.Cron, error) {
 		var workflowSpec string
 		var prevProcessGraphID string
 		var waitForPrevProcessGraph bool
+		var paused bool
 
-		if err := rows.Scan(&cronID, &colonyID, &name, &cronExpr, &interval, &random, &nextRun, &lastRun, &workflowSpec, &prevProcessGraphID, &waitForPrevProcessGraph); err != nil {
+		if err := rows.Scan(&cronID, &colonyID, &name, &cronExpr, &interval, &random, &nextRun, &lastRun, &workflowSpec, &prevProcessGraphID, &waitForPrevProcessGraph, &paused); err != nil {
 			return nil, err
 		}
 
-		cron := &core.Cron{ID: cronID, ColonyID: colonyID, Name: name, CronExpression: cronExpr, Interval: interval, Random: random, NextRun: nextRun, LastRun: lastRun, WorkflowSpec: workflowSpec, PrevProcessGraphID: prevProcessGraphID, WaitForPrevProcessGraph: waitForPrevProcessGraph}
+		cron := &core.Cron{ID: cronID, ColonyID: colonyID, Name: name, CronExpression: cronExpr, Interval: interval, Random: random, NextRun: nextRun, LastRun: lastRun, WorkflowSpec: workflowSpec, PrevProcessGraphID: prevProcessGraphID, WaitForPrevProcessGraph: waitForPrevProcessGraph, Paused: paused}
 
 		crons = append(crons, cron)
 	}
@@ -115,7 +128,7 @@ func (db *PQDatabase) DeleteCronByID(cronID string) error {
 		return err
 	}
 
-	return nil
+	return db.DeleteRunHistoryByTriggerID(cronID)
 }
 
 func (db *PQDatabase) DeleteAllCronsByColonyID(colonyID string) error {
@@ -125,5 +138,5 @@ func (db *PQDatabase) DeleteAllCronsByColonyID(colonyID string) error {
 		return err
 	}
 
-	return nil
+	return db.deleteRunHistoryByColonyID(colonyID, core.CRON_TRIGGER)
 }
@@ -23,6 +23,9 @@ func TestCronClosedDB(t *testing.T) {
 	err = db.UpdateCron("invalid_id", time.Now(), time.Time{}, core.GenerateRandomID())
 	assert.NotNil(t, err)
 
+	err = db.UpdateCronSettings(cron)
+	assert.NotNil(t, err)
+
 	_, err = db.GetCronByID("invalid_id")
 	assert.NotNil(t, err)
 
@@ -97,6 +100,44 @@ func TestUpdateCron(t *testing.T) {
 	assert.Greater(t, cronFromDB.LastRun.Unix(), time.Time{}.Unix())
 }
 
+func TestUpdateCronSettings(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	cron := core.CreateCron(core.GenerateRandomID(), "test_name", "* * * * * *", -1, false, "workflow")
+	cron.ID = core.GenerateRandomID()
+
+	err = db.AddCron(cron)
+	assert.Nil(t, err)
+
+	prevProcessGraphID := core.GenerateRandomID()
+	err = db.UpdateCron(cron.ID, time.Now(), time.Now(), prevProcessGraphID)
+	assert.Nil(t, err)
+
+	cron.CronExpression = "0 0 * * * *"
+	cron.Interval = 10
+	cron.Random = true
+	cron.WorkflowSpec = "new_workflow"
+	cron.WaitForPrevProcessGraph = true
+	cron.Paused = true
+	err = db.UpdateCronSettings(cron)
+	assert.Nil(t, err)
+
+	cronFromDB, err := db.GetCronByID(cron.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, cronFromDB.CronExpression, "0 0 * * * *")
+	assert.Equal(t, cronFromDB.Interval, 10)
+	assert.True(t, cronFromDB.Random)
+	assert.Equal(t, cronFromDB.WorkflowSpec, "new_workflow")
+	assert.True(t, cronFromDB.WaitForPrevProcessGraph)
+	assert.True(t, cronFromDB.Paused)
+	assert.Equal(t, cronFromDB.NextRun.Unix(), time.Time{}.Unix())
+	assert.Greater(t, cronFromDB.LastRun.Unix(), time.Time{}.Unix())
+	assert.Equal(t, cronFromDB.PrevProcessGraphID, prevProcessGraphID)
+}
+
 func TestFindCronsByColonyID(t *testing.T) {
 	db, err := PrepareTests()
 	assert.Nil(t, err)
@@ -165,6 +165,136 @@ func (db *PQDatabase) dropCronsTable() error {
 	return nil
 }
 
+func (db *PQDatabase) dropRunHistoryTable() error {
+	sqlStatement := `DROP TABLE ` + db.dbPrefix + `RUNHISTORY`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) dropWorkflowTemplatesTable() error {
+	sqlStatement := `DROP TABLE ` + db.dbPrefix + `WORKFLOWTEMPLATES`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) dropProcessEventsTable() error {
+	sqlStatement := `DROP TABLE ` + db.dbPrefix + `PROCESSEVENTS`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) dropAuditLogTable() error {
+	sqlStatement := `DROP TABLE ` + db.dbPrefix + `AUDITLOG`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) dropLogsTable() error {
+	sqlStatement := `DROP TABLE ` + db.dbPrefix + `LOGS`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) dropArtifactsTable() error {
+	sqlStatement := `DROP TABLE ` + db.dbPrefix + `ARTIFACTS`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) dropWebhooksTable() error {
+	sqlStatement := `DROP TABLE ` + db.dbPrefix + `WEBHOOKS`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) dropWebhookDeliveriesTable() error {
+	sqlStatement := `DROP TABLE ` + db.dbPrefix + `WEBHOOKDELIVERIES`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) dropColonyEventsTable() error {
+	sqlStatement := `DROP TABLE ` + db.dbPrefix + `COLONYEVENTS`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) dropSecretsTable() error {
+	sqlStatement := `DROP TABLE ` + db.dbPrefix + `SECRETS`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) dropIdentityKeysTable() error {
+	sqlStatement := `DROP TABLE ` + db.dbPrefix + `IDENTITYKEYS`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) dropRotatedKeysTable() error {
+	sqlStatement := `DROP TABLE ` + db.dbPrefix + `ROTATEDKEYS`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) dropColonyAdminsTable() error {
+	sqlStatement := `DROP TABLE ` + db.dbPrefix + `COLONYADMINS`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (db *PQDatabase) Drop() error {
 	err := db.dropColoniesTable()
 	if err != nil {
@@ -211,6 +341,71 @@ func (db *PQDatabase) Drop() error {
 		return err
 	}
 
+	err = db.dropRunHistoryTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.dropWorkflowTemplatesTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.dropProcessEventsTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.dropAuditLogTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.dropLogsTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.dropArtifactsTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.dropWebhooksTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.dropWebhookDeliveriesTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.dropColonyEventsTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.dropSecretsTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.dropIdentityKeysTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.dropRotatedKeysTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.dropColonyAdminsTable()
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -244,7 +439,7 @@ func (db *PQDatabase) createFunctionsTable() error {
 }
 
 func (db *PQDatabase) createProcessesTable() error {
-	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `PROCESSES (PROCESS_ID TEXT PRIMARY KEY NOT NULL, TARGET_COLONY_ID TEXT NOT NULL, TARGET_EXECUTOR_IDS TEXT[], ASSIGNED_EXECUTOR_ID TEXT, STATE INTEGER, IS_ASSIGNED BOOLEAN, EXECUTOR_TYPE TEXT, SUBMISSION_TIME TIMESTAMPTZ, START_TIME TIMESTAMPTZ, END_TIME TIMESTAMPTZ, WAIT_DEADLINE TIMESTAMPTZ, EXEC_DEADLINE TIMESTAMPTZ, ERRORS TEXT[], NODENAME TEXT, FUNCNAME TEXT, ARGS TEXT[], MAX_WAIT_TIME INTEGER, MAX_EXEC_TIME INTEGER, RETRIES INTEGER, MAX_RETRIES INTEGER, DEPENDENCIES TEXT[], PRIORITY INTEGER, PRIORITYTIME BIGINT, WAIT_FOR_PARENTS BOOLEAN, PARENTS TEXT[], CHILDREN TEXT[], PROCESSGRAPH_ID TEXT, INPUT TEXT[], OUTPUT TEXT[], LABEL TEXT)`
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `PROCESSES (PROCESS_ID TEXT PRIMARY KEY NOT NULL, TARGET_COLONY_ID TEXT NOT NULL, TARGET_EXECUTOR_IDS TEXT[], ASSIGNED_EXECUTOR_ID TEXT, STATE INTEGER, IS_ASSIGNED BOOLEAN, EXECUTOR_TYPE TEXT, SUBMISSION_TIME TIMESTAMPTZ, START_TIME TIMESTAMPTZ, END_TIME TIMESTAMPTZ, WAIT_DEADLINE TIMESTAMPTZ, EXEC_DEADLINE TIMESTAMPTZ, ERRORS TEXT[], NODENAME TEXT, FUNCNAME TEXT, ARGS TEXT[], MAX_WAIT_TIME INTEGER, MAX_EXEC_TIME INTEGER, RETRIES INTEGER, MAX_RETRIES INTEGER, DEPENDENCIES TEXT[], PRIORITY INTEGER, PRIORITYTIME BIGINT, WAIT_FOR_PARENTS BOOLEAN, PARENTS TEXT[], CHILDREN TEXT[], PROCESSGRAPH_ID TEXT, INPUT TEXT[], OUTPUT TEXT[], LABEL TEXT, CONDITION TEXT, MAP BOOLEAN, MAX_PARALLEL INTEGER, WORKFLOW TEXT, INPUTS TEXT, NAMED_INPUT TEXT, NAMED_OUTPUT TEXT, ALLOW_FAILURE BOOLEAN)`
 	_, err := db.postgresql.Exec(sqlStatement)
 	if err != nil {
 		return err
@@ -264,7 +459,7 @@ func (db *PQDatabase) createAttributesTable() error {
 }
 
 func (db *PQDatabase) createProcessGraphsTable() error {
-	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `PROCESSGRAPHS (PROCESSGRAPH_ID TEXT PRIMARY KEY NOT NULL, TARGET_COLONY_ID TEXT NOT NULL, ROOTS TEXT[], STATE INTEGER, SUBMISSION_TIME TIMESTAMPTZ, START_TIME TIMESTAMPTZ, END_TIME TIMESTAMPTZ)`
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `PROCESSGRAPHS (PROCESSGRAPH_ID TEXT PRIMARY KEY NOT NULL, TARGET_COLONY_ID TEXT NOT NULL, ROOTS TEXT[], STATE INTEGER, SUBMISSION_TIME TIMESTAMPTZ, START_TIME TIMESTAMPTZ, END_TIME TIMESTAMPTZ, PARENT_PROCESS_ID TEXT, FAILURE_POLICY TEXT, DEADLINE TIMESTAMPTZ)`
 	_, err := db.postgresql.Exec(sqlStatement)
 	if err != nil {
 		return err
@@ -274,7 +469,7 @@ func (db *PQDatabase) createProcessGraphsTable() error {
 }
 
 func (db *PQDatabase) createGeneratorsTable() error {
-	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `GENERATORS (GENERATOR_ID TEXT PRIMARY KEY NOT NULL, COLONY_ID TEXT NOT NULL, NAME TEXT NOT NULL UNIQUE, WORKFLOW_SPEC TEXT NOT NULL, TRIGGER INTEGER, TIMEOUT INTEGER, LASTRUN TIMESTAMPTZ, FIRSTPACK TIMESTAMPTZ)`
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `GENERATORS (GENERATOR_ID TEXT PRIMARY KEY NOT NULL, COLONY_ID TEXT NOT NULL, NAME TEXT NOT NULL UNIQUE, WORKFLOW_SPEC TEXT NOT NULL, TRIGGER INTEGER, TIMEOUT INTEGER, LASTRUN TIMESTAMPTZ, FIRSTPACK TIMESTAMPTZ, PAUSED BOOLEAN)`
 	_, err := db.postgresql.Exec(sqlStatement)
 	if err != nil {
 		return err
@@ -294,7 +489,207 @@ func (db *PQDatabase) createGeneratorArgsTable() error {
 }
 
 func (db *PQDatabase) createCronsTable() error {
-	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `CRONS (CRON_ID TEXT PRIMARY KEY NOT NULL, COLONY_ID TEXT NOT NULL, NAME TEXT NOT NULL UNIQUE, CRON_EXPR TEXT NOT NULL, INTERVAL INT, RANDOM BOOLEAN, NEXT_RUN TIMESTAMPTZ, LAST_RUN TIMESTAMPTZ, WORKFLOW_SPEC TEXT NOT NULL, PREV_PROCESSGRAPH_ID TEXT NOT NULL, WAIT_FOR_PREV_PROCESSGRAPH BOOLEAN)`
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `CRONS (CRON_ID TEXT PRIMARY KEY NOT NULL, COLONY_ID TEXT NOT NULL, NAME TEXT NOT NULL UNIQUE, CRON_EXPR TEXT NOT NULL, INTERVAL INT, RANDOM BOOLEAN, NEXT_RUN TIMESTAMPTZ, LAST_RUN TIMESTAMPTZ, WORKFLOW_SPEC TEXT NOT NULL, PREV_PROCESSGRAPH_ID TEXT NOT NULL, WAIT_FOR_PREV_PROCESSGRAPH BOOLEAN, PAUSED BOOLEAN)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createRunHistoryTable() error {
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `RUNHISTORY (RUNRECORD_ID TEXT PRIMARY KEY NOT NULL, COLONY_ID TEXT NOT NULL, TRIGGER_ID TEXT NOT NULL, TRIGGER_TYPE TEXT NOT NULL, SCHEDULED_TIME TIMESTAMPTZ, TRIGGERED_TIME TIMESTAMPTZ, PROCESSGRAPH_ID TEXT NOT NULL, STATE INTEGER, SKIPPED BOOLEAN, REASON TEXT NOT NULL)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createRunHistoryIndex() error {
+	sqlStatement := `CREATE INDEX ` + db.dbPrefix + `RUNHISTORY_INDEX ON ` + db.dbPrefix + `RUNHISTORY (TRIGGER_ID, TRIGGERED_TIME)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createWorkflowTemplatesTable() error {
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `WORKFLOWTEMPLATES (WORKFLOWTEMPLATE_ID TEXT PRIMARY KEY NOT NULL, COLONY_ID TEXT NOT NULL, NAME TEXT NOT NULL, VERSION INTEGER NOT NULL, PARAMETERS TEXT NOT NULL, WORKFLOW_SPEC TEXT NOT NULL, ADDED TIMESTAMPTZ, UNIQUE (COLONY_ID, NAME, VERSION))`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createProcessEventsTable() error {
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `PROCESSEVENTS (PROCESSEVENT_ID TEXT PRIMARY KEY NOT NULL, PROCESS_ID TEXT NOT NULL, COLONY_ID TEXT NOT NULL, TYPE TEXT NOT NULL, STATE INTEGER, EXECUTOR_ID TEXT NOT NULL, MESSAGE TEXT NOT NULL, TIME TIMESTAMPTZ)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createProcessEventsIndex() error {
+	sqlStatement := `CREATE INDEX ` + db.dbPrefix + `PROCESSEVENTS_INDEX ON ` + db.dbPrefix + `PROCESSEVENTS (PROCESS_ID, TIME)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createAuditLogTable() error {
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `AUDITLOG (AUDITRECORD_ID TEXT PRIMARY KEY NOT NULL, COLONY_ID TEXT NOT NULL, RECOVERED_ID TEXT NOT NULL, PAYLOAD_TYPE TEXT NOT NULL, TARGET_IDS TEXT[], STATUS INTEGER, ERROR TEXT NOT NULL, TIME TIMESTAMPTZ)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createAuditLogIndex() error {
+	sqlStatement := `CREATE INDEX ` + db.dbPrefix + `AUDITLOG_INDEX ON ` + db.dbPrefix + `AUDITLOG (COLONY_ID, TIME)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createLogsTable() error {
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `LOGS (LOG_ID TEXT PRIMARY KEY NOT NULL, PROCESS_ID TEXT NOT NULL, COLONY_ID TEXT NOT NULL, EXECUTOR_ID TEXT NOT NULL, LOG_OFFSET BIGINT, MESSAGE TEXT NOT NULL, TIME TIMESTAMPTZ)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createLogsIndex() error {
+	sqlStatement := `CREATE INDEX ` + db.dbPrefix + `LOGS_INDEX ON ` + db.dbPrefix + `LOGS (PROCESS_ID, LOG_OFFSET)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createArtifactsTable() error {
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `ARTIFACTS (ARTIFACT_ID TEXT PRIMARY KEY NOT NULL, COLONY_ID TEXT NOT NULL, NAME TEXT NOT NULL, HASH TEXT NOT NULL, SIZE BIGINT, ARCHIVE BOOLEAN, EXECUTOR_ID TEXT NOT NULL, ADDED TIMESTAMPTZ)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createArtifactsIndex() error {
+	sqlStatement := `CREATE INDEX ` + db.dbPrefix + `ARTIFACTS_INDEX ON ` + db.dbPrefix + `ARTIFACTS (COLONY_ID, ADDED)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createWebhooksTable() error {
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `WEBHOOKS (WEBHOOK_ID TEXT PRIMARY KEY NOT NULL, COLONY_ID TEXT NOT NULL, URL TEXT NOT NULL, SECRET TEXT NOT NULL, KINDS TEXT[], TYPES TEXT[], ADDED TIMESTAMPTZ)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createWebhookDeliveriesTable() error {
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `WEBHOOKDELIVERIES (DELIVERY_ID TEXT PRIMARY KEY NOT NULL, WEBHOOK_ID TEXT NOT NULL, COLONY_ID TEXT NOT NULL, EVENT_ID TEXT NOT NULL, KIND TEXT NOT NULL, TYPE TEXT NOT NULL, ATTEMPTS INTEGER, STATUS_CODE INTEGER, SUCCESS BOOLEAN, ERROR TEXT NOT NULL, TIME TIMESTAMPTZ)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createWebhookDeliveriesIndex() error {
+	sqlStatement := `CREATE INDEX ` + db.dbPrefix + `WEBHOOKDELIVERIES_INDEX ON ` + db.dbPrefix + `WEBHOOKDELIVERIES (WEBHOOK_ID, TIME)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createColonyEventsTable() error {
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `COLONYEVENTS (SEQUENCE BIGSERIAL PRIMARY KEY, EVENT_ID TEXT NOT NULL, COLONY_ID TEXT NOT NULL, KIND TEXT NOT NULL, TYPE TEXT NOT NULL, TARGET_ID TEXT NOT NULL, STATE INTEGER, EXECUTOR_ID TEXT NOT NULL, TIME TIMESTAMPTZ)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createColonyEventsIndex() error {
+	sqlStatement := `CREATE INDEX ` + db.dbPrefix + `COLONYEVENTS_INDEX ON ` + db.dbPrefix + `COLONYEVENTS (COLONY_ID, SEQUENCE)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createIdentityKeysTable() error {
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `IDENTITYKEYS (KEY_ID TEXT PRIMARY KEY NOT NULL, IDENTITY_ID TEXT UNIQUE NOT NULL, COLONY_ID TEXT NOT NULL, ROTATED TIMESTAMPTZ)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createRotatedKeysTable() error {
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `ROTATEDKEYS (KEY_ID TEXT PRIMARY KEY NOT NULL, IDENTITY_ID TEXT NOT NULL, COLONY_ID TEXT NOT NULL, ROTATED TIMESTAMPTZ)`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createSecretsTable() error {
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `SECRETS (SECRET_ID TEXT PRIMARY KEY NOT NULL, COLONY_ID TEXT NOT NULL, NAME TEXT NOT NULL, VALUE TEXT NOT NULL, EXECUTOR_TYPES TEXT[], ADDED TIMESTAMPTZ, UNIQUE (COLONY_ID, NAME))`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) createColonyAdminsTable() error {
+	sqlStatement := `CREATE TABLE ` + db.dbPrefix + `COLONYADMINS (COLONY_ID TEXT NOT NULL, ADMIN_ID TEXT NOT NULL, NAME TEXT NOT NULL, ADDED_BY TEXT NOT NULL, ADDED TIMESTAMPTZ, PRIMARY KEY (COLONY_ID, ADMIN_ID))`
 	_, err := db.postgresql.Exec(sqlStatement)
 	if err != nil {
 		return err
@@ -479,6 +874,106 @@ func (db *PQDatabase) Initialize() error {
 		return err
 	}
 
+	err = db.createRunHistoryTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.createRunHistoryIndex()
+	if err != nil {
+		return err
+	}
+
+	err = db.createWorkflowTemplatesTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.createProcessEventsTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.createProcessEventsIndex()
+	if err != nil {
+		return err
+	}
+
+	err = db.createAuditLogTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.createAuditLogIndex()
+	if err != nil {
+		return err
+	}
+
+	err = db.createLogsTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.createLogsIndex()
+	if err != nil {
+		return err
+	}
+
+	err = db.createArtifactsTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.createArtifactsIndex()
+	if err != nil {
+		return err
+	}
+
+	err = db.createWebhooksTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.createWebhookDeliveriesTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.createWebhookDeliveriesIndex()
+	if err != nil {
+		return err
+	}
+
+	err = db.createColonyEventsTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.createColonyEventsIndex()
+	if err != nil {
+		return err
+	}
+
+	err = db.createSecretsTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.createIdentityKeysTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.createRotatedKeysTable()
+	if err != nil {
+		return err
+	}
+
+	err = db.createColonyAdminsTable()
+	if err != nil {
+		return err
+	}
+
 	err = db.createProcessesIndex1()
 	if err != nil {
 		return err
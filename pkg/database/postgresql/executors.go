@@ -184,6 +184,11 @@ func (db *PQDatabase) DeleteExecutorByID(executorID string) error {
 		return err
 	}
 
+	err = db.DeleteIdentityKeyByIdentityID(executorID)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -9,8 +9,8 @@ import (
 )
 
 func (db *PQDatabase) AddGenerator(generator *core.Generator) error {
-	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `GENERATORS (GENERATOR_ID, COLONY_ID, NAME, WORKFLOW_SPEC, TRIGGER, TIMEOUT, LASTRUN, FIRSTPACK) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
-	_, err := db.postgresql.Exec(sqlStatement, generator.ID, generator.ColonyID, generator.Name, generator.WorkflowSpec, generator.Trigger, generator.Timeout, time.Time{}, time.Time{})
+	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `GENERATORS (GENERATOR_ID, COLONY_ID, NAME, WORKFLOW_SPEC, TRIGGER, TIMEOUT, LASTRUN, FIRSTPACK, PAUSED) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	_, err := db.postgresql.Exec(sqlStatement, generator.ID, generator.ColonyID, generator.Name, generator.WorkflowSpec, generator.Trigger, generator.Timeout, time.Time{}, time.Time{}, generator.Paused)
 	if err != nil {
 		return err
 	}
@@ -30,11 +30,12 @@ func (db *PQDatabase) parseGenerators(rows *sql.Rows) ([]*core.Generator, error)
 		var timeout int
 		var lastRun time.Time
 		var firstPack time.Time
-		if err := rows.Scan(&generatorID, &colonyID, &name, &workflowSpec, &trigger, &timeout, &lastRun, &firstPack); err != nil {
+		var paused bool
+		if err := rows.Scan(&generatorID, &colonyID, &name, &workflowSpec, &trigger, &timeout, &lastRun, &firstPack, &paused); err != nil {
 			return nil, err
 		}
 
-		generator := &core.Generator{ID: generatorID, ColonyID: colonyID, Name: name, WorkflowSpec: workflowSpec, Trigger: trigger, Timeout: timeout, LastRun: lastRun, FirstPack: firstPack}
+		generator := &core.Generator{ID: generatorID, ColonyID: colonyID, Name: name, WorkflowSpec: workflowSpec, Trigger: trigger, Timeout: timeout, LastRun: lastRun, FirstPack: firstPack, Paused: paused}
 
 		generators = append(generators, generator)
 	}
@@ -116,6 +117,18 @@ func (db *PQDatabase) SetGeneratorFirstPack(generatorID string) error {
 	return nil
 }
 
+// UpdateGeneratorSettings replaces the user configurable settings of a generator, packed args,
+// LastRun and FirstPack are kept.
+func (db *PQDatabase) UpdateGeneratorSettings(generator *core.Generator) error {
+	sqlStatement := `UPDATE  ` + db.dbPrefix + `GENERATORS SET WORKFLOW_SPEC=$1, TRIGGER=$2, TIMEOUT=$3, PAUSED=$4 WHERE GENERATOR_ID=$5`
+	_, err := db.postgresql.Exec(sqlStatement, generator.WorkflowSpec, generator.Trigger, generator.Timeout, generator.Paused, generator.ID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (db *PQDatabase) FindGeneratorsByColonyID(colonyID string, count int) ([]*core.Generator, error) {
 	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `GENERATORS WHERE COLONY_ID=$1 LIMIT $2`
 	rows, err := db.postgresql.Query(sqlStatement, colonyID, count)
@@ -155,7 +168,12 @@ func (db *PQDatabase) DeleteGeneratorByID(generatorID string) error {
 		return err
 	}
 
-	return db.DeleteAllGeneratorArgsByGeneratorID(generatorID)
+	err = db.DeleteAllGeneratorArgsByGeneratorID(generatorID)
+	if err != nil {
+		return err
+	}
+
+	return db.DeleteRunHistoryByTriggerID(generatorID)
 }
 
 func (db *PQDatabase) DeleteAllGeneratorsByColonyID(colonyID string) error {
@@ -165,5 +183,10 @@ func (db *PQDatabase) DeleteAllGeneratorsByColonyID(colonyID string) error {
 		return err
 	}
 
-	return db.DeleteAllGeneratorArgsByColonyID(colonyID)
+	err = db.DeleteAllGeneratorArgsByColonyID(colonyID)
+	if err != nil {
+		return err
+	}
+
+	return db.deleteRunHistoryByColonyID(colonyID, core.GENERATOR_TRIGGER)
 }
@@ -25,6 +25,9 @@ func TestGeneratorClosedDB(t *testing.T) {
 	err = db.SetGeneratorFirstPack("invalid_id")
 	assert.NotNil(t, err)
 
+	err = db.UpdateGeneratorSettings(generator)
+	assert.NotNil(t, err)
+
 	_, err = db.GetGeneratorByID("invalid_id")
 	assert.NotNil(t, err)
 
@@ -147,6 +150,33 @@ func TestSetGeneratorFirstPack(t *testing.T) {
 	assert.True(t, generatorFromDB.FirstPack.Unix() > 0)
 }
 
+func TestUpdateGeneratorSettings(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	generator := utils.FakeGenerator(t, core.GenerateRandomID())
+	generator.ID = core.GenerateRandomID()
+	err = db.AddGenerator(generator)
+	assert.Nil(t, err)
+
+	err = db.SetGeneratorLastRun(generator.ID)
+	assert.Nil(t, err)
+
+	generator.WorkflowSpec = "new_workflow"
+	generator.Trigger = 20
+	generator.Timeout = 30
+	generator.Paused = true
+	err = db.UpdateGeneratorSettings(generator)
+	assert.Nil(t, err)
+
+	generatorFromDB, err := db.GetGeneratorByID(generator.ID)
+	assert.Nil(t, err)
+	assert.True(t, generator.Equals(generatorFromDB))
+	assert.Greater(t, generatorFromDB.LastRun.Unix(), int64(0))
+}
+
 func TestFindGeneratorsByColonyID(t *testing.T) {
 	db, err := PrepareTests()
 	assert.Nil(t, err)
@@ -0,0 +1,128 @@
+package postgresql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+// SetIdentityKey sets the current key of a colony or executor. The previous key of the identity, or the original
+// key if the identity has not been rotated before, is replaced and kept in ROTATEDKEYS so that it can be rejected.
+func (db *PQDatabase) SetIdentityKey(identityKey *core.IdentityKey) error {
+	sqlStatement := `WITH ROTATED AS (INSERT INTO ` + db.dbPrefix + `ROTATEDKEYS (KEY_ID, IDENTITY_ID, COLONY_ID, ROTATED) SELECT COALESCE((SELECT KEY_ID FROM ` + db.dbPrefix + `IDENTITYKEYS WHERE IDENTITY_ID=$2), $2), $2, $3, $4 ON CONFLICT (KEY_ID) DO NOTHING) INSERT INTO ` + db.dbPrefix + `IDENTITYKEYS (KEY_ID, IDENTITY_ID, COLONY_ID, ROTATED) VALUES ($1, $2, $3, $4) ON CONFLICT (IDENTITY_ID) DO UPDATE SET KEY_ID=EXCLUDED.KEY_ID, ROTATED=EXCLUDED.ROTATED`
+	_, err := db.postgresql.Exec(sqlStatement, identityKey.KeyID, identityKey.IdentityID, identityKey.ColonyID, identityKey.Rotated)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) parseIdentityKeys(rows *sql.Rows) ([]*core.IdentityKey, error) {
+	var identityKeys []*core.IdentityKey
+
+	for rows.Next() {
+		var keyID string
+		var identityID string
+		var colonyID string
+		var rotated time.Time
+		if err := rows.Scan(&keyID, &identityID, &colonyID, &rotated); err != nil {
+			return nil, err
+		}
+
+		identityKey := &core.IdentityKey{
+			KeyID:      keyID,
+			IdentityID: identityID,
+			ColonyID:   colonyID,
+			Rotated:    rotated}
+
+		identityKeys = append(identityKeys, identityKey)
+	}
+
+	return identityKeys, nil
+}
+
+func (db *PQDatabase) getIdentityKey(sqlStatement string, id string) (*core.IdentityKey, error) {
+	rows, err := db.postgresql.Query(sqlStatement, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	identityKeys, err := db.parseIdentityKeys(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(identityKeys) == 0 {
+		return nil, nil
+	}
+
+	return identityKeys[0], nil
+}
+
+func (db *PQDatabase) GetIdentityKeyByKeyID(keyID string) (*core.IdentityKey, error) {
+	return db.getIdentityKey(`SELECT * FROM `+db.dbPrefix+`IDENTITYKEYS WHERE KEY_ID=$1`, keyID)
+}
+
+func (db *PQDatabase) GetIdentityKeyByIdentityID(identityID string) (*core.IdentityKey, error) {
+	return db.getIdentityKey(`SELECT * FROM `+db.dbPrefix+`IDENTITYKEYS WHERE IDENTITY_ID=$1`, identityID)
+}
+
+// GetIdentityIDByKeyID looks up the current and all rotated out keys in one query. It returns the Id of the identity
+// the key belongs to and if the key has been rotated out, or an empty Id if the key has never been rotated.
+func (db *PQDatabase) GetIdentityIDByKeyID(keyID string) (string, bool, error) {
+	sqlStatement := `SELECT IDENTITY_ID, FALSE FROM ` + db.dbPrefix + `IDENTITYKEYS WHERE KEY_ID=$1 UNION ALL SELECT IDENTITY_ID, TRUE FROM ` + db.dbPrefix + `ROTATEDKEYS WHERE KEY_ID=$1`
+	rows, err := db.postgresql.Query(sqlStatement, keyID)
+	if err != nil {
+		return "", false, err
+	}
+	defer rows.Close()
+
+	identityID := ""
+	rotated := false
+	for rows.Next() {
+		var id string
+		var r bool
+		if err := rows.Scan(&id, &r); err != nil {
+			return "", false, err
+		}
+		identityID = id
+		rotated = rotated || r
+	}
+
+	return identityID, rotated, nil
+}
+
+func (db *PQDatabase) DeleteIdentityKeyByIdentityID(identityID string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `IDENTITYKEYS WHERE IDENTITY_ID=$1`
+	_, err := db.postgresql.Exec(sqlStatement, identityID)
+	if err != nil {
+		return err
+	}
+
+	sqlStatement = `DELETE FROM ` + db.dbPrefix + `ROTATEDKEYS WHERE IDENTITY_ID=$1`
+	_, err = db.postgresql.Exec(sqlStatement, identityID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) DeleteAllIdentityKeysByColonyID(colonyID string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `IDENTITYKEYS WHERE COLONY_ID=$1`
+	_, err := db.postgresql.Exec(sqlStatement, colonyID)
+	if err != nil {
+		return err
+	}
+
+	sqlStatement = `DELETE FROM ` + db.dbPrefix + `ROTATEDKEYS WHERE COLONY_ID=$1`
+	_, err = db.postgresql.Exec(sqlStatement, colonyID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
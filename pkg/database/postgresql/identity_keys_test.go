@@ -0,0 +1,169 @@
+package postgresql
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentityKeysClosedDB(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	db.Close()
+
+	err = db.SetIdentityKey(core.CreateIdentityKey(core.GenerateRandomID(), core.GenerateRandomID(), core.GenerateRandomID()))
+	assert.NotNil(t, err)
+
+	_, err = db.GetIdentityKeyByKeyID("invalid_id")
+	assert.NotNil(t, err)
+
+	_, err = db.GetIdentityKeyByIdentityID("invalid_id")
+	assert.NotNil(t, err)
+
+	_, _, err = db.GetIdentityIDByKeyID("invalid_id")
+	assert.NotNil(t, err)
+
+	err = db.DeleteIdentityKeyByIdentityID("invalid_id")
+	assert.NotNil(t, err)
+
+	err = db.DeleteAllIdentityKeysByColonyID("invalid_id")
+	assert.NotNil(t, err)
+}
+
+func TestSetIdentityKey(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+
+	identityKey1 := core.CreateIdentityKey(core.GenerateRandomID(), colonyID, colonyID)
+	err = db.SetIdentityKey(identityKey1)
+	assert.Nil(t, err)
+
+	identityKeyFromDB, err := db.GetIdentityKeyByKeyID(identityKey1.KeyID)
+	assert.Nil(t, err)
+	assert.True(t, identityKey1.Equals(identityKeyFromDB))
+
+	identityKeyFromDB, err = db.GetIdentityKeyByIdentityID(colonyID)
+	assert.Nil(t, err)
+	assert.True(t, identityKey1.Equals(identityKeyFromDB))
+
+	// Rotating the key again replaces the previous key
+	identityKey2 := core.CreateIdentityKey(core.GenerateRandomID(), colonyID, colonyID)
+	err = db.SetIdentityKey(identityKey2)
+	assert.Nil(t, err)
+
+	identityKeyFromDB, err = db.GetIdentityKeyByKeyID(identityKey1.KeyID)
+	assert.Nil(t, err)
+	assert.Nil(t, identityKeyFromDB)
+
+	identityKeyFromDB, err = db.GetIdentityKeyByIdentityID(colonyID)
+	assert.Nil(t, err)
+	assert.True(t, identityKey2.Equals(identityKeyFromDB))
+}
+
+func TestGetIdentityIDByKeyID(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+
+	identityID, rotated, err := db.GetIdentityIDByKeyID(colonyID)
+	assert.Nil(t, err)
+	assert.Equal(t, "", identityID)
+	assert.False(t, rotated)
+
+	identityKey1 := core.CreateIdentityKey(core.GenerateRandomID(), colonyID, colonyID)
+	err = db.SetIdentityKey(identityKey1)
+	assert.Nil(t, err)
+
+	identityID, rotated, err = db.GetIdentityIDByKeyID(identityKey1.KeyID)
+	assert.Nil(t, err)
+	assert.Equal(t, colonyID, identityID)
+	assert.False(t, rotated)
+
+	// The original key has been rotated out
+	identityID, rotated, err = db.GetIdentityIDByKeyID(colonyID)
+	assert.Nil(t, err)
+	assert.Equal(t, colonyID, identityID)
+	assert.True(t, rotated)
+
+	identityKey2 := core.CreateIdentityKey(core.GenerateRandomID(), colonyID, colonyID)
+	err = db.SetIdentityKey(identityKey2)
+	assert.Nil(t, err)
+
+	// The intermediate key has been rotated out
+	identityID, rotated, err = db.GetIdentityIDByKeyID(identityKey1.KeyID)
+	assert.Nil(t, err)
+	assert.Equal(t, colonyID, identityID)
+	assert.True(t, rotated)
+
+	identityID, rotated, err = db.GetIdentityIDByKeyID(identityKey2.KeyID)
+	assert.Nil(t, err)
+	assert.Equal(t, colonyID, identityID)
+	assert.False(t, rotated)
+
+	err = db.DeleteIdentityKeyByIdentityID(colonyID)
+	assert.Nil(t, err)
+
+	identityID, _, err = db.GetIdentityIDByKeyID(identityKey1.KeyID)
+	assert.Nil(t, err)
+	assert.Equal(t, "", identityID)
+}
+
+func TestDeleteIdentityKeys(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colony := core.CreateColony(core.GenerateRandomID(), "test_colony_name")
+	err = db.AddColony(colony)
+	assert.Nil(t, err)
+
+	executor1 := utils.CreateTestExecutor(colony.ID)
+	err = db.AddExecutor(executor1)
+	assert.Nil(t, err)
+
+	executor2 := utils.CreateTestExecutor(colony.ID)
+	err = db.AddExecutor(executor2)
+	assert.Nil(t, err)
+
+	err = db.SetIdentityKey(core.CreateIdentityKey(core.GenerateRandomID(), colony.ID, colony.ID))
+	assert.Nil(t, err)
+	err = db.SetIdentityKey(core.CreateIdentityKey(core.GenerateRandomID(), executor1.ID, colony.ID))
+	assert.Nil(t, err)
+	err = db.SetIdentityKey(core.CreateIdentityKey(core.GenerateRandomID(), executor2.ID, colony.ID))
+	assert.Nil(t, err)
+
+	// Deleting an executor also deletes its key
+	err = db.DeleteExecutorByID(executor1.ID)
+	assert.Nil(t, err)
+
+	identityKeyFromDB, err := db.GetIdentityKeyByIdentityID(executor1.ID)
+	assert.Nil(t, err)
+	assert.Nil(t, identityKeyFromDB)
+
+	identityKeyFromDB, err = db.GetIdentityKeyByIdentityID(executor2.ID)
+	assert.Nil(t, err)
+	assert.NotNil(t, identityKeyFromDB)
+
+	// Deleting a colony deletes all keys in the colony
+	err = db.DeleteColonyByID(colony.ID)
+	assert.Nil(t, err)
+
+	identityKeyFromDB, err = db.GetIdentityKeyByIdentityID(colony.ID)
+	assert.Nil(t, err)
+	assert.Nil(t, identityKeyFromDB)
+
+	identityKeyFromDB, err = db.GetIdentityKeyByIdentityID(executor2.ID)
+	assert.Nil(t, err)
+	assert.Nil(t, identityKeyFromDB)
+}
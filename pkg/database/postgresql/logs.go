@@ -0,0 +1,109 @@
+package postgresql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+func (db *PQDatabase) AddLog(log *core.Log) error {
+	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `LOGS (LOG_ID, PROCESS_ID, COLONY_ID, EXECUTOR_ID, LOG_OFFSET, MESSAGE, TIME) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	_, err := db.postgresql.Exec(sqlStatement, log.ID, log.ProcessID, log.ColonyID, log.ExecutorID, log.Offset, log.Message, log.Time)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) parseLogs(rows *sql.Rows) ([]*core.Log, error) {
+	var logs []*core.Log
+
+	for rows.Next() {
+		var logID string
+		var processID string
+		var colonyID string
+		var executorID string
+		var offset int64
+		var message string
+		var logTime time.Time
+		if err := rows.Scan(&logID, &processID, &colonyID, &executorID, &offset, &message, &logTime); err != nil {
+			return nil, err
+		}
+
+		log := &core.Log{
+			ID:         logID,
+			ProcessID:  processID,
+			ColonyID:   colonyID,
+			ExecutorID: executorID,
+			Offset:     offset,
+			Message:    message,
+			Time:       logTime}
+
+		logs = append(logs, log)
+	}
+
+	return logs, nil
+}
+
+// GetLogSize returns the number of bytes logged for a process, which is also the offset of the next chunk
+func (db *PQDatabase) GetLogSize(processID string) (int64, error) {
+	sqlStatement := `SELECT COALESCE(MAX(LOG_OFFSET + OCTET_LENGTH(MESSAGE)), 0) FROM ` + db.dbPrefix + `LOGS WHERE PROCESS_ID=$1`
+	rows, err := db.postgresql.Query(sqlStatement, processID)
+	if err != nil {
+		return -1, err
+	}
+	defer rows.Close()
+
+	size := int64(0)
+	for rows.Next() {
+		if err := rows.Scan(&size); err != nil {
+			return -1, err
+		}
+	}
+
+	return size, nil
+}
+
+// FindLogs returns at most count chunks starting at offset, sorted by offset
+func (db *PQDatabase) FindLogs(processID string, offset int64, count int) ([]*core.Log, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `LOGS WHERE PROCESS_ID=$1 AND LOG_OFFSET>=$2 ORDER BY LOG_OFFSET ASC LIMIT $3`
+	rows, err := db.postgresql.Query(sqlStatement, processID, offset, count)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return db.parseLogs(rows)
+}
+
+func (db *PQDatabase) deleteLogsByProcessID(processID string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `LOGS WHERE PROCESS_ID=$1`
+	_, err := db.postgresql.Exec(sqlStatement, processID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) deleteLogsByColonyID(colonyID string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `LOGS WHERE COLONY_ID=$1`
+	_, err := db.postgresql.Exec(sqlStatement, colonyID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) deleteAllLogs() error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `LOGS`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
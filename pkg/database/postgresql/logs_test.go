@@ -0,0 +1,79 @@
+package postgresql
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogsClosedDB(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	db.Close()
+
+	process := utils.CreateTestProcess(core.GenerateRandomID())
+	err = db.AddLog(core.CreateLog(process, core.GenerateRandomID(), 0, "hello"))
+	assert.NotNil(t, err)
+
+	_, err = db.GetLogSize("invalid_id")
+	assert.NotNil(t, err)
+
+	_, err = db.FindLogs("invalid_id", 0, 1)
+	assert.NotNil(t, err)
+}
+
+func TestLogs(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+	executorID := core.GenerateRandomID()
+
+	process := utils.CreateTestProcess(colonyID)
+	err = db.AddProcess(process)
+	assert.Nil(t, err)
+
+	size, err := db.GetLogSize(process.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, size, int64(0))
+
+	for _, message := range []string{"line1\n", "line2\n", "line3\n"} {
+		size, err := db.GetLogSize(process.ID)
+		assert.Nil(t, err)
+		err = db.AddLog(core.CreateLog(process, executorID, size, message))
+		assert.Nil(t, err)
+	}
+
+	size, err = db.GetLogSize(process.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, size, int64(18))
+
+	logs, err := db.FindLogs(process.ID, 0, 100)
+	assert.Nil(t, err)
+	assert.Len(t, logs, 3)
+	assert.Equal(t, logs[0].Message, "line1\n")
+	assert.Equal(t, logs[2].Offset, int64(12))
+	assert.Equal(t, logs[2].ColonyID, colonyID)
+	assert.Equal(t, logs[2].ExecutorID, executorID)
+
+	logs, err = db.FindLogs(process.ID, logs[0].End(), 1)
+	assert.Nil(t, err)
+	assert.Len(t, logs, 1)
+	assert.Equal(t, logs[0].Message, "line2\n")
+
+	logs, err = db.FindLogs(process.ID, size, 100)
+	assert.Nil(t, err)
+	assert.Len(t, logs, 0)
+
+	err = db.DeleteProcessByID(process.ID)
+	assert.Nil(t, err)
+
+	logs, err = db.FindLogs(process.ID, 0, 100)
+	assert.Nil(t, err)
+	assert.Len(t, logs, 0)
+}
@@ -0,0 +1,93 @@
+package postgresql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+// addProcessEvent is called by the functions that change the state of a process, the events are never updated
+func (db *PQDatabase) addProcessEvent(event *core.ProcessEvent) error {
+	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `PROCESSEVENTS (PROCESSEVENT_ID, PROCESS_ID, COLONY_ID, TYPE, STATE, EXECUTOR_ID, MESSAGE, TIME) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err := db.postgresql.Exec(sqlStatement, event.ID, event.ProcessID, event.ColonyID, event.Type, event.State, event.ExecutorID, event.Message, event.Time)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) parseProcessEvents(rows *sql.Rows) ([]*core.ProcessEvent, error) {
+	var events []*core.ProcessEvent
+
+	for rows.Next() {
+		var eventID string
+		var processID string
+		var colonyID string
+		var eventType string
+		var state int
+		var executorID string
+		var message string
+		var eventTime time.Time
+		if err := rows.Scan(&eventID, &processID, &colonyID, &eventType, &state, &executorID, &message, &eventTime); err != nil {
+			return nil, err
+		}
+
+		event := &core.ProcessEvent{
+			ID:         eventID,
+			ProcessID:  processID,
+			ColonyID:   colonyID,
+			Type:       eventType,
+			State:      state,
+			ExecutorID: executorID,
+			Message:    message,
+			Time:       eventTime}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// FindProcessEvents returns the history of a process, oldest event first
+func (db *PQDatabase) FindProcessEvents(processID string) ([]*core.ProcessEvent, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `PROCESSEVENTS WHERE PROCESS_ID=$1 ORDER BY TIME ASC`
+	rows, err := db.postgresql.Query(sqlStatement, processID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return db.parseProcessEvents(rows)
+}
+
+func (db *PQDatabase) deleteProcessEventsByProcessID(processID string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `PROCESSEVENTS WHERE PROCESS_ID=$1`
+	_, err := db.postgresql.Exec(sqlStatement, processID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) deleteProcessEventsByColonyID(colonyID string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `PROCESSEVENTS WHERE COLONY_ID=$1`
+	_, err := db.postgresql.Exec(sqlStatement, colonyID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) deleteAllProcessEvents() error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `PROCESSEVENTS`
+	_, err := db.postgresql.Exec(sqlStatement)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
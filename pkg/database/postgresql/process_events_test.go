@@ -0,0 +1,87 @@
+package postgresql
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessEventsClosedDB(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	db.Close()
+
+	_, err = db.FindProcessEvents("invalid_id")
+	assert.NotNil(t, err)
+}
+
+func TestProcessEvents(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+	executor1ID := core.GenerateRandomID()
+	executor2ID := core.GenerateRandomID()
+
+	process := utils.CreateTestProcess(colonyID)
+	err = db.AddProcess(process)
+	assert.Nil(t, err)
+
+	err = db.Assign(executor1ID, process)
+	assert.Nil(t, err)
+
+	err = db.Unassign(process)
+	assert.Nil(t, err)
+
+	err = db.Assign(executor2ID, process)
+	assert.Nil(t, err)
+
+	err = db.MarkFailed(process.ID, []string{"error1", "error2"})
+	assert.Nil(t, err)
+
+	err = db.ResetProcess(process)
+	assert.Nil(t, err)
+
+	err = db.Assign(executor2ID, process)
+	assert.Nil(t, err)
+
+	_, _, err = db.MarkSuccessful(process.ID)
+	assert.Nil(t, err)
+
+	events, err := db.FindProcessEvents(process.ID)
+	assert.Nil(t, err)
+	assert.Len(t, events, 8)
+
+	eventTypes := []string{core.SUBMITTED_EVENT, core.ASSIGNED_EVENT, core.UNASSIGNED_EVENT, core.ASSIGNED_EVENT, core.FAILED_EVENT, core.RESET_EVENT, core.ASSIGNED_EVENT, core.SUCCESSFUL_EVENT}
+	for i, event := range events {
+		assert.Equal(t, event.Type, eventTypes[i])
+		assert.Equal(t, event.ColonyID, colonyID)
+	}
+
+	// The executor the process was assigned to before it was unassigned is kept
+	assert.Equal(t, events[1].ExecutorID, executor1ID)
+	assert.Equal(t, events[2].ExecutorID, executor1ID)
+	assert.Equal(t, events[3].ExecutorID, executor2ID)
+	assert.Equal(t, events[4].Message, "error1, error2")
+	assert.Equal(t, events[7].State, core.SUCCESS)
+
+	err = db.SetProcessState(process.ID, core.SKIPPED)
+	assert.Nil(t, err)
+	events, err = db.FindProcessEvents(process.ID)
+	assert.Nil(t, err)
+	assert.Len(t, events, 9)
+	assert.Equal(t, events[8].Type, core.STATE_CHANGED_EVENT)
+	assert.Equal(t, events[8].State, core.SKIPPED)
+
+	err = db.DeleteProcessByID(process.ID)
+	assert.Nil(t, err)
+
+	events, err = db.FindProcessEvents(process.ID)
+	assert.Nil(t, err)
+	assert.Len(t, events, 0)
+}
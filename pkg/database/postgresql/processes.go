@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/colonyos/colonies/pkg/core"
@@ -26,7 +27,7 @@ func (db *PQDatabase) AddProcess(process *core.Process) error {
 		deadline = time.Now().Add(time.Duration(maxWaitTime) * time.Second)
 	}
 
-	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `PROCESSES (PROCESS_ID, TARGET_COLONY_ID, TARGET_EXECUTOR_IDS, ASSIGNED_EXECUTOR_ID, STATE, IS_ASSIGNED, EXECUTOR_TYPE, SUBMISSION_TIME, START_TIME, END_TIME, WAIT_DEADLINE, EXEC_DEADLINE, ERRORS, RETRIES, NODENAME, FUNCNAME, ARGS, MAX_WAIT_TIME, MAX_EXEC_TIME, MAX_RETRIES, DEPENDENCIES, PRIORITY, PRIORITYTIME, WAIT_FOR_PARENTS, PARENTS, CHILDREN, PROCESSGRAPH_ID, INPUT, OUTPUT, LABEL) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30)`
+	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `PROCESSES (PROCESS_ID, TARGET_COLONY_ID, TARGET_EXECUTOR_IDS, ASSIGNED_EXECUTOR_ID, STATE, IS_ASSIGNED, EXECUTOR_TYPE, SUBMISSION_TIME, START_TIME, END_TIME, WAIT_DEADLINE, EXEC_DEADLINE, ERRORS, RETRIES, NODENAME, FUNCNAME, ARGS, MAX_WAIT_TIME, MAX_EXEC_TIME, MAX_RETRIES, DEPENDENCIES, PRIORITY, PRIORITYTIME, WAIT_FOR_PARENTS, PARENTS, CHILDREN, PROCESSGRAPH_ID, INPUT, OUTPUT, LABEL, CONDITION, MAP, MAX_PARALLEL, WORKFLOW, INPUTS, NAMED_INPUT, NAMED_OUTPUT, ALLOW_FAILURE) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36, $37, $38)`
 
 	// TODO: Change the database so that argsm input and output are only text
 	argsJSON, err := json.Marshal(process.FunctionSpec.Args)
@@ -47,9 +48,32 @@ func (db *PQDatabase) AddProcess(process *core.Process) error {
 	}
 	outJSONArrStr := []string{string(outJSON)}
 
+	workflowJSON := ""
+	if process.FunctionSpec.Workflow != nil {
+		workflowJSON, err = process.FunctionSpec.Workflow.ToJSON()
+		if err != nil {
+			return err
+		}
+	}
+
+	inputsJSON, err := json.Marshal(process.FunctionSpec.Inputs)
+	if err != nil {
+		return err
+	}
+
+	namedInJSON, err := json.Marshal(process.NamedInput)
+	if err != nil {
+		return err
+	}
+
+	namedOutJSON, err := json.Marshal(process.NamedOutput)
+	if err != nil {
+		return err
+	}
+
 	process.SetSubmissionTime(submissionTime)
 
-	_, err = db.postgresql.Exec(sqlStatement, process.ID, process.FunctionSpec.Conditions.ColonyID, pq.Array(targetExecutorIDs), process.AssignedExecutorID, process.State, process.IsAssigned, process.FunctionSpec.Conditions.ExecutorType, submissionTime, time.Time{}, time.Time{}, deadline, process.ExecDeadline, pq.Array(process.Errors), 0, process.FunctionSpec.NodeName, process.FunctionSpec.FuncName, pq.Array(argsJSONArrStr), process.FunctionSpec.MaxWaitTime, process.FunctionSpec.MaxExecTime, process.FunctionSpec.MaxRetries, pq.Array(process.FunctionSpec.Conditions.Dependencies), process.FunctionSpec.Priority, process.PriorityTime, process.WaitForParents, pq.Array(process.Parents), pq.Array(process.Children), process.ProcessGraphID, pq.Array(inJSONArrStr), pq.Array(outJSONArrStr), process.FunctionSpec.Label)
+	_, err = db.postgresql.Exec(sqlStatement, process.ID, process.FunctionSpec.Conditions.ColonyID, pq.Array(targetExecutorIDs), process.AssignedExecutorID, process.State, process.IsAssigned, process.FunctionSpec.Conditions.ExecutorType, submissionTime, time.Time{}, time.Time{}, deadline, process.ExecDeadline, pq.Array(process.Errors), 0, process.FunctionSpec.NodeName, process.FunctionSpec.FuncName, pq.Array(argsJSONArrStr), process.FunctionSpec.MaxWaitTime, process.FunctionSpec.MaxExecTime, process.FunctionSpec.MaxRetries, pq.Array(process.FunctionSpec.Conditions.Dependencies), process.FunctionSpec.Priority, process.PriorityTime, process.WaitForParents, pq.Array(process.Parents), pq.Array(process.Children), process.ProcessGraphID, pq.Array(inJSONArrStr), pq.Array(outJSONArrStr), process.FunctionSpec.Label, process.FunctionSpec.Condition, process.FunctionSpec.Map, process.FunctionSpec.MaxParallel, workflowJSON, string(inputsJSON), string(namedInJSON), string(namedOutJSON), process.FunctionSpec.AllowFailure)
 	if err != nil {
 		return err
 	}
@@ -64,7 +88,7 @@ func (db *PQDatabase) AddProcess(process *core.Process) error {
 		return err
 	}
 
-	return nil
+	return db.addProcessEvent(core.CreateProcessEvent(process, core.SUBMITTED_EVENT, process.State, "", ""))
 }
 
 func (db *PQDatabase) parseProcesses(rows *sql.Rows) ([]*core.Process, error) {
@@ -101,8 +125,16 @@ func (db *PQDatabase) parseProcesses(rows *sql.Rows) ([]*core.Process, error) {
 		var inputJSONStrArr []string
 		var outputJSONStrArr []string
 		var label string
-
-		if err := rows.Scan(&processID, &targetColonyID, pq.Array(&targetExecutorIDs), &assignedExecutorID, &state, &isAssigned, &executorType, &submissionTime, &startTime, &endTime, &waitDeadline, &execDeadline, pq.Array(&errs), &nodeName, &funcName, pq.Array(&argsJSONStrArr), &maxWaitTime, &maxExecTime, &retries, &maxRetries, pq.Array(&dependencies), &priority, &priorityTime, &waitForParent, pq.Array(&parents), pq.Array(&children), &processGraphID, pq.Array(&inputJSONStrArr), pq.Array(&outputJSONStrArr), &label); err != nil {
+		var condition string
+		var isMap bool
+		var maxParallel int
+		var workflowJSON string
+		var inputsJSON string
+		var namedInputJSON string
+		var namedOutputJSON string
+		var allowFailure bool
+
+		if err := rows.Scan(&processID, &targetColonyID, pq.Array(&targetExecutorIDs), &assignedExecutorID, &state, &isAssigned, &executorType, &submissionTime, &startTime, &endTime, &waitDeadline, &execDeadline, pq.Array(&errs), &nodeName, &funcName, pq.Array(&argsJSONStrArr), &maxWaitTime, &maxExecTime, &retries, &maxRetries, pq.Array(&dependencies), &priority, &priorityTime, &waitForParent, pq.Array(&parents), pq.Array(&children), &processGraphID, pq.Array(&inputJSONStrArr), pq.Array(&outputJSONStrArr), &label, &condition, &isMap, &maxParallel, &workflowJSON, &inputsJSON, &namedInputJSON, &namedOutputJSON, &allowFailure); err != nil {
 			return nil, err
 		}
 
@@ -136,24 +168,55 @@ func (db *PQDatabase) parseProcesses(rows *sql.Rows) ([]*core.Process, error) {
 
 		var argsif []interface{}
 		if len(argsJSONStrArr) == 1 {
-			json.Unmarshal([]byte(argsJSONStrArr[0]), &argsif)
+			if err := json.Unmarshal([]byte(argsJSONStrArr[0]), &argsif); err != nil {
+				return nil, err
+			}
 		}
 
 		var inputif []interface{}
 		if len(inputJSONStrArr) == 1 {
-			json.Unmarshal([]byte(inputJSONStrArr[0]), &inputif)
+			if err := json.Unmarshal([]byte(inputJSONStrArr[0]), &inputif); err != nil {
+				return nil, err
+			}
 		}
 
 		var outputif []interface{}
 		if len(outputJSONStrArr) == 1 {
-			json.Unmarshal([]byte(outputJSONStrArr[0]), &outputif)
+			if err := json.Unmarshal([]byte(outputJSONStrArr[0]), &outputif); err != nil {
+				return nil, err
+			}
 		}
 
 		functionSpec := core.CreateFunctionSpec(nodeName, funcName, argsif, targetColonyID, targetExecutorIDs, executorType, maxWaitTime, maxExecTime, maxRetries, env, dependencies, priority, label)
+		functionSpec.Condition = condition
+		functionSpec.Map = isMap
+		functionSpec.MaxParallel = maxParallel
+		functionSpec.AllowFailure = allowFailure
+		if workflowJSON != "" {
+			functionSpec.Workflow, err = core.ConvertJSONToWorkflowSpec(workflowJSON)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if inputsJSON != "" {
+			if err := json.Unmarshal([]byte(inputsJSON), &functionSpec.Inputs); err != nil {
+				return nil, err
+			}
+		}
 		process := core.CreateProcessFromDB(functionSpec, processID, assignedExecutorID, isAssigned, state, priorityTime, submissionTime, startTime, endTime, waitDeadline, execDeadline, errs, retries, attributes)
 
 		process.Input = inputif
 		process.Output = outputif
+		if namedInputJSON != "" {
+			if err := json.Unmarshal([]byte(namedInputJSON), &process.NamedInput); err != nil {
+				return nil, err
+			}
+		}
+		if namedOutputJSON != "" {
+			if err := json.Unmarshal([]byte(namedOutputJSON), &process.NamedOutput); err != nil {
+				return nil, err
+			}
+		}
 		processes = append(processes, process)
 
 		process.WaitForParents = waitForParent
@@ -437,7 +500,12 @@ func (db *PQDatabase) DeleteProcessByID(processID string) error {
 		return err
 	}
 
-	return nil
+	err = db.deleteProcessEventsByProcessID(processID)
+	if err != nil {
+		return err
+	}
+
+	return db.deleteLogsByProcessID(processID)
 }
 
 func (db *PQDatabase) DeleteAllProcesses() error {
@@ -452,7 +520,12 @@ func (db *PQDatabase) DeleteAllProcesses() error {
 		return err
 	}
 
-	return nil
+	err = db.deleteAllProcessEvents()
+	if err != nil {
+		return err
+	}
+
+	return db.deleteAllLogs()
 }
 
 func (db *PQDatabase) DeleteAllWaitingProcessesByColonyID(colonyID string) error {
@@ -600,7 +673,7 @@ func (db *PQDatabase) ResetProcess(process *core.Process) error {
 	process.SetAssignedExecutorID("")
 	process.SetState(core.WAITING)
 
-	return nil
+	return db.addProcessEvent(core.CreateProcessEvent(process, core.RESET_EVENT, core.WAITING, "", ""))
 }
 
 func (db *PQDatabase) SetWaitForParents(processID string, waitForParent bool) error {
@@ -645,7 +718,15 @@ func (db *PQDatabase) SetProcessState(processID string, state int) error {
 		return err
 	}
 
-	return nil
+	process, err := db.GetProcessByID(processID)
+	if err != nil {
+		return err
+	}
+	if process == nil {
+		return nil
+	}
+
+	return db.addProcessEvent(core.CreateProcessEvent(process, core.STATE_CHANGED_EVENT, state, "", ""))
 }
 
 func (db *PQDatabase) SetInput(processID string, input []interface{}) error {
@@ -680,6 +761,36 @@ func (db *PQDatabase) SetOutput(processID string, output []interface{}) error {
 	return nil
 }
 
+func (db *PQDatabase) SetNamedInput(processID string, namedInput map[string]interface{}) error {
+	namedInJSON, err := json.Marshal(namedInput)
+	if err != nil {
+		return err
+	}
+
+	sqlStatement := `UPDATE ` + db.dbPrefix + `PROCESSES SET NAMED_INPUT=$1 WHERE PROCESS_ID=$2`
+	_, err = db.postgresql.Exec(sqlStatement, string(namedInJSON), processID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) SetNamedOutput(processID string, namedOutput map[string]interface{}) error {
+	namedOutJSON, err := json.Marshal(namedOutput)
+	if err != nil {
+		return err
+	}
+
+	sqlStatement := `UPDATE ` + db.dbPrefix + `PROCESSES SET NAMED_OUTPUT=$1 WHERE PROCESS_ID=$2`
+	_, err = db.postgresql.Exec(sqlStatement, string(namedOutJSON), processID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (db *PQDatabase) SetErrors(processID string, errs []string) error {
 	sqlStatement := `UPDATE ` + db.dbPrefix + `PROCESSES SET ERRORS=$1 WHERE PROCESS_ID=$2`
 	_, err := db.postgresql.Exec(sqlStatement, pq.Array(errs), processID)
@@ -750,7 +861,7 @@ func (db *PQDatabase) Assign(executorID string, process *core.Process) error {
 	process.SetAssignedExecutorID(executorID)
 	process.SetState(core.RUNNING)
 
-	return nil
+	return db.addProcessEvent(core.CreateProcessEvent(process, core.ASSIGNED_EVENT, core.RUNNING, executorID, ""))
 }
 
 func (db *PQDatabase) Unassign(process *core.Process) error {
@@ -778,6 +889,11 @@ func (db *PQDatabase) Unassign(process *core.Process) error {
 		return err
 	}
 
+	err = db.addProcessEvent(core.CreateProcessEvent(process, core.UNASSIGNED_EVENT, core.WAITING, process.AssignedExecutorID, "Retry "+strconv.Itoa(process.Retries+1)))
+	if err != nil {
+		return err
+	}
+
 	process.SetEndTime(endTime)
 	process.Unassign()
 	process.SetState(core.WAITING)
@@ -815,6 +931,11 @@ func (db *PQDatabase) MarkSuccessful(processID string) (float64, float64, error)
 	process.SetEndTime(endTime)
 	process.SetState(core.SUCCESS)
 
+	err = db.addProcessEvent(core.CreateProcessEvent(process, core.SUCCESSFUL_EVENT, core.SUCCESS, process.AssignedExecutorID, ""))
+	if err != nil {
+		return 0.0, 0.0, err
+	}
+
 	return process.WaitingTime().Seconds(), process.ProcessingTime().Seconds(), nil
 }
 
@@ -855,6 +976,11 @@ func (db *PQDatabase) MarkFailed(processID string, errs []string) error {
 	process.SetEndTime(endTime)
 	process.SetState(core.FAILED)
 
+	err = db.addProcessEvent(core.CreateProcessEvent(process, core.FAILED_EVENT, core.FAILED, process.AssignedExecutorID, strings.Join(errs, ", ")))
+	if err != nil {
+		return err
+	}
+
 	return db.SetErrors(process.ID, errs)
 }
 
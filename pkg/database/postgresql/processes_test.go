@@ -189,6 +189,76 @@ func TestAddProcess(t *testing.T) {
 	assert.Contains(t, processFromDB.FunctionSpec.Conditions.ExecutorIDs, executor2ID)
 }
 
+func TestGetProcessCorruptJSON(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	process := utils.CreateTestProcess(core.GenerateRandomID())
+	err = db.AddProcess(process)
+	assert.Nil(t, err)
+
+	_, err = db.postgresql.Exec(`UPDATE `+db.dbPrefix+`PROCESSES SET NAMED_INPUT=$1 WHERE PROCESS_ID=$2`, "{invalid", process.ID)
+	assert.Nil(t, err)
+
+	_, err = db.GetProcessByID(process.ID)
+	assert.NotNil(t, err)
+}
+
+func TestAddProcessWithCondition(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	process := utils.CreateTestProcess(core.GenerateRandomID())
+	process.FunctionSpec.Condition = "train.output[0] > 0.5"
+	err = db.AddProcess(process)
+	assert.Nil(t, err)
+
+	processFromDB, err := db.GetProcessByID(process.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, processFromDB.FunctionSpec.Condition, "train.output[0] > 0.5")
+}
+
+func TestAddMapProcess(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	process := utils.CreateTestProcess(core.GenerateRandomID())
+	process.FunctionSpec.Map = true
+	process.FunctionSpec.MaxParallel = 5
+	err = db.AddProcess(process)
+	assert.Nil(t, err)
+
+	processFromDB, err := db.GetProcessByID(process.ID)
+	assert.Nil(t, err)
+	assert.True(t, processFromDB.FunctionSpec.Map)
+	assert.Equal(t, processFromDB.FunctionSpec.MaxParallel, 5)
+}
+
+func TestAddProcessWithWorkflow(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+	process := utils.CreateTestProcess(colonyID)
+	process.FunctionSpec.Workflow = core.CreateWorkflowSpec(colonyID)
+	process.FunctionSpec.Workflow.AddFunctionSpec(&utils.CreateTestProcess(colonyID).FunctionSpec)
+	err = db.AddProcess(process)
+	assert.Nil(t, err)
+
+	processFromDB, err := db.GetProcessByID(process.ID)
+	assert.Nil(t, err)
+	assert.NotNil(t, processFromDB.FunctionSpec.Workflow)
+	assert.True(t, process.FunctionSpec.Workflow.Equals(processFromDB.FunctionSpec.Workflow))
+}
+
 func TestSelectCandiate(t *testing.T) {
 	db, err := PrepareTests()
 	assert.Nil(t, err)
@@ -1057,6 +1127,32 @@ func TestSetOutput(t *testing.T) {
 	assert.Equal(t, processFromDB.Output[1], "result2")
 }
 
+func TestSetNamedInputOutput(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colony := core.CreateColony(core.GenerateRandomID(), "test_colony_name")
+	process := utils.CreateTestProcess(colony.ID)
+	process.FunctionSpec.Inputs = map[string]string{"model": "train.out.model"}
+	process.FunctionSpec.AllowFailure = true
+	err = db.AddProcess(process)
+	assert.Nil(t, err)
+
+	err = db.SetNamedInput(process.ID, map[string]interface{}{"model": "model.bin"})
+	assert.Nil(t, err)
+	err = db.SetNamedOutput(process.ID, map[string]interface{}{"accuracy": 0.9})
+	assert.Nil(t, err)
+
+	processFromDB, err := db.GetProcessByID(process.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, processFromDB.FunctionSpec.Inputs["model"], "train.out.model")
+	assert.True(t, processFromDB.FunctionSpec.AllowFailure)
+	assert.Equal(t, processFromDB.NamedInput["model"], "model.bin")
+	assert.Equal(t, processFromDB.NamedOutput["accuracy"], 0.9)
+}
+
 func TestSetExecDeadline(t *testing.T) {
 	db, err := PrepareTests()
 	assert.Nil(t, err)
@@ -9,8 +9,8 @@ import (
 )
 
 func (db *PQDatabase) AddProcessGraph(processGraph *core.ProcessGraph) error {
-	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `PROCESSGRAPHS (PROCESSGRAPH_ID, TARGET_COLONY_ID, ROOTS, STATE, SUBMISSION_TIME, START_TIME, END_TIME) VALUES ($1, $2, $3, $4, $5, $6, $7)`
-	_, err := db.postgresql.Exec(sqlStatement, processGraph.ID, processGraph.ColonyID, pq.Array(processGraph.Roots), processGraph.State, time.Now(), time.Time{}, time.Time{})
+	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `PROCESSGRAPHS (PROCESSGRAPH_ID, TARGET_COLONY_ID, ROOTS, STATE, SUBMISSION_TIME, START_TIME, END_TIME, PARENT_PROCESS_ID, FAILURE_POLICY, DEADLINE) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	_, err := db.postgresql.Exec(sqlStatement, processGraph.ID, processGraph.ColonyID, pq.Array(processGraph.Roots), processGraph.State, time.Now(), time.Time{}, time.Time{}, processGraph.ParentProcessID, processGraph.FailurePolicy, processGraph.Deadline)
 	if err != nil {
 		return err
 	}
@@ -29,7 +29,10 @@ func (db *PQDatabase) parseProcessGraphs(rows *sql.Rows) ([]*core.ProcessGraph,
 		var submissionTime time.Time
 		var startTime time.Time
 		var endTime time.Time
-		if err := rows.Scan(&processGraphID, &colonyID, pq.Array(&roots), &state, &submissionTime, &startTime, &endTime); err != nil {
+		var parentProcessID string
+		var failurePolicy string
+		var deadline time.Time
+		if err := rows.Scan(&processGraphID, &colonyID, pq.Array(&roots), &state, &submissionTime, &startTime, &endTime, &parentProcessID, &failurePolicy, &deadline); err != nil {
 			return nil, err
 		}
 
@@ -40,6 +43,9 @@ func (db *PQDatabase) parseProcessGraphs(rows *sql.Rows) ([]*core.ProcessGraph,
 		graph.SubmissionTime = submissionTime
 		graph.StartTime = startTime
 		graph.EndTime = endTime
+		graph.ParentProcessID = parentProcessID
+		graph.FailurePolicy = failurePolicy
+		graph.Deadline = deadline
 		if err != nil {
 			return graphs, err
 		}
@@ -75,6 +81,20 @@ func (db *PQDatabase) GetProcessGraphByID(processGraphID string) (*core.ProcessG
 	return processGraphs[0], nil
 }
 
+// FindProcessGraphsByParentProcessID returns the processgraphs of the sub-workflow run by the process, newest
+// first. A new processgraph is started every time the process is retried
+func (db *PQDatabase) FindProcessGraphsByParentProcessID(parentProcessID string) ([]*core.ProcessGraph, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `PROCESSGRAPHS WHERE PARENT_PROCESS_ID=$1 ORDER BY SUBMISSION_TIME DESC`
+	rows, err := db.postgresql.Query(sqlStatement, parentProcessID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	return db.parseProcessGraphs(rows)
+}
+
 func (db *PQDatabase) SetProcessGraphState(processGraphID string, state int) error {
 	graph, err := db.GetProcessGraphByID(processGraphID)
 	if err != nil {
@@ -102,6 +122,20 @@ func (db *PQDatabase) SetProcessGraphState(processGraphID string, state int) err
 
 	}
 
+	// Keep the run history of crons and generators in sync with the processgraph
+	return db.SetRunRecordStateByProcessGraphID(processGraphID, state)
+}
+
+// ResetProcessGraph clears the end time of a processgraph that is about to be retried and sets its deadline
+func (db *PQDatabase) ResetProcessGraph(processGraph *core.ProcessGraph) error {
+	sqlStatement := `UPDATE ` + db.dbPrefix + `PROCESSGRAPHS SET END_TIME=$1, DEADLINE=$2 WHERE PROCESSGRAPH_ID=$3`
+	_, err := db.postgresql.Exec(sqlStatement, time.Time{}, processGraph.Deadline, processGraph.ID)
+	if err != nil {
+		return err
+	}
+
+	processGraph.EndTime = time.Time{}
+
 	return nil
 }
 
@@ -121,6 +155,22 @@ func (db *PQDatabase) findProcessGraphsByState(colonyID string, state int, count
 	return matches, nil
 }
 
+func (db *PQDatabase) FindProcessGraphsWithExpiredDeadline() ([]*core.ProcessGraph, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `PROCESSGRAPHS WHERE (STATE=$1 OR STATE=$2) AND DEADLINE>$3 AND DEADLINE<$4`
+	rows, err := db.postgresql.Query(sqlStatement, core.WAITING, core.RUNNING, time.Time{}, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	matches, err := db.parseProcessGraphs(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
 func (db *PQDatabase) FindWaitingProcessGraphs(colonyID string, count int) ([]*core.ProcessGraph, error) {
 	return db.findProcessGraphsByState(colonyID, core.WAITING, count)
 }
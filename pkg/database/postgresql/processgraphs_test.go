@@ -2,6 +2,7 @@ package postgresql
 
 import (
 	"testing"
+	"time"
 
 	"github.com/colonyos/colonies/pkg/core"
 	"github.com/colonyos/colonies/pkg/utils"
@@ -74,6 +75,9 @@ func TestProcessGraphClosedDB(t *testing.T) {
 	_, err = db.GetProcessGraphByID("invalid_id")
 	assert.NotNil(t, err)
 
+	_, err = db.FindProcessGraphsByParentProcessID("invalid_id")
+	assert.NotNil(t, err)
+
 	err = db.SetProcessGraphState("invalid_id", 1)
 	assert.NotNil(t, err)
 
@@ -89,6 +93,12 @@ func TestProcessGraphClosedDB(t *testing.T) {
 	_, err = db.FindFailedProcessGraphs("invalid_id", 1)
 	assert.NotNil(t, err)
 
+	_, err = db.FindProcessGraphsWithExpiredDeadline()
+	assert.NotNil(t, err)
+
+	err = db.ResetProcessGraph(graph)
+	assert.NotNil(t, err)
+
 	err = db.DeleteProcessGraphByID("invalid_id")
 	assert.NotNil(t, err)
 
@@ -149,6 +159,110 @@ func TestAddProcessGraph(t *testing.T) {
 	assert.True(t, graph.Equals(graphFromDB))
 }
 
+func TestAddSubProcessGraph(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+
+	graph := generateProcessGraph(t, db, colonyID)
+	graph.ParentProcessID = core.GenerateRandomID()
+	err = db.AddProcessGraph(graph)
+	assert.Nil(t, err)
+
+	graphFromDB, err := db.GetProcessGraphByID(graph.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, graphFromDB.ParentProcessID, graph.ParentProcessID)
+
+	// A retried sub-workflow gets a new processgraph with the same parent
+	retriedGraph := generateProcessGraph(t, db, colonyID)
+	retriedGraph.ParentProcessID = graph.ParentProcessID
+	err = db.AddProcessGraph(retriedGraph)
+	assert.Nil(t, err)
+
+	graphsFromDB, err := db.FindProcessGraphsByParentProcessID(graph.ParentProcessID)
+	assert.Nil(t, err)
+	assert.Len(t, graphsFromDB, 2)
+	assert.Equal(t, graphsFromDB[0].ID, retriedGraph.ID)
+	assert.Equal(t, graphsFromDB[1].ID, graph.ID)
+
+	graphsFromDB, err = db.FindProcessGraphsByParentProcessID(core.GenerateRandomID())
+	assert.Nil(t, err)
+	assert.Len(t, graphsFromDB, 0)
+}
+
+func TestFindProcessGraphsWithExpiredDeadline(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+
+	graph1 := generateProcessGraph(t, db, colonyID)
+	graph1.FailurePolicy = core.CONTINUE
+	graph1.Deadline = time.Now().Add(-time.Second)
+	err = db.AddProcessGraph(graph1)
+	assert.Nil(t, err)
+
+	graph2 := generateProcessGraph(t, db, colonyID)
+	graph2.Deadline = time.Now().Add(time.Hour)
+	err = db.AddProcessGraph(graph2)
+	assert.Nil(t, err)
+
+	graph3 := generateProcessGraph(t, db, colonyID)
+	err = db.AddProcessGraph(graph3)
+	assert.Nil(t, err)
+
+	graphFromDB, err := db.GetProcessGraphByID(graph1.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, graphFromDB.FailurePolicy, core.CONTINUE)
+	assert.Equal(t, graphFromDB.Deadline.Unix(), graph1.Deadline.Unix())
+
+	graphs, err := db.FindProcessGraphsWithExpiredDeadline()
+	assert.Nil(t, err)
+	assert.Len(t, graphs, 1)
+	assert.Equal(t, graphs[0].ID, graph1.ID)
+
+	err = db.SetProcessGraphState(graph1.ID, core.FAILED)
+	assert.Nil(t, err)
+
+	graphs, err = db.FindProcessGraphsWithExpiredDeadline()
+	assert.Nil(t, err)
+	assert.Len(t, graphs, 0)
+}
+
+func TestResetProcessGraph(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+
+	graph := generateProcessGraph(t, db, colonyID)
+	err = db.AddProcessGraph(graph)
+	assert.Nil(t, err)
+
+	err = db.SetProcessGraphState(graph.ID, core.FAILED)
+	assert.Nil(t, err)
+
+	graphFromDB, err := db.GetProcessGraphByID(graph.ID)
+	assert.Nil(t, err)
+	assert.False(t, graphFromDB.EndTime.IsZero())
+
+	graphFromDB.Deadline = time.Now().Add(time.Hour)
+	err = db.ResetProcessGraph(graphFromDB)
+	assert.Nil(t, err)
+
+	graphFromDB2, err := db.GetProcessGraphByID(graph.ID)
+	assert.Nil(t, err)
+	assert.True(t, graphFromDB2.EndTime.IsZero())
+	assert.Equal(t, graphFromDB2.Deadline.Unix(), graphFromDB.Deadline.Unix())
+}
+
 func TestDeleteProcessGraphByID(t *testing.T) {
 	db, err := PrepareTests()
 	assert.Nil(t, err)
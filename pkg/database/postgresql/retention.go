@@ -36,5 +36,38 @@ func (db *PQDatabase) ApplyRetentionPolicy(retentionPeriod int64) error {
 		return err
 	}
 
+	// Run records are deleted in all states, skipped and failed runs would otherwise never be deleted
+	sqlStatement = `DELETE FROM ` + db.dbPrefix + `RUNHISTORY WHERE TRIGGERED_TIME<$1`
+	_, err = db.postgresql.Exec(sqlStatement, timestamp)
+	if err != nil {
+		return err
+	}
+
+	sqlStatement = `DELETE FROM ` + db.dbPrefix + `AUDITLOG WHERE TIME<$1`
+	_, err = db.postgresql.Exec(sqlStatement, timestamp)
+	if err != nil {
+		return err
+	}
+
+	sqlStatement = `DELETE FROM ` + db.dbPrefix + `WEBHOOKDELIVERIES WHERE TIME<$1`
+	_, err = db.postgresql.Exec(sqlStatement, timestamp)
+	if err != nil {
+		return err
+	}
+
+	// Events are kept as long as the process exists
+	sqlStatement = `DELETE FROM ` + db.dbPrefix + `PROCESSEVENTS WHERE TIME<$1 AND PROCESS_ID NOT IN (SELECT PROCESS_ID FROM ` + db.dbPrefix + `PROCESSES)`
+	_, err = db.postgresql.Exec(sqlStatement, timestamp)
+	if err != nil {
+		return err
+	}
+
+	// Logs are kept as long as the process exists
+	sqlStatement = `DELETE FROM ` + db.dbPrefix + `LOGS WHERE TIME<$1 AND PROCESS_ID NOT IN (SELECT PROCESS_ID FROM ` + db.dbPrefix + `PROCESSES)`
+	_, err = db.postgresql.Exec(sqlStatement, timestamp)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -56,6 +56,16 @@ func TestApplyRetentionPolicy(t *testing.T) {
 	err = db.SetProcessGraphState(graph.ID, core.SUCCESS)
 	assert.Nil(t, err)
 
+	triggerID := core.GenerateRandomID()
+	runRecord := core.CreateRunRecord(colonyID, triggerID, core.CRON_TRIGGER, time.Now(), graph.ID)
+	runRecord.State = core.SUCCESS
+	err = db.AddRunRecord(runRecord)
+	assert.Nil(t, err)
+	err = db.AddRunRecord(core.CreateFailedRunRecord(colonyID, triggerID, core.CRON_TRIGGER, time.Now(), "error"))
+	assert.Nil(t, err)
+	err = db.AddRunRecord(core.CreateSkippedRunRecord(colonyID, triggerID, core.CRON_TRIGGER, time.Now(), "skipped"))
+	assert.Nil(t, err)
+
 	count, err := db.CountSuccessfulProcessGraphs()
 	assert.Nil(t, err)
 	assert.Equal(t, count, 1)
@@ -74,6 +84,10 @@ func TestApplyRetentionPolicy(t *testing.T) {
 	_, err = db.GetAttributeByID(attribute.ID)
 	assert.Nil(t, err)
 
+	runHistory, err := db.FindRunHistory(triggerID, 100)
+	assert.Nil(t, err)
+	assert.Len(t, runHistory, 3)
+
 	time.Sleep(2 * time.Second)
 
 	err = db.ApplyRetentionPolicy(1)
@@ -90,5 +104,10 @@ func TestApplyRetentionPolicy(t *testing.T) {
 	_, err = db.GetAttributeByID(attribute.ID)
 	assert.NotNil(t, err)
 
+	// Run records are deleted regardless of their state
+	runHistory, err = db.FindRunHistory(triggerID, 100)
+	assert.Nil(t, err)
+	assert.Len(t, runHistory, 0)
+
 	defer db.Close()
 }
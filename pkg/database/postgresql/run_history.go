@@ -0,0 +1,96 @@
+package postgresql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+func (db *PQDatabase) AddRunRecord(runRecord *core.RunRecord) error {
+	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `RUNHISTORY (RUNRECORD_ID, COLONY_ID, TRIGGER_ID, TRIGGER_TYPE, SCHEDULED_TIME, TRIGGERED_TIME, PROCESSGRAPH_ID, STATE, SKIPPED, REASON) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	_, err := db.postgresql.Exec(sqlStatement, runRecord.ID, runRecord.ColonyID, runRecord.TriggerID, runRecord.TriggerType, runRecord.ScheduledTime, runRecord.TriggeredTime, runRecord.ProcessGraphID, runRecord.State, runRecord.Skipped, runRecord.Reason)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) parseRunRecords(rows *sql.Rows) ([]*core.RunRecord, error) {
+	var runRecords []*core.RunRecord
+
+	for rows.Next() {
+		var runRecordID string
+		var colonyID string
+		var triggerID string
+		var triggerType string
+		var scheduledTime time.Time
+		var triggeredTime time.Time
+		var processGraphID string
+		var state int
+		var skipped bool
+		var reason string
+		if err := rows.Scan(&runRecordID, &colonyID, &triggerID, &triggerType, &scheduledTime, &triggeredTime, &processGraphID, &state, &skipped, &reason); err != nil {
+			return nil, err
+		}
+
+		runRecord := &core.RunRecord{
+			ID:             runRecordID,
+			ColonyID:       colonyID,
+			TriggerID:      triggerID,
+			TriggerType:    triggerType,
+			ScheduledTime:  scheduledTime,
+			TriggeredTime:  triggeredTime,
+			ProcessGraphID: processGraphID,
+			State:          state,
+			Skipped:        skipped,
+			Reason:         reason}
+
+		runRecords = append(runRecords, runRecord)
+	}
+
+	return runRecords, nil
+}
+
+// FindRunHistory returns the latest run records of a cron or a generator, newest first
+func (db *PQDatabase) FindRunHistory(triggerID string, count int) ([]*core.RunRecord, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `RUNHISTORY WHERE TRIGGER_ID=$1 ORDER BY TRIGGERED_TIME DESC LIMIT $2`
+	rows, err := db.postgresql.Query(sqlStatement, triggerID, count)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return db.parseRunRecords(rows)
+}
+
+func (db *PQDatabase) SetRunRecordStateByProcessGraphID(processGraphID string, state int) error {
+	sqlStatement := `UPDATE ` + db.dbPrefix + `RUNHISTORY SET STATE=$1 WHERE PROCESSGRAPH_ID=$2`
+	_, err := db.postgresql.Exec(sqlStatement, state, processGraphID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) DeleteRunHistoryByTriggerID(triggerID string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `RUNHISTORY WHERE TRIGGER_ID=$1`
+	_, err := db.postgresql.Exec(sqlStatement, triggerID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) deleteRunHistoryByColonyID(colonyID string, triggerType string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `RUNHISTORY WHERE COLONY_ID=$1 AND TRIGGER_TYPE=$2`
+	_, err := db.postgresql.Exec(sqlStatement, colonyID, triggerType)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
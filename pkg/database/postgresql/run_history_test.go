@@ -0,0 +1,128 @@
+package postgresql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunHistoryClosedDB(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	db.Close()
+
+	runRecord := core.CreateRunRecord(core.GenerateRandomID(), core.GenerateRandomID(), core.CRON_TRIGGER, time.Now(), core.GenerateRandomID())
+	err = db.AddRunRecord(runRecord)
+	assert.NotNil(t, err)
+
+	_, err = db.FindRunHistory("invalid_id", 1)
+	assert.NotNil(t, err)
+
+	err = db.SetRunRecordStateByProcessGraphID("invalid_id", core.SUCCESS)
+	assert.NotNil(t, err)
+
+	err = db.DeleteRunHistoryByTriggerID("invalid_id")
+	assert.NotNil(t, err)
+}
+
+func TestAddRunRecord(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+	cronID := core.GenerateRandomID()
+
+	runRecord1 := core.CreateRunRecord(colonyID, cronID, core.CRON_TRIGGER, time.Now(), core.GenerateRandomID())
+	err = db.AddRunRecord(runRecord1)
+	assert.Nil(t, err)
+
+	runRecord2 := core.CreateSkippedRunRecord(colonyID, cronID, core.CRON_TRIGGER, time.Now(), "reason")
+	runRecord2.TriggeredTime = time.Now().Add(1 * time.Second)
+	err = db.AddRunRecord(runRecord2)
+	assert.Nil(t, err)
+
+	runRecord3 := core.CreateRunRecord(colonyID, core.GenerateRandomID(), core.GENERATOR_TRIGGER, time.Now(), core.GenerateRandomID())
+	err = db.AddRunRecord(runRecord3)
+	assert.Nil(t, err)
+
+	runHistory, err := db.FindRunHistory(cronID, 100)
+	assert.Nil(t, err)
+	assert.Len(t, runHistory, 2)
+	assert.True(t, runHistory[0].Equals(runRecord2)) // Newest first
+	assert.True(t, runHistory[1].Equals(runRecord1))
+
+	runHistory, err = db.FindRunHistory(cronID, 1)
+	assert.Nil(t, err)
+	assert.Len(t, runHistory, 1)
+}
+
+func TestSetRunRecordState(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+	graph, err := core.CreateProcessGraph(colonyID)
+	assert.Nil(t, err)
+	err = db.AddProcessGraph(graph)
+	assert.Nil(t, err)
+
+	cronID := core.GenerateRandomID()
+	runRecord := core.CreateRunRecord(colonyID, cronID, core.CRON_TRIGGER, time.Now(), graph.ID)
+	err = db.AddRunRecord(runRecord)
+	assert.Nil(t, err)
+
+	// Changing the state of the processgraph should also update the run history
+	err = db.SetProcessGraphState(graph.ID, core.FAILED)
+	assert.Nil(t, err)
+
+	runHistory, err := db.FindRunHistory(cronID, 100)
+	assert.Nil(t, err)
+	assert.Len(t, runHistory, 1)
+	assert.Equal(t, runHistory[0].State, core.FAILED)
+}
+
+func TestDeleteRunHistory(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+	cron := core.CreateCron(colonyID, "test_name", "* * * * * *", 0, false, "workflow")
+	cron.ID = core.GenerateRandomID()
+	err = db.AddCron(cron)
+	assert.Nil(t, err)
+
+	err = db.AddRunRecord(core.CreateRunRecord(colonyID, cron.ID, core.CRON_TRIGGER, time.Now(), core.GenerateRandomID()))
+	assert.Nil(t, err)
+
+	otherCronID := core.GenerateRandomID()
+	err = db.AddRunRecord(core.CreateRunRecord(colonyID, otherCronID, core.CRON_TRIGGER, time.Now(), core.GenerateRandomID()))
+	assert.Nil(t, err)
+
+	// Deleting a cron should also remove its run history
+	err = db.DeleteCronByID(cron.ID)
+	assert.Nil(t, err)
+
+	runHistory, err := db.FindRunHistory(cron.ID, 100)
+	assert.Nil(t, err)
+	assert.Len(t, runHistory, 0)
+
+	runHistory, err = db.FindRunHistory(otherCronID, 100)
+	assert.Nil(t, err)
+	assert.Len(t, runHistory, 1)
+
+	err = db.DeleteRunHistoryByTriggerID(otherCronID)
+	assert.Nil(t, err)
+
+	runHistory, err = db.FindRunHistory(otherCronID, 100)
+	assert.Nil(t, err)
+	assert.Len(t, runHistory, 0)
+}
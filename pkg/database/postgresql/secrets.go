@@ -0,0 +1,100 @@
+package postgresql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/lib/pq"
+)
+
+// AddSecret adds a secret, an existing secret with the same name in the colony is replaced. The value is stored as
+// is, i.e. it must be encrypted by the caller.
+func (db *PQDatabase) AddSecret(secret *core.Secret) error {
+	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `SECRETS (SECRET_ID, COLONY_ID, NAME, VALUE, EXECUTOR_TYPES, ADDED) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (COLONY_ID, NAME) DO UPDATE SET SECRET_ID=EXCLUDED.SECRET_ID, VALUE=EXCLUDED.VALUE, EXECUTOR_TYPES=EXCLUDED.EXECUTOR_TYPES, ADDED=EXCLUDED.ADDED`
+	_, err := db.postgresql.Exec(sqlStatement, secret.ID, secret.ColonyID, secret.Name, secret.Value, pq.Array(secret.ExecutorTypes), secret.Added)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) parseSecrets(rows *sql.Rows) ([]*core.Secret, error) {
+	var secrets []*core.Secret
+
+	for rows.Next() {
+		var secretID string
+		var colonyID string
+		var name string
+		var value string
+		var executorTypes []string
+		var added time.Time
+		if err := rows.Scan(&secretID, &colonyID, &name, &value, pq.Array(&executorTypes), &added); err != nil {
+			return nil, err
+		}
+
+		secret := &core.Secret{
+			ID:            secretID,
+			ColonyID:      colonyID,
+			Name:          name,
+			Value:         value,
+			ExecutorTypes: executorTypes,
+			Added:         added}
+
+		secrets = append(secrets, secret)
+	}
+
+	return secrets, nil
+}
+
+func (db *PQDatabase) GetSecretByName(colonyID string, name string) (*core.Secret, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `SECRETS WHERE COLONY_ID=$1 AND NAME=$2`
+	rows, err := db.postgresql.Query(sqlStatement, colonyID, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	secrets, err := db.parseSecrets(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(secrets) == 0 {
+		return nil, nil
+	}
+
+	return secrets[0], nil
+}
+
+func (db *PQDatabase) FindSecretsByColonyID(colonyID string) ([]*core.Secret, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `SECRETS WHERE COLONY_ID=$1 ORDER BY NAME`
+	rows, err := db.postgresql.Query(sqlStatement, colonyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return db.parseSecrets(rows)
+}
+
+func (db *PQDatabase) DeleteSecretByName(colonyID string, name string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `SECRETS WHERE COLONY_ID=$1 AND NAME=$2`
+	_, err := db.postgresql.Exec(sqlStatement, colonyID, name)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) DeleteAllSecretsByColonyID(colonyID string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `SECRETS WHERE COLONY_ID=$1`
+	_, err := db.postgresql.Exec(sqlStatement, colonyID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
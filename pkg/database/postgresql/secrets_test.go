@@ -0,0 +1,109 @@
+package postgresql
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretsClosedDB(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	db.Close()
+
+	err = db.AddSecret(core.CreateSecret(core.GenerateRandomID(), "db-password", "encrypted", []string{"test_executor_type"}))
+	assert.NotNil(t, err)
+
+	_, err = db.GetSecretByName("invalid_id", "db-password")
+	assert.NotNil(t, err)
+
+	_, err = db.FindSecretsByColonyID("invalid_id")
+	assert.NotNil(t, err)
+
+	err = db.DeleteSecretByName("invalid_id", "db-password")
+	assert.NotNil(t, err)
+
+	err = db.DeleteAllSecretsByColonyID("invalid_id")
+	assert.NotNil(t, err)
+}
+
+func TestAddSecret(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+
+	secret1 := core.CreateSecret(colonyID, "db-password", "encrypted1", []string{"test_executor_type"})
+	err = db.AddSecret(secret1)
+	assert.Nil(t, err)
+
+	secret2 := core.CreateSecret(colonyID, "api-key", "encrypted2", []string{"test_executor_type"})
+	err = db.AddSecret(secret2)
+	assert.Nil(t, err)
+
+	secretFromDB, err := db.GetSecretByName(colonyID, "db-password")
+	assert.Nil(t, err)
+	assert.True(t, secret1.Equals(secretFromDB))
+
+	secretFromDB, err = db.GetSecretByName(colonyID, "invalid_name")
+	assert.Nil(t, err)
+	assert.Nil(t, secretFromDB)
+
+	secrets, err := db.FindSecretsByColonyID(colonyID)
+	assert.Nil(t, err)
+	assert.Len(t, secrets, 2)
+	assert.Equal(t, secrets[0].Name, "api-key") // Sorted by name
+
+	// Adding a secret with the same name replaces the secret
+	secret3 := core.CreateSecret(colonyID, "db-password", "encrypted3", []string{"test_executor_type", "other_executor_type"})
+	err = db.AddSecret(secret3)
+	assert.Nil(t, err)
+
+	secretFromDB, err = db.GetSecretByName(colonyID, "db-password")
+	assert.Nil(t, err)
+	assert.True(t, secret3.Equals(secretFromDB))
+
+	secrets, err = db.FindSecretsByColonyID(colonyID)
+	assert.Nil(t, err)
+	assert.Len(t, secrets, 2)
+}
+
+func TestDeleteSecret(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+
+	err = db.AddSecret(core.CreateSecret(colonyID, "db-password", "encrypted1", []string{"test_executor_type"}))
+	assert.Nil(t, err)
+	err = db.AddSecret(core.CreateSecret(colonyID, "api-key", "encrypted2", []string{"test_executor_type"}))
+	assert.Nil(t, err)
+
+	otherColonyID := core.GenerateRandomID()
+	err = db.AddSecret(core.CreateSecret(otherColonyID, "db-password", "encrypted3", []string{"test_executor_type"}))
+	assert.Nil(t, err)
+
+	err = db.DeleteSecretByName(colonyID, "db-password")
+	assert.Nil(t, err)
+
+	secrets, err := db.FindSecretsByColonyID(colonyID)
+	assert.Nil(t, err)
+	assert.Len(t, secrets, 1)
+
+	err = db.DeleteAllSecretsByColonyID(colonyID)
+	assert.Nil(t, err)
+
+	secrets, err = db.FindSecretsByColonyID(colonyID)
+	assert.Nil(t, err)
+	assert.Len(t, secrets, 0)
+
+	secrets, err = db.FindSecretsByColonyID(otherColonyID)
+	assert.Nil(t, err)
+	assert.Len(t, secrets, 1)
+}
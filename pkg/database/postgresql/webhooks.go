@@ -0,0 +1,144 @@
+package postgresql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/lib/pq"
+)
+
+func (db *PQDatabase) AddWebhook(webhook *core.Webhook) error {
+	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `WEBHOOKS (WEBHOOK_ID, COLONY_ID, URL, SECRET, KINDS, TYPES, ADDED) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	_, err := db.postgresql.Exec(sqlStatement, webhook.ID, webhook.ColonyID, webhook.URL, webhook.Secret, pq.Array(webhook.Kinds), pq.Array(webhook.Types), webhook.Added)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) parseWebhooks(rows *sql.Rows) ([]*core.Webhook, error) {
+	var webhooks []*core.Webhook
+
+	for rows.Next() {
+		var webhookID string
+		var colonyID string
+		var url string
+		var secret string
+		var kinds []string
+		var types []string
+		var added time.Time
+		if err := rows.Scan(&webhookID, &colonyID, &url, &secret, pq.Array(&kinds), pq.Array(&types), &added); err != nil {
+			return nil, err
+		}
+
+		webhook := &core.Webhook{
+			ID:       webhookID,
+			ColonyID: colonyID,
+			URL:      url,
+			Secret:   secret,
+			Kinds:    kinds,
+			Types:    types,
+			Added:    added}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
+
+func (db *PQDatabase) GetWebhookByID(webhookID string) (*core.Webhook, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `WEBHOOKS WHERE WEBHOOK_ID=$1`
+	rows, err := db.postgresql.Query(sqlStatement, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	webhooks, err := db.parseWebhooks(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(webhooks) == 0 {
+		return nil, nil
+	}
+
+	return webhooks[0], nil
+}
+
+func (db *PQDatabase) FindWebhooksByColonyID(colonyID string) ([]*core.Webhook, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `WEBHOOKS WHERE COLONY_ID=$1 ORDER BY ADDED`
+	rows, err := db.postgresql.Query(sqlStatement, colonyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return db.parseWebhooks(rows)
+}
+
+// DeleteWebhookByID deletes a webhook and its delivery log
+func (db *PQDatabase) DeleteWebhookByID(webhookID string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `WEBHOOKS WHERE WEBHOOK_ID=$1`
+	_, err := db.postgresql.Exec(sqlStatement, webhookID)
+	if err != nil {
+		return err
+	}
+
+	sqlStatement = `DELETE FROM ` + db.dbPrefix + `WEBHOOKDELIVERIES WHERE WEBHOOK_ID=$1`
+	_, err = db.postgresql.Exec(sqlStatement, webhookID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) DeleteAllWebhooksByColonyID(colonyID string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `WEBHOOKS WHERE COLONY_ID=$1`
+	_, err := db.postgresql.Exec(sqlStatement, colonyID)
+	if err != nil {
+		return err
+	}
+
+	sqlStatement = `DELETE FROM ` + db.dbPrefix + `WEBHOOKDELIVERIES WHERE COLONY_ID=$1`
+	_, err = db.postgresql.Exec(sqlStatement, colonyID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) AddWebhookDelivery(delivery *core.WebhookDelivery) error {
+	sqlStatement := `INSERT INTO  ` + db.dbPrefix + `WEBHOOKDELIVERIES (DELIVERY_ID, WEBHOOK_ID, COLONY_ID, EVENT_ID, KIND, TYPE, ATTEMPTS, STATUS_CODE, SUCCESS, ERROR, TIME) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+	_, err := db.postgresql.Exec(sqlStatement, delivery.ID, delivery.WebhookID, delivery.ColonyID, delivery.EventID, delivery.Kind, delivery.Type, delivery.Attempts, delivery.StatusCode, delivery.Success, delivery.Error, delivery.Time)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindWebhookDeliveries returns the delivery log of a webhook, newest first
+func (db *PQDatabase) FindWebhookDeliveries(webhookID string, count int) ([]*core.WebhookDelivery, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `WEBHOOKDELIVERIES WHERE WEBHOOK_ID=$1 ORDER BY TIME DESC LIMIT $2`
+	rows, err := db.postgresql.Query(sqlStatement, webhookID, count)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*core.WebhookDelivery
+	for rows.Next() {
+		delivery := &core.WebhookDelivery{}
+		if err := rows.Scan(&delivery.ID, &delivery.WebhookID, &delivery.ColonyID, &delivery.EventID, &delivery.Kind, &delivery.Type, &delivery.Attempts, &delivery.StatusCode, &delivery.Success, &delivery.Error, &delivery.Time); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,141 @@
+package postgresql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhooksClosedDB(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	db.Close()
+
+	webhook := core.CreateWebhook(core.GenerateRandomID(), "https://example.com/hook", "secret", nil, nil)
+	err = db.AddWebhook(webhook)
+	assert.NotNil(t, err)
+
+	_, err = db.GetWebhookByID("invalid_id")
+	assert.NotNil(t, err)
+
+	_, err = db.FindWebhooksByColonyID("invalid_id")
+	assert.NotNil(t, err)
+
+	err = db.DeleteWebhookByID("invalid_id")
+	assert.NotNil(t, err)
+
+	err = db.DeleteAllWebhooksByColonyID("invalid_id")
+	assert.NotNil(t, err)
+
+	event := core.CreateColonyEvent(webhook.ColonyID, core.PROCESS_KIND, core.FAILED_EVENT, core.GenerateRandomID(), core.FAILED, "")
+	err = db.AddWebhookDelivery(core.CreateWebhookDelivery(webhook, event))
+	assert.NotNil(t, err)
+
+	_, err = db.FindWebhookDeliveries("invalid_id", 1)
+	assert.NotNil(t, err)
+}
+
+func TestAddWebhook(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+
+	webhook1 := core.CreateWebhook(colonyID, "https://example.com/hook1", "secret1", []string{core.PROCESS_KIND}, []string{core.FAILED_EVENT})
+	err = db.AddWebhook(webhook1)
+	assert.Nil(t, err)
+
+	webhook2 := core.CreateWebhook(colonyID, "https://example.com/hook2", "secret2", []string{}, []string{})
+	webhook2.Added = time.Now().Add(1 * time.Second)
+	err = db.AddWebhook(webhook2)
+	assert.Nil(t, err)
+
+	webhookFromDB, err := db.GetWebhookByID(webhook1.ID)
+	assert.Nil(t, err)
+	assert.True(t, webhook1.Equals(webhookFromDB))
+
+	webhookFromDB, err = db.GetWebhookByID(core.GenerateRandomID())
+	assert.Nil(t, err)
+	assert.Nil(t, webhookFromDB)
+
+	webhooks, err := db.FindWebhooksByColonyID(colonyID)
+	assert.Nil(t, err)
+	assert.True(t, core.IsWebhookArraysEqual(webhooks, []*core.Webhook{webhook1, webhook2}))
+}
+
+func TestWebhookDeliveries(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	webhook := core.CreateWebhook(core.GenerateRandomID(), "https://example.com/hook", "secret", nil, nil)
+	err = db.AddWebhook(webhook)
+	assert.Nil(t, err)
+
+	event := core.CreateColonyEvent(webhook.ColonyID, core.PROCESS_KIND, core.FAILED_EVENT, core.GenerateRandomID(), core.FAILED, "")
+	delivery1 := core.CreateWebhookDelivery(webhook, event)
+	delivery1.Attempts = 3
+	delivery1.StatusCode = 500
+	delivery1.Error = "Internal Server Error"
+	err = db.AddWebhookDelivery(delivery1)
+	assert.Nil(t, err)
+
+	delivery2 := core.CreateWebhookDelivery(webhook, event)
+	delivery2.Attempts = 1
+	delivery2.StatusCode = 200
+	delivery2.Success = true
+	delivery2.Time = time.Now().Add(1 * time.Second)
+	err = db.AddWebhookDelivery(delivery2)
+	assert.Nil(t, err)
+
+	// Newest first
+	deliveries, err := db.FindWebhookDeliveries(webhook.ID, 100)
+	assert.Nil(t, err)
+	assert.True(t, core.IsWebhookDeliveryArraysEqual(deliveries, []*core.WebhookDelivery{delivery2, delivery1}))
+
+	deliveries, err = db.FindWebhookDeliveries(webhook.ID, 1)
+	assert.Nil(t, err)
+	assert.Len(t, deliveries, 1)
+
+	// The delivery log is deleted together with the webhook
+	err = db.DeleteWebhookByID(webhook.ID)
+	assert.Nil(t, err)
+
+	webhookFromDB, err := db.GetWebhookByID(webhook.ID)
+	assert.Nil(t, err)
+	assert.Nil(t, webhookFromDB)
+
+	deliveries, err = db.FindWebhookDeliveries(webhook.ID, 100)
+	assert.Nil(t, err)
+	assert.Len(t, deliveries, 0)
+}
+
+func TestDeleteWebhooks(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colony := core.CreateColony(core.GenerateRandomID(), "test_colony_name")
+	err = db.AddColony(colony)
+	assert.Nil(t, err)
+
+	err = db.AddWebhook(core.CreateWebhook(colony.ID, "https://example.com/hook1", "secret", nil, nil))
+	assert.Nil(t, err)
+	err = db.AddWebhook(core.CreateWebhook(colony.ID, "https://example.com/hook2", "secret", nil, nil))
+	assert.Nil(t, err)
+
+	// Webhooks are deleted when the colony is deleted
+	err = db.DeleteColonyByID(colony.ID)
+	assert.Nil(t, err)
+
+	webhooks, err := db.FindWebhooksByColonyID(colony.ID)
+	assert.Nil(t, err)
+	assert.Len(t, webhooks, 0)
+}
@@ -0,0 +1,165 @@
+package postgresql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+// AddWorkflowTemplate adds a new version of a workflow template, the version is set to the latest version of
+// the template with the same name plus one
+func (db *PQDatabase) AddWorkflowTemplate(template *core.WorkflowTemplate) error {
+	parametersJSON, err := json.Marshal(template.Parameters)
+	if err != nil {
+		return err
+	}
+
+	workflowJSON, err := json.Marshal(template.Workflow)
+	if err != nil {
+		return err
+	}
+
+	var latestVersion int
+	sqlStatement := `SELECT COALESCE(MAX(VERSION), 0) FROM ` + db.dbPrefix + `WORKFLOWTEMPLATES WHERE COLONY_ID=$1 AND NAME=$2`
+	err = db.postgresql.QueryRow(sqlStatement, template.ColonyID, template.Name).Scan(&latestVersion)
+	if err != nil {
+		return err
+	}
+
+	template.Version = latestVersion + 1
+	template.Added = time.Now()
+
+	sqlStatement = `INSERT INTO  ` + db.dbPrefix + `WORKFLOWTEMPLATES (WORKFLOWTEMPLATE_ID, COLONY_ID, NAME, VERSION, PARAMETERS, WORKFLOW_SPEC, ADDED) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	_, err = db.postgresql.Exec(sqlStatement, template.ID, template.ColonyID, template.Name, template.Version, string(parametersJSON), string(workflowJSON), template.Added)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) parseWorkflowTemplates(rows *sql.Rows) ([]*core.WorkflowTemplate, error) {
+	var templates []*core.WorkflowTemplate
+
+	for rows.Next() {
+		var templateID string
+		var colonyID string
+		var name string
+		var version int
+		var parametersJSON string
+		var workflowJSON string
+		var added time.Time
+		if err := rows.Scan(&templateID, &colonyID, &name, &version, &parametersJSON, &workflowJSON, &added); err != nil {
+			return nil, err
+		}
+
+		var parameters []core.TemplateParameter
+		err := json.Unmarshal([]byte(parametersJSON), &parameters)
+		if err != nil {
+			return nil, err
+		}
+
+		workflow, err := core.ConvertJSONToWorkflowSpec(workflowJSON)
+		if err != nil {
+			return nil, err
+		}
+
+		template := core.CreateWorkflowTemplate(colonyID, name, parameters, workflow)
+		template.ID = templateID
+		template.Version = version
+		template.Added = added
+
+		templates = append(templates, template)
+	}
+
+	return templates, nil
+}
+
+func (db *PQDatabase) GetWorkflowTemplateByID(templateID string) (*core.WorkflowTemplate, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `WORKFLOWTEMPLATES WHERE WORKFLOWTEMPLATE_ID=$1`
+	rows, err := db.postgresql.Query(sqlStatement, templateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	templates, err := db.parseWorkflowTemplates(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(templates) == 0 {
+		return nil, nil
+	}
+
+	return templates[0], nil
+}
+
+// GetWorkflowTemplate returns a specific version of a workflow template, or the latest version if version is 0
+func (db *PQDatabase) GetWorkflowTemplate(colonyID string, name string, version int) (*core.WorkflowTemplate, error) {
+	var rows *sql.Rows
+	var err error
+	if version == 0 {
+		sqlStatement := `SELECT * FROM ` + db.dbPrefix + `WORKFLOWTEMPLATES WHERE COLONY_ID=$1 AND NAME=$2 ORDER BY VERSION DESC LIMIT 1`
+		rows, err = db.postgresql.Query(sqlStatement, colonyID, name)
+	} else {
+		sqlStatement := `SELECT * FROM ` + db.dbPrefix + `WORKFLOWTEMPLATES WHERE COLONY_ID=$1 AND NAME=$2 AND VERSION=$3`
+		rows, err = db.postgresql.Query(sqlStatement, colonyID, name, version)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	templates, err := db.parseWorkflowTemplates(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(templates) == 0 {
+		return nil, nil
+	}
+
+	return templates[0], nil
+}
+
+// FindWorkflowTemplatesByColonyID returns all versions of all workflow templates in a colony
+func (db *PQDatabase) FindWorkflowTemplatesByColonyID(colonyID string) ([]*core.WorkflowTemplate, error) {
+	sqlStatement := `SELECT * FROM ` + db.dbPrefix + `WORKFLOWTEMPLATES WHERE COLONY_ID=$1 ORDER BY NAME, VERSION`
+	rows, err := db.postgresql.Query(sqlStatement, colonyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return db.parseWorkflowTemplates(rows)
+}
+
+// DeleteWorkflowTemplate deletes a specific version of a workflow template, or all versions if version is 0
+func (db *PQDatabase) DeleteWorkflowTemplate(colonyID string, name string, version int) error {
+	var err error
+	if version == 0 {
+		sqlStatement := `DELETE FROM ` + db.dbPrefix + `WORKFLOWTEMPLATES WHERE COLONY_ID=$1 AND NAME=$2`
+		_, err = db.postgresql.Exec(sqlStatement, colonyID, name)
+	} else {
+		sqlStatement := `DELETE FROM ` + db.dbPrefix + `WORKFLOWTEMPLATES WHERE COLONY_ID=$1 AND NAME=$2 AND VERSION=$3`
+		_, err = db.postgresql.Exec(sqlStatement, colonyID, name, version)
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *PQDatabase) DeleteAllWorkflowTemplatesByColonyID(colonyID string) error {
+	sqlStatement := `DELETE FROM ` + db.dbPrefix + `WORKFLOWTEMPLATES WHERE COLONY_ID=$1`
+	_, err := db.postgresql.Exec(sqlStatement, colonyID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package postgresql
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestWorkflowTemplate(colonyID string, name string) *core.WorkflowTemplate {
+	funcSpec := core.CreateEmptyFunctionSpec()
+	funcSpec.NodeName = "task"
+	funcSpec.Args = []interface{}{"${arg}"}
+	workflowSpec := core.CreateWorkflowSpec(colonyID)
+	workflowSpec.AddFunctionSpec(funcSpec)
+
+	parameters := []core.TemplateParameter{{Name: "arg", Default: "test_arg"}}
+	template := core.CreateWorkflowTemplate(colonyID, name, parameters, workflowSpec)
+	template.ID = core.GenerateRandomID()
+
+	return template
+}
+
+func TestWorkflowTemplatesClosedDB(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	db.Close()
+
+	err = db.AddWorkflowTemplate(createTestWorkflowTemplate(core.GenerateRandomID(), "test_template"))
+	assert.NotNil(t, err)
+
+	_, err = db.GetWorkflowTemplateByID("invalid_id")
+	assert.NotNil(t, err)
+
+	_, err = db.GetWorkflowTemplate("invalid_id", "test_template", 0)
+	assert.NotNil(t, err)
+
+	_, err = db.GetWorkflowTemplate("invalid_id", "test_template", 1)
+	assert.NotNil(t, err)
+
+	_, err = db.FindWorkflowTemplatesByColonyID("invalid_id")
+	assert.NotNil(t, err)
+
+	err = db.DeleteWorkflowTemplate("invalid_id", "test_template", 0)
+	assert.NotNil(t, err)
+
+	err = db.DeleteWorkflowTemplate("invalid_id", "test_template", 1)
+	assert.NotNil(t, err)
+
+	err = db.DeleteAllWorkflowTemplatesByColonyID("invalid_id")
+	assert.NotNil(t, err)
+}
+
+func TestAddWorkflowTemplate(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+
+	template1 := createTestWorkflowTemplate(colonyID, "test_template")
+	err = db.AddWorkflowTemplate(template1)
+	assert.Nil(t, err)
+	assert.Equal(t, template1.Version, 1)
+
+	template2 := createTestWorkflowTemplate(colonyID, "test_template")
+	err = db.AddWorkflowTemplate(template2)
+	assert.Nil(t, err)
+	assert.Equal(t, template2.Version, 2)
+
+	template3 := createTestWorkflowTemplate(colonyID, "test_template2")
+	err = db.AddWorkflowTemplate(template3)
+	assert.Nil(t, err)
+	assert.Equal(t, template3.Version, 1)
+
+	templateFromDB, err := db.GetWorkflowTemplateByID(template1.ID)
+	assert.Nil(t, err)
+	assert.True(t, template1.Equals(templateFromDB))
+
+	templateFromDB, err = db.GetWorkflowTemplate(colonyID, "test_template", 0)
+	assert.Nil(t, err)
+	assert.True(t, template2.Equals(templateFromDB)) // Latest version
+
+	templateFromDB, err = db.GetWorkflowTemplate(colonyID, "test_template", 1)
+	assert.Nil(t, err)
+	assert.True(t, template1.Equals(templateFromDB))
+
+	templateFromDB, err = db.GetWorkflowTemplate(colonyID, "test_template", 3)
+	assert.Nil(t, err)
+	assert.Nil(t, templateFromDB)
+
+	templates, err := db.FindWorkflowTemplatesByColonyID(colonyID)
+	assert.Nil(t, err)
+	assert.True(t, core.IsWorkflowTemplateArraysEqual(templates, []*core.WorkflowTemplate{template1, template2, template3}))
+}
+
+func TestDeleteWorkflowTemplate(t *testing.T) {
+	db, err := PrepareTests()
+	assert.Nil(t, err)
+
+	defer db.Close()
+
+	colonyID := core.GenerateRandomID()
+
+	for i := 0; i < 3; i++ {
+		err = db.AddWorkflowTemplate(createTestWorkflowTemplate(colonyID, "test_template"))
+		assert.Nil(t, err)
+	}
+	err = db.AddWorkflowTemplate(createTestWorkflowTemplate(colonyID, "test_template2"))
+	assert.Nil(t, err)
+
+	otherColonyID := core.GenerateRandomID()
+	err = db.AddWorkflowTemplate(createTestWorkflowTemplate(otherColonyID, "test_template"))
+	assert.Nil(t, err)
+
+	err = db.DeleteWorkflowTemplate(colonyID, "test_template", 2)
+	assert.Nil(t, err)
+
+	templates, err := db.FindWorkflowTemplatesByColonyID(colonyID)
+	assert.Nil(t, err)
+	assert.Len(t, templates, 3)
+
+	err = db.DeleteWorkflowTemplate(colonyID, "test_template", 0)
+	assert.Nil(t, err)
+
+	templates, err = db.FindWorkflowTemplatesByColonyID(colonyID)
+	assert.Nil(t, err)
+	assert.Len(t, templates, 1)
+
+	err = db.DeleteAllWorkflowTemplatesByColonyID(colonyID)
+	assert.Nil(t, err)
+
+	templates, err = db.FindWorkflowTemplatesByColonyID(colonyID)
+	assert.Nil(t, err)
+	assert.Len(t, templates, 0)
+
+	templates, err = db.FindWorkflowTemplatesByColonyID(otherColonyID)
+	assert.Nil(t, err)
+	assert.Len(t, templates, 1)
+}
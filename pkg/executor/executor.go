@@ -0,0 +1,381 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/signal"
+	"runtime/debug"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/client"
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/security/crypto"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	DEFAULT_CONCURRENCY      = 1
+	DEFAULT_ASSIGN_TIMEOUT   = 10 // Seconds
+	DEFAULT_RECONNECT_DELAY  = 2 * time.Second
+	DEFAULT_SHUTDOWN_TIMEOUT = 30 * time.Second
+	MAX_CLOSE_RETRIES        = 5
+)
+
+// HandlerFunc executes an assigned process. The returned output is stored on the process when it is closed as
+// successful, if an error is returned the process is closed as failed. The context is cancelled if the executor
+// is shut down and the handler does not return within the shutdown timeout.
+type HandlerFunc func(ctx context.Context, process *core.Process) ([]interface{}, error)
+
+type handler struct {
+	funcName string
+	desc     string
+	fn       HandlerFunc
+}
+
+// coloniesClient is the subset of the Colonies client used by the executor
+type coloniesClient interface {
+	AddExecutor(executor *core.Executor, prvKey string) (*core.Executor, error)
+	ApproveExecutor(executorID string, prvKey string) error
+	AssignWithContext(colonyID string, timeout int, ctx context.Context, prvKey string) (*core.Process, error)
+	AddFunction(function *core.Function, prvKey string) (*core.Function, error)
+	GetFunctionsByExecutorID(executorID string, prvKey string) ([]*core.Function, error)
+	Close(processID string, prvKey string) error
+	CloseWithOutput(processID string, output []interface{}, prvKey string) error
+	Fail(processID string, errs []string, prvKey string) error
+}
+
+// Executor assigns processes from a colony and dispatches them to handlers registered per function name
+type Executor struct {
+	client          coloniesClient
+	colonyID        string
+	executorID      string
+	executorPrvKey  string
+	handlers        map[string]*handler
+	concurrency     int
+	assignTimeout   int
+	reconnectDelay  time.Duration
+	shutdownTimeout time.Duration
+	mutex           sync.Mutex
+	running         bool
+}
+
+// CreateExecutor creates an executor where the Executor Id is derived from the private key. Use
+// CreateExecutorWithID if the private key has been rotated, since the Executor Id is then no longer derived from it.
+func CreateExecutor(client *client.ColoniesClient, colonyID string, executorPrvKey string) (*Executor, error) {
+	executorID, err := crypto.CreateCrypto().GenerateID(executorPrvKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return createExecutor(client, colonyID, executorID, executorPrvKey), nil
+}
+
+// CreateExecutorWithID creates an executor for an existing Executor Id, e.g. after its private key has been rotated
+func CreateExecutorWithID(client *client.ColoniesClient, colonyID string, executorID string, executorPrvKey string) (*Executor, error) {
+	if executorID == "" {
+		return nil, errors.New("Executor Id must be specified")
+	}
+
+	return createExecutor(client, colonyID, executorID, executorPrvKey), nil
+}
+
+func createExecutor(client coloniesClient, colonyID string, executorID string, executorPrvKey string) *Executor {
+	return &Executor{
+		client:          client,
+		colonyID:        colonyID,
+		executorID:      executorID,
+		executorPrvKey:  executorPrvKey,
+		handlers:        make(map[string]*handler),
+		concurrency:     DEFAULT_CONCURRENCY,
+		assignTimeout:   DEFAULT_ASSIGN_TIMEOUT,
+		reconnectDelay:  DEFAULT_RECONNECT_DELAY,
+		shutdownTimeout: DEFAULT_SHUTDOWN_TIMEOUT,
+	}
+}
+
+func (executor *Executor) ExecutorID() string {
+	return executor.executorID
+}
+
+// SetConcurrency sets the max number of processes executed in parallel
+func (executor *Executor) SetConcurrency(concurrency int) error {
+	if concurrency < 1 {
+		return errors.New("Concurrency must be at least 1")
+	}
+	executor.concurrency = concurrency
+	return nil
+}
+
+// SetAssignTimeout sets the max number of seconds an assign request waits for a process
+func (executor *Executor) SetAssignTimeout(timeout int) {
+	executor.assignTimeout = timeout
+}
+
+// SetReconnectDelay sets how long to wait before calling the server again after a connection error
+func (executor *Executor) SetReconnectDelay(delay time.Duration) {
+	executor.reconnectDelay = delay
+}
+
+// SetShutdownTimeout sets how long running handlers may continue after shutdown before their context is cancelled
+func (executor *Executor) SetShutdownTimeout(timeout time.Duration) {
+	executor.shutdownTimeout = timeout
+}
+
+// AddHandler registers a handler for a function, the function is added to the server when the executor is started
+func (executor *Executor) AddHandler(funcName string, desc string, fn HandlerFunc) error {
+	if funcName == "" {
+		return errors.New("Function name must be specified")
+	}
+	if fn == nil {
+		return errors.New("Handler must not be nil")
+	}
+
+	executor.mutex.Lock()
+	defer executor.mutex.Unlock()
+
+	if executor.running {
+		return errors.New("Handlers cannot be added to a running executor")
+	}
+	if _, ok := executor.handlers[funcName]; ok {
+		return errors.New("A handler for function <" + funcName + "> is already registered")
+	}
+
+	executor.handlers[funcName] = &handler{funcName: funcName, desc: desc, fn: fn}
+
+	return nil
+}
+
+// Register adds the executor to the colony and approves it, this requires the colony private key
+func (executor *Executor) Register(executorName string, executorType string, colonyPrvKey string) error {
+	coreExecutor := core.CreateExecutor(executor.executorID, executorType, executorName, executor.colonyID, time.Now(), time.Now())
+	_, err := executor.client.AddExecutor(coreExecutor, colonyPrvKey)
+	if err != nil {
+		return err
+	}
+
+	return executor.client.ApproveExecutor(executor.executorID, colonyPrvKey)
+}
+
+// Start runs the executor until SIGINT or SIGTERM is received
+func (executor *Executor) Start() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return executor.Run(ctx)
+}
+
+// Run assigns and executes processes until the context is cancelled. After the context has been cancelled, no new
+// processes are assigned, and Run returns when all running handlers have returned.
+func (executor *Executor) Run(ctx context.Context) error {
+	executor.mutex.Lock()
+	if executor.running {
+		executor.mutex.Unlock()
+		return errors.New("Executor is already running")
+	}
+	if len(executor.handlers) == 0 {
+		executor.mutex.Unlock()
+		return errors.New("No handlers have been added")
+	}
+	executor.running = true
+	executor.mutex.Unlock()
+
+	defer func() {
+		executor.mutex.Lock()
+		executor.running = false
+		executor.mutex.Unlock()
+	}()
+
+	err := executor.addFunctions(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Handlers get their own context, which is only cancelled if they are still running when the shutdown timeout
+	// has expired
+	handlerCtx, cancelHandlers := context.WithCancel(context.Background())
+	defer cancelHandlers()
+
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-done:
+		case <-ctx.Done():
+			timer := time.NewTimer(executor.shutdownTimeout)
+			defer timer.Stop()
+			select {
+			case <-done:
+			case <-timer.C:
+				log.WithFields(log.Fields{"ExecutorID": executor.executorID}).Warn("Shutdown timeout expired, cancelling running handlers")
+				cancelHandlers()
+			}
+		}
+	}()
+
+	log.WithFields(log.Fields{"ExecutorID": executor.executorID, "ColonyID": executor.colonyID, "Concurrency": executor.concurrency}).Info("Executor now waiting for processes to execute")
+
+	var wg sync.WaitGroup
+	for i := 0; i < executor.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			executor.worker(ctx, handlerCtx)
+		}()
+	}
+	wg.Wait()
+	close(done)
+
+	log.WithFields(log.Fields{"ExecutorID": executor.executorID}).Info("Executor stopped")
+
+	return nil
+}
+
+// addFunctions registers all handled functions on the server, functions already registered by a previous run are
+// skipped
+func (executor *Executor) addFunctions(ctx context.Context) error {
+	var functions []*core.Function
+	err := executor.retry(ctx, func() error {
+		var err error
+		functions, err = executor.client.GetFunctionsByExecutorID(executor.executorID, executor.executorPrvKey)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]bool)
+	for _, function := range functions {
+		existing[function.FuncName] = true
+	}
+
+	for funcName, handler := range executor.handlers {
+		if existing[funcName] {
+			continue
+		}
+		function := &core.Function{ExecutorID: executor.executorID, ColonyID: executor.colonyID, FuncName: funcName, Desc: handler.desc}
+		err := executor.retry(ctx, func() error {
+			_, err := executor.client.AddFunction(function, executor.executorPrvKey)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		log.WithFields(log.Fields{"ExecutorID": executor.executorID, "FuncName": funcName}).Debug("Added function")
+	}
+
+	return nil
+}
+
+// retry calls f until it succeeds, fails with an error that is not a connection error, or the context is cancelled
+func (executor *Executor) retry(ctx context.Context, f func() error) error {
+	for {
+		err := f()
+		if err == nil || !isConnectionError(err) {
+			return err
+		}
+
+		log.WithFields(log.Fields{"Error": err}).Warn("Connection error, trying to reconnect ...")
+		if !executor.sleep(ctx) {
+			return ctx.Err()
+		}
+	}
+}
+
+// sleep waits the reconnect delay, false is returned if the context was cancelled while waiting
+func (executor *Executor) sleep(ctx context.Context) bool {
+	timer := time.NewTimer(executor.reconnectDelay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func (executor *Executor) worker(ctx context.Context, handlerCtx context.Context) {
+	for ctx.Err() == nil {
+		process, err := executor.client.AssignWithContext(executor.colonyID, executor.assignTimeout, ctx, executor.executorPrvKey)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			if errors.Is(err, core.ErrNoWorkAvailable) {
+				continue
+			}
+			if isConnectionError(err) {
+				log.WithFields(log.Fields{"Error": err}).Warn("Connection error, trying to reconnect ...")
+			} else {
+				log.WithFields(log.Fields{"Error": err}).Error("Failed to assign process")
+			}
+			executor.sleep(ctx)
+			continue
+		}
+
+		executor.execute(handlerCtx, process)
+	}
+}
+
+// execute calls the handler of an assigned process and closes the process with the result
+func (executor *Executor) execute(ctx context.Context, process *core.Process) {
+	log.WithFields(log.Fields{"ProcessID": process.ID, "FuncName": process.FunctionSpec.FuncName}).Info("Executor was assigned a process")
+
+	output, err := executor.call(ctx, process)
+
+	err = executor.closeProcess(process, output, err)
+	if err != nil {
+		log.WithFields(log.Fields{"ProcessID": process.ID, "Error": err}).Error("Failed to close process")
+	}
+}
+
+// call calls the handler of a process, a panic in the handler is returned as an error
+func (executor *Executor) call(ctx context.Context, process *core.Process) (output []interface{}, err error) {
+	handler, ok := executor.handlers[process.FunctionSpec.FuncName]
+	if !ok {
+		return nil, errors.New("No handler registered for function <" + process.FunctionSpec.FuncName + ">")
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			log.WithFields(log.Fields{"ProcessID": process.ID, "Panic": r, "Stack": string(debug.Stack())}).Error("Handler panicked")
+			output = nil
+			err = fmt.Errorf("Handler panicked: %v", r)
+		}
+	}()
+
+	return handler.fn(ctx, process)
+}
+
+func (executor *Executor) closeProcess(process *core.Process, output []interface{}, handlerErr error) error {
+	var err error
+	for retries := 0; retries < MAX_CLOSE_RETRIES; retries++ {
+		if handlerErr != nil {
+			log.WithFields(log.Fields{"ProcessID": process.ID, "Error": handlerErr}).Info("Closing process as failed")
+			err = executor.client.Fail(process.ID, []string{handlerErr.Error()}, executor.executorPrvKey)
+		} else if len(output) > 0 {
+			log.WithFields(log.Fields{"ProcessID": process.ID}).Info("Closing process as successful")
+			err = executor.client.CloseWithOutput(process.ID, output, executor.executorPrvKey)
+		} else {
+			log.WithFields(log.Fields{"ProcessID": process.ID}).Info("Closing process as successful")
+			err = executor.client.Close(process.ID, executor.executorPrvKey)
+		}
+
+		if err == nil || !isConnectionError(err) {
+			return err
+		}
+
+		log.WithFields(log.Fields{"ProcessID": process.ID, "Error": err}).Warn("Connection error, trying to reconnect ...")
+		time.Sleep(executor.reconnectDelay)
+	}
+
+	return err
+}
+
+func isConnectionError(err error) bool {
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
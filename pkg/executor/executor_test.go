@@ -0,0 +1,360 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/security/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+type closedProcess struct {
+	output []interface{}
+	errs   []string
+	failed bool
+}
+
+type clientMock struct {
+	mutex           sync.Mutex
+	processes       chan *core.Process
+	functions       []*core.Function
+	closed          map[string]*closedProcess
+	closedChan      chan string
+	assignErrs      []error
+	approved        bool
+	addedExecutor   *core.Executor
+	addFunctionErrs []error
+}
+
+func createClientMock() *clientMock {
+	return &clientMock{processes: make(chan *core.Process, 100), closed: make(map[string]*closedProcess), closedChan: make(chan string, 100)}
+}
+
+func (client *clientMock) AddExecutor(executor *core.Executor, prvKey string) (*core.Executor, error) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	client.addedExecutor = executor
+	return executor, nil
+}
+
+func (client *clientMock) ApproveExecutor(executorID string, prvKey string) error {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	client.approved = true
+	return nil
+}
+
+func (client *clientMock) AssignWithContext(colonyID string, timeout int, ctx context.Context, prvKey string) (*core.Process, error) {
+	client.mutex.Lock()
+	if len(client.assignErrs) > 0 {
+		err := client.assignErrs[0]
+		client.assignErrs = client.assignErrs[1:]
+		client.mutex.Unlock()
+		return nil, err
+	}
+	client.mutex.Unlock()
+
+	select {
+	case process := <-client.processes:
+		return process, nil
+	case <-ctx.Done():
+		return nil, &url.Error{Op: "Post", URL: "https://localhost/api", Err: ctx.Err()}
+	case <-time.After(10 * time.Millisecond):
+		return nil, core.CreateError(core.ERROR_NO_WORK_AVAILABLE, "No processes can be selected for executor with Id <"+prvKey+">")
+	}
+}
+
+func (client *clientMock) AddFunction(function *core.Function, prvKey string) (*core.Function, error) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	if len(client.addFunctionErrs) > 0 {
+		err := client.addFunctionErrs[0]
+		client.addFunctionErrs = client.addFunctionErrs[1:]
+		return nil, err
+	}
+	client.functions = append(client.functions, function)
+	return function, nil
+}
+
+func (client *clientMock) GetFunctionsByExecutorID(executorID string, prvKey string) ([]*core.Function, error) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	return client.functions, nil
+}
+
+func (client *clientMock) close(processID string, closed *closedProcess) {
+	client.mutex.Lock()
+	client.closed[processID] = closed
+	client.mutex.Unlock()
+	client.closedChan <- processID
+}
+
+func (client *clientMock) Close(processID string, prvKey string) error {
+	client.close(processID, &closedProcess{})
+	return nil
+}
+
+func (client *clientMock) CloseWithOutput(processID string, output []interface{}, prvKey string) error {
+	client.close(processID, &closedProcess{output: output})
+	return nil
+}
+
+func (client *clientMock) Fail(processID string, errs []string, prvKey string) error {
+	client.close(processID, &closedProcess{errs: errs, failed: true})
+	return nil
+}
+
+func (client *clientMock) getClosed(processID string) *closedProcess {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	return client.closed[processID]
+}
+
+func (client *clientMock) waitForClosed(t *testing.T, count int) {
+	for i := 0; i < count; i++ {
+		select {
+		case <-client.closedChan:
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "Timeout waiting for process to be closed")
+			return
+		}
+	}
+}
+
+func createTestProcess(funcName string) *core.Process {
+	funcSpec := core.CreateEmptyFunctionSpec()
+	funcSpec.FuncName = funcName
+	return core.CreateProcess(funcSpec)
+}
+
+func createTestExecutor(t *testing.T, client *clientMock) *Executor {
+	prvKey, err := crypto.CreateCrypto().GeneratePrivateKey()
+	assert.Nil(t, err)
+	executorID, err := crypto.CreateCrypto().GenerateID(prvKey)
+	assert.Nil(t, err)
+	executor := createExecutor(client, core.GenerateRandomID(), executorID, prvKey)
+	executor.SetReconnectDelay(10 * time.Millisecond)
+	executor.SetAssignTimeout(1)
+	return executor
+}
+
+func runTestExecutor(executor *Executor) (context.CancelFunc, chan error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- executor.Run(ctx)
+	}()
+	return cancel, errChan
+}
+
+func TestExecutorAddHandler(t *testing.T) {
+	executor := createTestExecutor(t, createClientMock())
+
+	handler := func(ctx context.Context, process *core.Process) ([]interface{}, error) { return nil, nil }
+	assert.Nil(t, executor.AddHandler("test_func", "Test function", handler))
+	assert.NotNil(t, executor.AddHandler("test_func", "Test function", handler))
+	assert.NotNil(t, executor.AddHandler("", "Test function", handler))
+	assert.NotNil(t, executor.AddHandler("test_func2", "Test function", nil))
+	assert.NotNil(t, executor.SetConcurrency(0))
+}
+
+func TestExecutorRunWithoutHandlers(t *testing.T) {
+	executor := createTestExecutor(t, createClientMock())
+	assert.NotNil(t, executor.Run(context.Background()))
+}
+
+func TestExecutorRegister(t *testing.T) {
+	client := createClientMock()
+	executor := createTestExecutor(t, client)
+
+	assert.Nil(t, executor.Register("test_executor", "test_executor_type", "colonyprvkey"))
+	assert.True(t, client.approved)
+	assert.Equal(t, executor.ExecutorID(), client.addedExecutor.ID)
+	assert.Equal(t, "test_executor_type", client.addedExecutor.Type)
+}
+
+func TestExecutorAddFunctions(t *testing.T) {
+	client := createClientMock()
+	executor := createTestExecutor(t, client)
+	client.functions = []*core.Function{{ExecutorID: executor.ExecutorID(), FuncName: "test_func1"}}
+	client.addFunctionErrs = []error{&url.Error{Op: "Post", URL: "https://localhost/api", Err: errors.New("connection refused")}}
+
+	handler := func(ctx context.Context, process *core.Process) ([]interface{}, error) { return nil, nil }
+	assert.Nil(t, executor.AddHandler("test_func1", "Test function", handler))
+	assert.Nil(t, executor.AddHandler("test_func2", "Test function", handler))
+
+	assert.Nil(t, executor.addFunctions(context.Background()))
+	assert.Len(t, client.functions, 2)
+	assert.Equal(t, "test_func2", client.functions[1].FuncName)
+	assert.Equal(t, executor.ExecutorID(), client.functions[1].ExecutorID)
+
+	// Running again should not add the functions twice
+	assert.Nil(t, executor.addFunctions(context.Background()))
+	assert.Len(t, client.functions, 2)
+}
+
+func TestExecutorRun(t *testing.T) {
+	client := createClientMock()
+	executor := createTestExecutor(t, client)
+	client.assignErrs = []error{&url.Error{Op: "Post", URL: "https://localhost/api", Err: errors.New("connection refused")}, errors.New("Some error")}
+
+	assert.Nil(t, executor.AddHandler("echo", "Echo args", func(ctx context.Context, process *core.Process) ([]interface{}, error) {
+		return process.FunctionSpec.Args, nil
+	}))
+	assert.Nil(t, executor.AddHandler("empty", "No output", func(ctx context.Context, process *core.Process) ([]interface{}, error) {
+		return nil, nil
+	}))
+	assert.Nil(t, executor.AddHandler("fail", "Always fails", func(ctx context.Context, process *core.Process) ([]interface{}, error) {
+		return nil, errors.New("error")
+	}))
+	assert.Nil(t, executor.AddHandler("panic", "Always panics", func(ctx context.Context, process *core.Process) ([]interface{}, error) {
+		panic("test panic")
+	}))
+
+	echoProcess := createTestProcess("echo")
+	echoProcess.FunctionSpec.Args = []interface{}{"arg"}
+	emptyProcess := createTestProcess("empty")
+	failProcess := createTestProcess("fail")
+	panicProcess := createTestProcess("panic")
+	unknownProcess := createTestProcess("unknown")
+	client.processes <- echoProcess
+	client.processes <- emptyProcess
+	client.processes <- failProcess
+	client.processes <- panicProcess
+	client.processes <- unknownProcess
+
+	cancel, errChan := runTestExecutor(executor)
+	client.waitForClosed(t, 5)
+	cancel()
+	assert.Nil(t, <-errChan)
+
+	closed := client.getClosed(echoProcess.ID)
+	assert.False(t, closed.failed)
+	assert.Equal(t, []interface{}{"arg"}, closed.output)
+
+	closed = client.getClosed(emptyProcess.ID)
+	assert.False(t, closed.failed)
+	assert.Len(t, closed.output, 0)
+
+	closed = client.getClosed(failProcess.ID)
+	assert.True(t, closed.failed)
+	assert.Equal(t, []string{"error"}, closed.errs)
+
+	closed = client.getClosed(panicProcess.ID)
+	assert.True(t, closed.failed)
+	assert.Contains(t, closed.errs[0], "test panic")
+
+	closed = client.getClosed(unknownProcess.ID)
+	assert.True(t, closed.failed)
+	assert.Contains(t, closed.errs[0], "unknown")
+}
+
+func TestExecutorConcurrency(t *testing.T) {
+	client := createClientMock()
+	executor := createTestExecutor(t, client)
+	assert.Nil(t, executor.SetConcurrency(2))
+
+	var mutex sync.Mutex
+	running := 0
+	maxRunning := 0
+	assert.Nil(t, executor.AddHandler("sleep", "Sleep", func(ctx context.Context, process *core.Process) ([]interface{}, error) {
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+		time.Sleep(50 * time.Millisecond)
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+		return nil, nil
+	}))
+
+	for i := 0; i < 6; i++ {
+		client.processes <- createTestProcess("sleep")
+	}
+
+	cancel, errChan := runTestExecutor(executor)
+	client.waitForClosed(t, 6)
+	cancel()
+	assert.Nil(t, <-errChan)
+
+	assert.Equal(t, 2, maxRunning)
+}
+
+func TestExecutorGracefulShutdown(t *testing.T) {
+	client := createClientMock()
+	executor := createTestExecutor(t, client)
+
+	started := make(chan bool)
+	assert.Nil(t, executor.AddHandler("sleep", "Sleep", func(ctx context.Context, process *core.Process) ([]interface{}, error) {
+		started <- true
+		time.Sleep(100 * time.Millisecond)
+		return []interface{}{"done"}, ctx.Err()
+	}))
+
+	process := createTestProcess("sleep")
+	client.processes <- process
+
+	cancel, errChan := runTestExecutor(executor)
+	<-started
+	cancel()
+	assert.Nil(t, <-errChan)
+
+	// The running handler should be allowed to finish
+	closed := client.getClosed(process.ID)
+	assert.NotNil(t, closed)
+	assert.False(t, closed.failed)
+	assert.Equal(t, []interface{}{"done"}, closed.output)
+}
+
+func TestExecutorShutdownTimeout(t *testing.T) {
+	client := createClientMock()
+	executor := createTestExecutor(t, client)
+	executor.SetShutdownTimeout(10 * time.Millisecond)
+
+	started := make(chan bool)
+	assert.Nil(t, executor.AddHandler("block", "Block until cancelled", func(ctx context.Context, process *core.Process) ([]interface{}, error) {
+		started <- true
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}))
+
+	process := createTestProcess("block")
+	client.processes <- process
+
+	cancel, errChan := runTestExecutor(executor)
+	<-started
+	cancel()
+	assert.Nil(t, <-errChan)
+
+	closed := client.getClosed(process.ID)
+	assert.NotNil(t, closed)
+	assert.True(t, closed.failed)
+}
+
+func TestCreateExecutorWithID(t *testing.T) {
+	prvKey, err := crypto.CreateCrypto().GeneratePrivateKey()
+	assert.Nil(t, err)
+
+	// After a key rotation, the Executor Id is no longer derived from the private key
+	executorID := core.GenerateRandomID()
+	executor, err := CreateExecutorWithID(nil, core.GenerateRandomID(), executorID, prvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, executor.ExecutorID(), executorID)
+
+	_, err = CreateExecutorWithID(nil, core.GenerateRandomID(), "", prvKey)
+	assert.NotNil(t, err)
+
+	executor, err = CreateExecutor(nil, core.GenerateRandomID(), prvKey)
+	assert.Nil(t, err)
+	derivedID, err := crypto.CreateCrypto().GenerateID(prvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, executor.ExecutorID(), derivedID)
+}
@@ -1,7 +1,6 @@
 package basic
 
 import (
-	"errors"
 	"fmt"
 	"sort"
 
@@ -39,7 +38,7 @@ func (planner *BasicPlanner) printCandidates(candidates []*core.Process) {
 func (planner *BasicPlanner) Select(executorID string, candidates []*core.Process) (*core.Process, error) {
 	prioritizedProcesses := planner.Prioritize(executorID, candidates, 1)
 	if len(prioritizedProcesses) < 1 {
-		return nil, errors.New("No processes can be selected for executor with Id <" + executorID + ">")
+		return nil, core.CreateError(core.ERROR_NO_WORK_AVAILABLE, "No processes can be selected for executor with Id <"+executorID+">")
 	}
 
 	return prioritizedProcesses[0], nil
@@ -0,0 +1,78 @@
+package rpc
+
+import (
+	"encoding/json"
+)
+
+const AddArtifactPayloadType = "addartifactmsg"
+
+// ArtifactMsgHeader is the HTTP header used to send signed RPC messages to the artifact endpoint, the content of the
+// artifact is sent in the request or reply body
+const ArtifactMsgHeader = "Colonies-Rpc-Msg"
+
+type AddArtifactMsg struct {
+	ColonyID string `json:"colonyid"`
+	Name     string `json:"name"`
+	Hash     string `json:"hash"`
+	Size     int64  `json:"size"`
+	Archive  bool   `json:"archive"`
+	MsgType  string `json:"msgtype"`
+}
+
+func CreateAddArtifactMsg(colonyID string, name string, hash string, size int64, archive bool) *AddArtifactMsg {
+	msg := &AddArtifactMsg{}
+	msg.ColonyID = colonyID
+	msg.Name = name
+	msg.Hash = hash
+	msg.Size = size
+	msg.Archive = archive
+	msg.MsgType = AddArtifactPayloadType
+
+	return msg
+}
+
+func (msg *AddArtifactMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *AddArtifactMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *AddArtifactMsg) Equals(msg2 *AddArtifactMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType &&
+		msg.ColonyID == msg2.ColonyID &&
+		msg.Name == msg2.Name &&
+		msg.Hash == msg2.Hash &&
+		msg.Size == msg2.Size &&
+		msg.Archive == msg2.Archive {
+		return true
+	}
+
+	return false
+}
+
+func CreateAddArtifactMsgFromJSON(jsonString string) (*AddArtifactMsg, error) {
+	var msg *AddArtifactMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCAddArtifactMsg(t *testing.T) {
+	msg := CreateAddArtifactMsg(core.GenerateRandomID(), "model.bin", core.GenerateRandomID(), 1024, false)
+	jsonString, err := msg.ToJSON()
+	assert.Nil(t, err)
+
+	msg2, err := CreateAddArtifactMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateAddArtifactMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCAddArtifactMsgIndent(t *testing.T) {
+	msg := CreateAddArtifactMsg(core.GenerateRandomID(), "model.bin", core.GenerateRandomID(), 1024, false)
+	jsonString, err := msg.ToJSONIndent()
+	assert.Nil(t, err)
+
+	msg2, err := CreateAddArtifactMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateAddArtifactMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCAddArtifactMsgEquals(t *testing.T) {
+	msg := CreateAddArtifactMsg(core.GenerateRandomID(), "model.bin", core.GenerateRandomID(), 1024, false)
+	assert.True(t, msg.Equals(msg))
+	assert.False(t, msg.Equals(nil))
+}
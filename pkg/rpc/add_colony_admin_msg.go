@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"encoding/json"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+const AddColonyAdminPayloadType = "addcolonyadminmsg"
+
+type AddColonyAdminMsg struct {
+	ColonyAdmin *core.ColonyAdmin `json:"colonyadmin"`
+	MsgType     string            `json:"msgtype"`
+}
+
+func CreateAddColonyAdminMsg(admin *core.ColonyAdmin) *AddColonyAdminMsg {
+	msg := &AddColonyAdminMsg{}
+	msg.ColonyAdmin = admin
+	msg.MsgType = AddColonyAdminPayloadType
+
+	return msg
+}
+
+func (msg *AddColonyAdminMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *AddColonyAdminMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *AddColonyAdminMsg) Equals(msg2 *AddColonyAdminMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType && msg.ColonyAdmin.Equals(msg2.ColonyAdmin) {
+		return true
+	}
+
+	return false
+}
+
+func CreateAddColonyAdminMsgFromJSON(jsonString string) (*AddColonyAdminMsg, error) {
+	var msg *AddColonyAdminMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
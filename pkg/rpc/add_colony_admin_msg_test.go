@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCAddColonyAdminMsg(t *testing.T) {
+	admin := core.CreateColonyAdmin(core.GenerateRandomID(), core.GenerateRandomID(), "alice")
+	msg := CreateAddColonyAdminMsg(admin)
+	jsonString, err := msg.ToJSON()
+	assert.Nil(t, err)
+
+	msg2, err := CreateAddColonyAdminMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateAddColonyAdminMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCAddColonyAdminMsgIndent(t *testing.T) {
+	admin := core.CreateColonyAdmin(core.GenerateRandomID(), core.GenerateRandomID(), "alice")
+	msg := CreateAddColonyAdminMsg(admin)
+	jsonString, err := msg.ToJSONIndent()
+	assert.Nil(t, err)
+
+	msg2, err := CreateAddColonyAdminMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateAddColonyAdminMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCAddColonyAdminMsgEquals(t *testing.T) {
+	admin := core.CreateColonyAdmin(core.GenerateRandomID(), core.GenerateRandomID(), "alice")
+	msg := CreateAddColonyAdminMsg(admin)
+	assert.True(t, msg.Equals(msg))
+	assert.False(t, msg.Equals(nil))
+}
@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"encoding/json"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+const AddSecretPayloadType = "addsecretmsg"
+
+type AddSecretMsg struct {
+	Secret  *core.Secret `json:"secret"`
+	MsgType string       `json:"msgtype"`
+}
+
+func CreateAddSecretMsg(secret *core.Secret) *AddSecretMsg {
+	msg := &AddSecretMsg{}
+	msg.Secret = secret
+	msg.MsgType = AddSecretPayloadType
+
+	return msg
+}
+
+func (msg *AddSecretMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *AddSecretMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *AddSecretMsg) Equals(msg2 *AddSecretMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType && msg.Secret.Equals(msg2.Secret) {
+		return true
+	}
+
+	return false
+}
+
+func CreateAddSecretMsgFromJSON(jsonString string) (*AddSecretMsg, error) {
+	var msg *AddSecretMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCAddSecretMsg(t *testing.T) {
+	secret := core.CreateSecret(core.GenerateRandomID(), "db-password", "password", []string{"test_executor_type"})
+	msg := CreateAddSecretMsg(secret)
+	jsonString, err := msg.ToJSON()
+	assert.Nil(t, err)
+
+	msg2, err := CreateAddSecretMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateAddSecretMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCAddSecretMsgIndent(t *testing.T) {
+	secret := core.CreateSecret(core.GenerateRandomID(), "db-password", "password", []string{"test_executor_type"})
+	msg := CreateAddSecretMsg(secret)
+	jsonString, err := msg.ToJSONIndent()
+	assert.Nil(t, err)
+
+	msg2, err := CreateAddSecretMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateAddSecretMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCAddSecretMsgEquals(t *testing.T) {
+	secret := core.CreateSecret(core.GenerateRandomID(), "db-password", "password", []string{"test_executor_type"})
+	msg := CreateAddSecretMsg(secret)
+	assert.True(t, msg.Equals(msg))
+	assert.False(t, msg.Equals(nil))
+}
@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCAddWebhookMsg(t *testing.T) {
+	webhook := core.CreateWebhook(core.GenerateRandomID(), "https://example.com/hook", "secret", []string{core.PROCESS_KIND}, nil)
+	msg := CreateAddWebhookMsg(webhook)
+	jsonString, err := msg.ToJSON()
+	assert.Nil(t, err)
+
+	msg2, err := CreateAddWebhookMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateAddWebhookMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCAddWebhookMsgIndent(t *testing.T) {
+	webhook := core.CreateWebhook(core.GenerateRandomID(), "https://example.com/hook", "secret", []string{core.PROCESS_KIND}, nil)
+	msg := CreateAddWebhookMsg(webhook)
+	jsonString, err := msg.ToJSONIndent()
+	assert.Nil(t, err)
+
+	msg2, err := CreateAddWebhookMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateAddWebhookMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCAddWebhookMsgEquals(t *testing.T) {
+	webhook := core.CreateWebhook(core.GenerateRandomID(), "https://example.com/hook", "secret", []string{core.PROCESS_KIND}, nil)
+	msg := CreateAddWebhookMsg(webhook)
+	assert.True(t, msg.Equals(msg))
+	assert.False(t, msg.Equals(nil))
+}
@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"encoding/json"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+const AddWorkflowTemplatePayloadType = "addworkflowtemplatemsg"
+
+type AddWorkflowTemplateMsg struct {
+	WorkflowTemplate *core.WorkflowTemplate `json:"workflowtemplate"`
+	MsgType          string                 `json:"msgtype"`
+}
+
+func CreateAddWorkflowTemplateMsg(template *core.WorkflowTemplate) *AddWorkflowTemplateMsg {
+	msg := &AddWorkflowTemplateMsg{}
+	msg.WorkflowTemplate = template
+	msg.MsgType = AddWorkflowTemplatePayloadType
+
+	return msg
+}
+
+func (msg *AddWorkflowTemplateMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *AddWorkflowTemplateMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *AddWorkflowTemplateMsg) Equals(msg2 *AddWorkflowTemplateMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType && msg.WorkflowTemplate.Equals(msg2.WorkflowTemplate) {
+		return true
+	}
+
+	return false
+}
+
+func CreateAddWorkflowTemplateMsgFromJSON(jsonString string) (*AddWorkflowTemplateMsg, error) {
+	var msg *AddWorkflowTemplateMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
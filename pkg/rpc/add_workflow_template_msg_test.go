@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestWorkflowTemplate() *core.WorkflowTemplate {
+	colonyID := core.GenerateRandomID()
+	funcSpec := core.CreateEmptyFunctionSpec()
+	funcSpec.NodeName = "task"
+	workflowSpec := core.CreateWorkflowSpec(colonyID)
+	workflowSpec.AddFunctionSpec(funcSpec)
+	parameters := []core.TemplateParameter{{Name: "arg", Default: "test_arg"}}
+
+	return core.CreateWorkflowTemplate(colonyID, "test_template", parameters, workflowSpec)
+}
+
+func TestRPCAddWorkflowTemplateMsg(t *testing.T) {
+	msg := CreateAddWorkflowTemplateMsg(createTestWorkflowTemplate())
+	jsonString, err := msg.ToJSON()
+	assert.Nil(t, err)
+
+	msg2, err := CreateAddWorkflowTemplateMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateAddWorkflowTemplateMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCAddWorkflowTemplateMsgIndent(t *testing.T) {
+	msg := CreateAddWorkflowTemplateMsg(createTestWorkflowTemplate())
+	jsonString, err := msg.ToJSONIndent()
+	assert.Nil(t, err)
+
+	msg2, err := CreateAddWorkflowTemplateMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateAddWorkflowTemplateMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCAddWorkflowTemplateMsgEquals(t *testing.T) {
+	msg := CreateAddWorkflowTemplateMsg(createTestWorkflowTemplate())
+	assert.True(t, msg.Equals(msg))
+	assert.False(t, msg.Equals(nil))
+}
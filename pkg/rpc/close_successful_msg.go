@@ -7,9 +7,10 @@ import (
 const CloseSuccessfulPayloadType = "closesuccessfulmsg"
 
 type CloseSuccessfulMsg struct {
-	ProcessID string        `json:"processid"`
-	MsgType   string        `json:"msgtype"`
-	Output    []interface{} `json:"out"`
+	ProcessID   string                 `json:"processid"`
+	MsgType     string                 `json:"msgtype"`
+	Output      []interface{}          `json:"out"`
+	NamedOutput map[string]interface{} `json:"namedout"`
 }
 
 func CreateCloseSuccessfulMsg(processID string) *CloseSuccessfulMsg {
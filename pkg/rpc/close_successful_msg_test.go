@@ -21,6 +21,19 @@ func TestRPCCloseSuccessfulMsg(t *testing.T) {
 	assert.True(t, msg.Equals(msg2))
 }
 
+func TestRPCCloseSuccessfulMsgWithNamedOutput(t *testing.T) {
+	msg := CreateCloseSuccessfulMsg(core.GenerateRandomID())
+	msg.NamedOutput = map[string]interface{}{"model": "model.bin", "accuracy": 0.9}
+	jsonString, err := msg.ToJSON()
+	assert.Nil(t, err)
+
+	msg2, err := CreateCloseSuccessfulMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+	assert.Equal(t, msg.NamedOutput, msg2.NamedOutput)
+}
+
 func TestRPCCloseSuccessfulMsgWithResult(t *testing.T) {
 	msg := CreateCloseSuccessfulMsg(core.GenerateRandomID())
 	msg.Output = make([]interface{}, 2)
@@ -0,0 +1,61 @@
+package rpc
+
+import (
+	"encoding/json"
+)
+
+const DeleteArtifactPayloadType = "deleteartifactmsg"
+
+type DeleteArtifactMsg struct {
+	ArtifactID string `json:"artifactid"`
+	MsgType    string `json:"msgtype"`
+}
+
+func CreateDeleteArtifactMsg(artifactID string) *DeleteArtifactMsg {
+	msg := &DeleteArtifactMsg{}
+	msg.ArtifactID = artifactID
+	msg.MsgType = DeleteArtifactPayloadType
+
+	return msg
+}
+
+func (msg *DeleteArtifactMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *DeleteArtifactMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *DeleteArtifactMsg) Equals(msg2 *DeleteArtifactMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType && msg.ArtifactID == msg2.ArtifactID {
+		return true
+	}
+
+	return false
+}
+
+func CreateDeleteArtifactMsgFromJSON(jsonString string) (*DeleteArtifactMsg, error) {
+	var msg *DeleteArtifactMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"encoding/json"
+)
+
+const DeleteColonyAdminPayloadType = "deletecolonyadminmsg"
+
+type DeleteColonyAdminMsg struct {
+	ColonyID string `json:"colonyid"`
+	AdminID  string `json:"adminid"`
+	MsgType  string `json:"msgtype"`
+}
+
+func CreateDeleteColonyAdminMsg(colonyID string, adminID string) *DeleteColonyAdminMsg {
+	msg := &DeleteColonyAdminMsg{}
+	msg.ColonyID = colonyID
+	msg.AdminID = adminID
+	msg.MsgType = DeleteColonyAdminPayloadType
+
+	return msg
+}
+
+func (msg *DeleteColonyAdminMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *DeleteColonyAdminMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *DeleteColonyAdminMsg) Equals(msg2 *DeleteColonyAdminMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType && msg.ColonyID == msg2.ColonyID && msg.AdminID == msg2.AdminID {
+		return true
+	}
+
+	return false
+}
+
+func CreateDeleteColonyAdminMsgFromJSON(jsonString string) (*DeleteColonyAdminMsg, error) {
+	var msg *DeleteColonyAdminMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCDeleteSecretMsg(t *testing.T) {
+	msg := CreateDeleteSecretMsg(core.GenerateRandomID(), "db-password")
+	jsonString, err := msg.ToJSON()
+	assert.Nil(t, err)
+
+	msg2, err := CreateDeleteSecretMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateDeleteSecretMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCDeleteSecretMsgIndent(t *testing.T) {
+	msg := CreateDeleteSecretMsg(core.GenerateRandomID(), "db-password")
+	jsonString, err := msg.ToJSONIndent()
+	assert.Nil(t, err)
+
+	msg2, err := CreateDeleteSecretMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateDeleteSecretMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCDeleteSecretMsgEquals(t *testing.T) {
+	msg := CreateDeleteSecretMsg(core.GenerateRandomID(), "db-password")
+	assert.True(t, msg.Equals(msg))
+	assert.False(t, msg.Equals(nil))
+}
@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCDeleteWorkflowTemplateMsg(t *testing.T) {
+	msg := CreateDeleteWorkflowTemplateMsg(core.GenerateRandomID(), "test_template", 1)
+	jsonString, err := msg.ToJSON()
+	assert.Nil(t, err)
+
+	msg2, err := CreateDeleteWorkflowTemplateMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateDeleteWorkflowTemplateMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCDeleteWorkflowTemplateMsgIndent(t *testing.T) {
+	msg := CreateDeleteWorkflowTemplateMsg(core.GenerateRandomID(), "test_template", 1)
+	jsonString, err := msg.ToJSONIndent()
+	assert.Nil(t, err)
+
+	msg2, err := CreateDeleteWorkflowTemplateMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateDeleteWorkflowTemplateMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCDeleteWorkflowTemplateMsgEquals(t *testing.T) {
+	msg := CreateDeleteWorkflowTemplateMsg(core.GenerateRandomID(), "test_template", 1)
+	assert.True(t, msg.Equals(msg))
+	assert.False(t, msg.Equals(nil))
+}
@@ -0,0 +1,61 @@
+package rpc
+
+import (
+	"encoding/json"
+)
+
+const DownloadArtifactPayloadType = "downloadartifactmsg"
+
+type DownloadArtifactMsg struct {
+	ArtifactID string `json:"artifactid"`
+	MsgType    string `json:"msgtype"`
+}
+
+func CreateDownloadArtifactMsg(artifactID string) *DownloadArtifactMsg {
+	msg := &DownloadArtifactMsg{}
+	msg.ArtifactID = artifactID
+	msg.MsgType = DownloadArtifactPayloadType
+
+	return msg
+}
+
+func (msg *DownloadArtifactMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *DownloadArtifactMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *DownloadArtifactMsg) Equals(msg2 *DownloadArtifactMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType && msg.ArtifactID == msg2.ArtifactID {
+		return true
+	}
+
+	return false
+}
+
+func CreateDownloadArtifactMsgFromJSON(jsonString string) (*DownloadArtifactMsg, error) {
+	var msg *DownloadArtifactMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,61 @@
+package rpc
+
+import (
+	"encoding/json"
+)
+
+const GetArtifactPayloadType = "getartifactmsg"
+
+type GetArtifactMsg struct {
+	ArtifactID string `json:"artifactid"`
+	MsgType    string `json:"msgtype"`
+}
+
+func CreateGetArtifactMsg(artifactID string) *GetArtifactMsg {
+	msg := &GetArtifactMsg{}
+	msg.ArtifactID = artifactID
+	msg.MsgType = GetArtifactPayloadType
+
+	return msg
+}
+
+func (msg *GetArtifactMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *GetArtifactMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *GetArtifactMsg) Equals(msg2 *GetArtifactMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType && msg.ArtifactID == msg2.ArtifactID {
+		return true
+	}
+
+	return false
+}
+
+func CreateGetArtifactMsgFromJSON(jsonString string) (*GetArtifactMsg, error) {
+	var msg *GetArtifactMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"encoding/json"
+)
+
+const GetArtifactsPayloadType = "getartifactsmsg"
+
+type GetArtifactsMsg struct {
+	ColonyID string `json:"colonyid"`
+	Count    int    `json:"count"`
+	MsgType  string `json:"msgtype"`
+}
+
+func CreateGetArtifactsMsg(colonyID string, count int) *GetArtifactsMsg {
+	msg := &GetArtifactsMsg{}
+	msg.ColonyID = colonyID
+	msg.Count = count
+	msg.MsgType = GetArtifactsPayloadType
+
+	return msg
+}
+
+func (msg *GetArtifactsMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *GetArtifactsMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *GetArtifactsMsg) Equals(msg2 *GetArtifactsMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType &&
+		msg.ColonyID == msg2.ColonyID &&
+		msg.Count == msg2.Count {
+		return true
+	}
+
+	return false
+}
+
+func CreateGetArtifactsMsgFromJSON(jsonString string) (*GetArtifactsMsg, error) {
+	var msg *GetArtifactsMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
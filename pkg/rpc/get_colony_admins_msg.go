@@ -0,0 +1,61 @@
+package rpc
+
+import (
+	"encoding/json"
+)
+
+const GetColonyAdminsPayloadType = "getcolonyadminsmsg"
+
+type GetColonyAdminsMsg struct {
+	ColonyID string `json:"colonyid"`
+	MsgType  string `json:"msgtype"`
+}
+
+func CreateGetColonyAdminsMsg(colonyID string) *GetColonyAdminsMsg {
+	msg := &GetColonyAdminsMsg{}
+	msg.ColonyID = colonyID
+	msg.MsgType = GetColonyAdminsPayloadType
+
+	return msg
+}
+
+func (msg *GetColonyAdminsMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *GetColonyAdminsMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *GetColonyAdminsMsg) Equals(msg2 *GetColonyAdminsMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType && msg.ColonyID == msg2.ColonyID {
+		return true
+	}
+
+	return false
+}
+
+func CreateGetColonyAdminsMsgFromJSON(jsonString string) (*GetColonyAdminsMsg, error) {
+	var msg *GetColonyAdminsMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,70 @@
+package rpc
+
+import (
+	"encoding/json"
+)
+
+const GetLogsPayloadType = "getlogsmsg"
+
+type GetLogsMsg struct {
+	ProcessID string `json:"processid"`
+	Offset    int64  `json:"offset"`
+	Count     int    `json:"count"`
+	Timeout   int    `json:"timeout"`
+	MsgType   string `json:"msgtype"`
+}
+
+func CreateGetLogsMsg(processID string, offset int64, count int, timeout int) *GetLogsMsg {
+	msg := &GetLogsMsg{}
+	msg.ProcessID = processID
+	msg.Offset = offset
+	msg.Count = count
+	msg.Timeout = timeout
+	msg.MsgType = GetLogsPayloadType
+
+	return msg
+}
+
+func (msg *GetLogsMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *GetLogsMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *GetLogsMsg) Equals(msg2 *GetLogsMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType && msg.ProcessID == msg2.ProcessID &&
+		msg.Offset == msg2.Offset &&
+		msg.Count == msg2.Count &&
+		msg.Timeout == msg2.Timeout {
+		return true
+	}
+
+	return false
+}
+
+func CreateGetLogsMsgFromJSON(jsonString string) (*GetLogsMsg, error) {
+	var msg *GetLogsMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,61 @@
+package rpc
+
+import (
+	"encoding/json"
+)
+
+const GetProcessEventsPayloadType = "getprocesseventsmsg"
+
+type GetProcessEventsMsg struct {
+	ProcessID string `json:"processid"`
+	MsgType   string `json:"msgtype"`
+}
+
+func CreateGetProcessEventsMsg(processID string) *GetProcessEventsMsg {
+	msg := &GetProcessEventsMsg{}
+	msg.ProcessID = processID
+	msg.MsgType = GetProcessEventsPayloadType
+
+	return msg
+}
+
+func (msg *GetProcessEventsMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *GetProcessEventsMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *GetProcessEventsMsg) Equals(msg2 *GetProcessEventsMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType && msg.ProcessID == msg2.ProcessID {
+		return true
+	}
+
+	return false
+}
+
+func CreateGetProcessEventsMsgFromJSON(jsonString string) (*GetProcessEventsMsg, error) {
+	var msg *GetProcessEventsMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"encoding/json"
+)
+
+const GetRunHistoryPayloadType = "getrunhistorymsg"
+
+type GetRunHistoryMsg struct {
+	TriggerID string `json:"triggerid"`
+	Count     int    `json:"count"`
+	MsgType   string `json:"msgtype"`
+}
+
+func CreateGetRunHistoryMsg(triggerID string, count int) *GetRunHistoryMsg {
+	msg := &GetRunHistoryMsg{}
+	msg.TriggerID = triggerID
+	msg.Count = count
+	msg.MsgType = GetRunHistoryPayloadType
+
+	return msg
+}
+
+func (msg *GetRunHistoryMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *GetRunHistoryMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *GetRunHistoryMsg) Equals(msg2 *GetRunHistoryMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType &&
+		msg.TriggerID == msg2.TriggerID &&
+		msg.Count == msg2.Count {
+		return true
+	}
+
+	return false
+}
+
+func CreateGetRunHistoryMsgFromJSON(jsonString string) (*GetRunHistoryMsg, error) {
+	var msg *GetRunHistoryMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
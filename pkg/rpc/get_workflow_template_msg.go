@@ -0,0 +1,68 @@
+package rpc
+
+import (
+	"encoding/json"
+)
+
+const GetWorkflowTemplatePayloadType = "getworkflowtemplatemsg"
+
+type GetWorkflowTemplateMsg struct {
+	ColonyID string `json:"colonyid"`
+	Name     string `json:"name"`
+	Version  int    `json:"version"`
+	MsgType  string `json:"msgtype"`
+}
+
+func CreateGetWorkflowTemplateMsg(colonyID string, name string, version int) *GetWorkflowTemplateMsg {
+	msg := &GetWorkflowTemplateMsg{}
+	msg.ColonyID = colonyID
+	msg.Name = name
+	msg.Version = version
+	msg.MsgType = GetWorkflowTemplatePayloadType
+
+	return msg
+}
+
+func (msg *GetWorkflowTemplateMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *GetWorkflowTemplateMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *GetWorkflowTemplateMsg) Equals(msg2 *GetWorkflowTemplateMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType &&
+		msg.ColonyID == msg2.ColonyID &&
+		msg.Name == msg2.Name &&
+		msg.Version == msg2.Version {
+		return true
+	}
+
+	return false
+}
+
+func CreateGetWorkflowTemplateMsgFromJSON(jsonString string) (*GetWorkflowTemplateMsg, error) {
+	var msg *GetWorkflowTemplateMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
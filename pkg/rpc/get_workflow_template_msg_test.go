@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCGetWorkflowTemplateMsg(t *testing.T) {
+	msg := CreateGetWorkflowTemplateMsg(core.GenerateRandomID(), "test_template", 1)
+	jsonString, err := msg.ToJSON()
+	assert.Nil(t, err)
+
+	msg2, err := CreateGetWorkflowTemplateMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateGetWorkflowTemplateMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCGetWorkflowTemplateMsgIndent(t *testing.T) {
+	msg := CreateGetWorkflowTemplateMsg(core.GenerateRandomID(), "test_template", 1)
+	jsonString, err := msg.ToJSONIndent()
+	assert.Nil(t, err)
+
+	msg2, err := CreateGetWorkflowTemplateMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateGetWorkflowTemplateMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCGetWorkflowTemplateMsgEquals(t *testing.T) {
+	msg := CreateGetWorkflowTemplateMsg(core.GenerateRandomID(), "test_template", 1)
+	assert.True(t, msg.Equals(msg))
+	assert.False(t, msg.Equals(nil))
+}
@@ -0,0 +1,61 @@
+package rpc
+
+import (
+	"encoding/json"
+)
+
+const RetryProcessGraphPayloadType = "retryprocessgraphmsg"
+
+type RetryProcessGraphMsg struct {
+	ProcessGraphID string `json:"processgraphid"`
+	MsgType        string `json:"msgtype"`
+}
+
+func CreateRetryProcessGraphMsg(processGraphID string) *RetryProcessGraphMsg {
+	msg := &RetryProcessGraphMsg{}
+	msg.ProcessGraphID = processGraphID
+	msg.MsgType = RetryProcessGraphPayloadType
+
+	return msg
+}
+
+func (msg *RetryProcessGraphMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *RetryProcessGraphMsg) Equals(msg2 *RetryProcessGraphMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType && msg.ProcessGraphID == msg2.ProcessGraphID {
+		return true
+	}
+
+	return false
+}
+
+func (msg *RetryProcessGraphMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func CreateRetryProcessGraphMsgFromJSON(jsonString string) (*RetryProcessGraphMsg, error) {
+	var msg *RetryProcessGraphMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,71 @@
+package rpc
+
+import (
+	"encoding/json"
+)
+
+const RotateKeyPayloadType = "rotatekeymsg"
+
+// RotateKeyMsg rotates the private key of a colony, or of an executor if ExecutorID is set. The message is signed
+// with the current private key and NewKeySignature is the signature of core.KeyRotationProof signed with the new
+// private key.
+type RotateKeyMsg struct {
+	ColonyID        string `json:"colonyid"`
+	ExecutorID      string `json:"executorid"`
+	NewKeySignature string `json:"newkeysignature"`
+	MsgType         string `json:"msgtype"`
+}
+
+func CreateRotateKeyMsg(colonyID string, executorID string, newKeySignature string) *RotateKeyMsg {
+	msg := &RotateKeyMsg{}
+	msg.ColonyID = colonyID
+	msg.ExecutorID = executorID
+	msg.NewKeySignature = newKeySignature
+	msg.MsgType = RotateKeyPayloadType
+
+	return msg
+}
+
+func (msg *RotateKeyMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *RotateKeyMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *RotateKeyMsg) Equals(msg2 *RotateKeyMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType &&
+		msg.ColonyID == msg2.ColonyID &&
+		msg.ExecutorID == msg2.ExecutorID &&
+		msg.NewKeySignature == msg2.NewKeySignature {
+		return true
+	}
+
+	return false
+}
+
+func CreateRotateKeyMsgFromJSON(jsonString string) (*RotateKeyMsg, error) {
+	var msg *RotateKeyMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCRotateKeyMsg(t *testing.T) {
+	msg := CreateRotateKeyMsg(core.GenerateRandomID(), core.GenerateRandomID(), "signature")
+	jsonString, err := msg.ToJSON()
+	assert.Nil(t, err)
+
+	msg2, err := CreateRotateKeyMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateRotateKeyMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCRotateKeyMsgIndent(t *testing.T) {
+	msg := CreateRotateKeyMsg(core.GenerateRandomID(), core.GenerateRandomID(), "signature")
+	jsonString, err := msg.ToJSONIndent()
+	assert.Nil(t, err)
+
+	msg2, err := CreateRotateKeyMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateRotateKeyMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCRotateKeyMsgEquals(t *testing.T) {
+	msg := CreateRotateKeyMsg(core.GenerateRandomID(), core.GenerateRandomID(), "signature")
+	assert.True(t, msg.Equals(msg))
+	assert.False(t, msg.Equals(nil))
+}
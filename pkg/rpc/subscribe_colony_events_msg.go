@@ -0,0 +1,91 @@
+package rpc
+
+import (
+	"encoding/json"
+)
+
+const SubscribeColonyEventsPayloadType = "subscribecolonyeventsmsg"
+
+type SubscribeColonyEventsMsg struct {
+	ColonyID string   `json:"colonyid"`
+	Kinds    []string `json:"kinds"`
+	Types    []string `json:"types"`
+	Since    int64    `json:"since"`
+	Timeout  int      `json:"timeout"`
+	MsgType  string   `json:"msgtype"`
+}
+
+// CreateSubscribeColonyEventsMsg creates a msg to subscribe to all events in a colony, kinds and types are optional
+// filters, e.g. kinds=["executor"] only subscribes to executor events. If since is set, stored events with a sequence
+// number greater than since are sent before new events, which makes it possible to resume a subscription.
+func CreateSubscribeColonyEventsMsg(colonyID string, kinds []string, types []string, since int64, timeout int) *SubscribeColonyEventsMsg {
+	msg := &SubscribeColonyEventsMsg{}
+	msg.ColonyID = colonyID
+	msg.Kinds = kinds
+	msg.Types = types
+	msg.Since = since
+	msg.Timeout = timeout
+	msg.MsgType = SubscribeColonyEventsPayloadType
+
+	return msg
+}
+
+func (msg *SubscribeColonyEventsMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *SubscribeColonyEventsMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func isStringArraysEqual(array1 []string, array2 []string) bool {
+	if len(array1) != len(array2) {
+		return false
+	}
+
+	for i := range array1 {
+		if array1[i] != array2[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (msg *SubscribeColonyEventsMsg) Equals(msg2 *SubscribeColonyEventsMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType &&
+		msg.ColonyID == msg2.ColonyID &&
+		isStringArraysEqual(msg.Kinds, msg2.Kinds) &&
+		isStringArraysEqual(msg.Types, msg2.Types) &&
+		msg.Since == msg2.Since &&
+		msg.Timeout == msg2.Timeout {
+		return true
+	}
+
+	return false
+}
+
+func CreateSubscribeColonyEventsMsgFromJSON(jsonString string) (*SubscribeColonyEventsMsg, error) {
+	var msg *SubscribeColonyEventsMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
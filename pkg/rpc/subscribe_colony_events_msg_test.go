@@ -0,0 +1,48 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCSubscribeColonyEventsMsg(t *testing.T) {
+	msg := CreateSubscribeColonyEventsMsg(core.GenerateRandomID(), []string{core.PROCESS_KIND}, []string{core.SUBMITTED_EVENT}, 42, 2)
+	jsonString, err := msg.ToJSON()
+	assert.Nil(t, err)
+
+	msg2, err := CreateSubscribeColonyEventsMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateSubscribeColonyEventsMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCSubscribeColonyEventsMsgIndent(t *testing.T) {
+	msg := CreateSubscribeColonyEventsMsg(core.GenerateRandomID(), []string{core.PROCESS_KIND}, []string{core.SUBMITTED_EVENT}, 42, 2)
+	jsonString, err := msg.ToJSONIndent()
+	assert.Nil(t, err)
+
+	msg2, err := CreateSubscribeColonyEventsMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateSubscribeColonyEventsMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCSubscribeColonyEventsMsgEquals(t *testing.T) {
+	msg := CreateSubscribeColonyEventsMsg(core.GenerateRandomID(), []string{core.PROCESS_KIND}, []string{core.SUBMITTED_EVENT}, 42, 2)
+	assert.True(t, msg.Equals(msg))
+	assert.False(t, msg.Equals(nil))
+
+	msg2 := CreateSubscribeColonyEventsMsg(msg.ColonyID, []string{core.EXECUTOR_KIND}, msg.Types, msg.Since, msg.Timeout)
+	assert.False(t, msg.Equals(msg2))
+
+	msg3 := CreateSubscribeColonyEventsMsg(msg.ColonyID, msg.Kinds, msg.Types, 43, msg.Timeout)
+	assert.False(t, msg.Equals(msg3))
+}
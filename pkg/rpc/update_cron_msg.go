@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"encoding/json"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+const UpdateCronPayloadType = "updatecronmsg"
+
+type UpdateCronMsg struct {
+	Cron    *core.Cron `json:"cron"`
+	MsgType string     `json:"msgtype"`
+}
+
+func CreateUpdateCronMsg(cron *core.Cron) *UpdateCronMsg {
+	msg := &UpdateCronMsg{}
+	msg.Cron = cron
+	msg.MsgType = UpdateCronPayloadType
+
+	return msg
+}
+
+func (msg *UpdateCronMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *UpdateCronMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *UpdateCronMsg) Equals(msg2 *UpdateCronMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType && msg.Cron.Equals(msg2.Cron) {
+		return true
+	}
+
+	return false
+}
+
+func CreateUpdateCronMsgFromJSON(jsonString string) (*UpdateCronMsg, error) {
+	var msg *UpdateCronMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
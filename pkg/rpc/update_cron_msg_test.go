@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCUpdateCronMsg(t *testing.T) {
+	cron := core.CreateCron(core.GenerateRandomID(), "test_name1", "* * * * * *", 0, false, "workflow1")
+	msg := CreateUpdateCronMsg(cron)
+	jsonString, err := msg.ToJSON()
+	assert.Nil(t, err)
+
+	msg2, err := CreateUpdateCronMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateUpdateCronMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCUpdateCronMsgIndent(t *testing.T) {
+	cron := core.CreateCron(core.GenerateRandomID(), "test_name1", "* * * * * *", 0, false, "workflow1")
+	msg := CreateUpdateCronMsg(cron)
+	jsonString, err := msg.ToJSONIndent()
+	assert.Nil(t, err)
+
+	msg2, err := CreateUpdateCronMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateUpdateCronMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCUpdateCronMsgEquals(t *testing.T) {
+	cron := core.CreateCron(core.GenerateRandomID(), "test_name1", "* * * * * *", 0, false, "workflow1")
+	msg := CreateUpdateCronMsg(cron)
+	assert.True(t, msg.Equals(msg))
+	assert.False(t, msg.Equals(nil))
+}
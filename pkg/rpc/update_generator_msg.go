@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"encoding/json"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+const UpdateGeneratorPayloadType = "updategeneratormsg"
+
+type UpdateGeneratorMsg struct {
+	Generator *core.Generator `json:"generator"`
+	MsgType   string          `json:"msgtype"`
+}
+
+func CreateUpdateGeneratorMsg(generator *core.Generator) *UpdateGeneratorMsg {
+	msg := &UpdateGeneratorMsg{}
+	msg.Generator = generator
+	msg.MsgType = UpdateGeneratorPayloadType
+
+	return msg
+}
+
+func (msg *UpdateGeneratorMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *UpdateGeneratorMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *UpdateGeneratorMsg) Equals(msg2 *UpdateGeneratorMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType && msg.Generator.Equals(msg2.Generator) {
+		return true
+	}
+
+	return false
+}
+
+func CreateUpdateGeneratorMsgFromJSON(jsonString string) (*UpdateGeneratorMsg, error) {
+	var msg *UpdateGeneratorMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
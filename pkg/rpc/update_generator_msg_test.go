@@ -0,0 +1,46 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCUpdateGeneratorMsg(t *testing.T) {
+	generator := utils.FakeGenerator(t, core.GenerateRandomID())
+	msg := CreateUpdateGeneratorMsg(generator)
+	jsonString, err := msg.ToJSON()
+	assert.Nil(t, err)
+
+	msg2, err := CreateUpdateGeneratorMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateUpdateGeneratorMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCUpdateGeneratorMsgIndent(t *testing.T) {
+	generator := utils.FakeGenerator(t, core.GenerateRandomID())
+	msg := CreateUpdateGeneratorMsg(generator)
+	jsonString, err := msg.ToJSONIndent()
+	assert.Nil(t, err)
+
+	msg2, err := CreateUpdateGeneratorMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateUpdateGeneratorMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCUpdateGeneratorMsgEquals(t *testing.T) {
+	generator := utils.FakeGenerator(t, core.GenerateRandomID())
+	msg := CreateUpdateGeneratorMsg(generator)
+	assert.True(t, msg.Equals(msg))
+	assert.False(t, msg.Equals(nil))
+}
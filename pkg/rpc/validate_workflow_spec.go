@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"encoding/json"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+const ValidateWorkflowSpecPayloadType = "validateworkflowspecmsg"
+
+type ValidateWorkflowSpecMsg struct {
+	WorkflowSpec *core.WorkflowSpec `json:"spec"`
+	MsgType      string             `json:"msgtype"`
+}
+
+func CreateValidateWorkflowSpecMsg(workflowSpec *core.WorkflowSpec) *ValidateWorkflowSpecMsg {
+	msg := &ValidateWorkflowSpecMsg{}
+	msg.WorkflowSpec = workflowSpec
+	msg.MsgType = ValidateWorkflowSpecPayloadType
+
+	return msg
+}
+
+func (msg *ValidateWorkflowSpecMsg) ToJSON() (string, error) {
+	jsonBytes, err := json.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *ValidateWorkflowSpecMsg) ToJSONIndent() (string, error) {
+	jsonBytes, err := json.MarshalIndent(msg, "", "    ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(jsonBytes), nil
+}
+
+func (msg *ValidateWorkflowSpecMsg) Equals(msg2 *ValidateWorkflowSpecMsg) bool {
+	if msg2 == nil {
+		return false
+	}
+
+	if msg.MsgType == msg2.MsgType && msg.WorkflowSpec.Equals(msg2.WorkflowSpec) {
+		return true
+	}
+
+	return false
+}
+
+func CreateValidateWorkflowSpecMsgFromJSON(jsonString string) (*ValidateWorkflowSpecMsg, error) {
+	var msg *ValidateWorkflowSpecMsg
+
+	err := json.Unmarshal([]byte(jsonString), &msg)
+	if err != nil {
+		return msg, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,41 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCValidateWorkflowSpecMsg(t *testing.T) {
+	msg := CreateValidateWorkflowSpecMsg(createWorkflowSpec())
+	jsonString, err := msg.ToJSON()
+	assert.Nil(t, err)
+
+	msg2, err := CreateValidateWorkflowSpecMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateValidateWorkflowSpecMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCValidateWorkflowSpecMsgIndent(t *testing.T) {
+	msg := CreateValidateWorkflowSpecMsg(createWorkflowSpec())
+	jsonString, err := msg.ToJSONIndent()
+	assert.Nil(t, err)
+
+	msg2, err := CreateValidateWorkflowSpecMsgFromJSON(jsonString + "error")
+	assert.NotNil(t, err)
+
+	msg2, err = CreateValidateWorkflowSpecMsgFromJSON(jsonString)
+	assert.Nil(t, err)
+
+	assert.True(t, msg.Equals(msg2))
+}
+
+func TestRPCValidateWorkflowSpecMsgEquals(t *testing.T) {
+	msg := CreateValidateWorkflowSpecMsg(createWorkflowSpec())
+	assert.True(t, msg.Equals(msg))
+	assert.False(t, msg.Equals(nil))
+}
@@ -0,0 +1,83 @@
+package security
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+)
+
+const SECRET_KEY_SIZE = 32 // AES-256
+
+// GenerateSecretKey returns a random hex encoded key that can be used with Encrypt and Decrypt
+func GenerateSecretKey() (string, error) {
+	key := make([]byte, SECRET_KEY_SIZE)
+	_, err := io.ReadFull(rand.Reader, key)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(key), nil
+}
+
+// ParseSecretKey decodes a hex encoded key created by GenerateSecretKey
+func ParseSecretKey(hexKey string) ([]byte, error) {
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return nil, errors.New("Invalid secret key, must be hex encoded")
+	}
+
+	if len(key) != SECRET_KEY_SIZE {
+		return nil, errors.New("Invalid secret key, must be 32 bytes")
+	}
+
+	return key, nil
+}
+
+// Encrypt encrypts and authenticates plaintext using AES-GCM, the random nonce is prepended to the ciphertext
+func Encrypt(key []byte, plaintext []byte) ([]byte, error) {
+	gcm, err := createGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Decrypt decrypts a ciphertext created by Encrypt, an error is returned if the key is wrong or the ciphertext has
+// been tampered with
+func Decrypt(key []byte, ciphertext []byte) ([]byte, error) {
+	gcm, err := createGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errors.New("Invalid ciphertext, too short")
+	}
+
+	nonce := ciphertext[:gcm.NonceSize()]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.New("Failed to decrypt, invalid key or corrupted ciphertext")
+	}
+
+	return plaintext, nil
+}
+
+func createGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,50 @@
+package security
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	hexKey, err := GenerateSecretKey()
+	assert.Nil(t, err)
+
+	key, err := ParseSecretKey(hexKey)
+	assert.Nil(t, err)
+	assert.Len(t, key, SECRET_KEY_SIZE)
+
+	ciphertext, err := Encrypt(key, []byte("db-password"))
+	assert.Nil(t, err)
+	assert.NotContains(t, string(ciphertext), "db-password")
+
+	ciphertext2, err := Encrypt(key, []byte("db-password"))
+	assert.Nil(t, err)
+	assert.NotEqual(t, ciphertext, ciphertext2) // Random nonce
+
+	plaintext, err := Decrypt(key, ciphertext)
+	assert.Nil(t, err)
+	assert.Equal(t, string(plaintext), "db-password")
+
+	otherHexKey, err := GenerateSecretKey()
+	assert.Nil(t, err)
+	otherKey, err := ParseSecretKey(otherHexKey)
+	assert.Nil(t, err)
+	_, err = Decrypt(otherKey, ciphertext)
+	assert.NotNil(t, err)
+
+	ciphertext[len(ciphertext)-1] ^= 1
+	_, err = Decrypt(key, ciphertext)
+	assert.NotNil(t, err)
+
+	_, err = Decrypt(key, []byte("short"))
+	assert.NotNil(t, err)
+}
+
+func TestParseSecretKey(t *testing.T) {
+	_, err := ParseSecretKey("not hex")
+	assert.NotNil(t, err)
+
+	_, err = ParseSecretKey("0011")
+	assert.NotNil(t, err)
+}
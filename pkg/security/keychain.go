@@ -1,14 +1,36 @@
 package security
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
+	"io"
 	"io/ioutil"
 	"os"
+	"runtime"
 	"strings"
+	"sync"
+
+	"golang.org/x/crypto/scrypt"
 )
 
+const KEYCHAIN_PASSPHRASE_ENV = "COLONIES_KEYCHAIN_PASSPHRASE"
+
+const encryptedPrvKeyPrefix = "colonies-encrypted-v1:"
+const pendingPrvKeySuffix = ".pending"
+const saltSize = 16
+
+// scrypt parameters recommended for interactive logins
+const scryptN = 32768
+const scryptR = 8
+const scryptP = 1
+
 type Keychain struct {
-	dirName string
+	dirName    string
+	passphrase string
+	inMemory   bool
+	prvKeys    map[string]string
+	mutex      sync.Mutex
 }
 
 func (keychain *Keychain) ensureColoniesDirExists() error {
@@ -25,14 +47,36 @@ func (keychain *Keychain) ensureColoniesDirExists() error {
 		if !info.IsDir() {
 			return errors.New(keychain.dirName + " exists but is not a directory")
 		}
-		return nil
+		return checkPermissions(keychain.dirName, info, 0022)
 	}
 
 	return err
 }
 
+// checkPermissions returns an error if any of the permission bits in mask are set, i.e. if the file can be accessed
+// by other users than the owner
+func checkPermissions(path string, info os.FileInfo, mask os.FileMode) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+
+	if info.Mode().Perm()&mask != 0 {
+		return errors.New("Insecure permissions " + info.Mode().Perm().String() + " on " + path + ", it must only be accessible by its owner, try chmod go-rwx " + path)
+	}
+
+	return nil
+}
+
+// CreateKeychain creates a keychain stored in a directory in the home directory of the user. The private keys are
+// encrypted if the COLONIES_KEYCHAIN_PASSPHRASE environmental variable is set.
 func CreateKeychain(coloniesDirName string) (*Keychain, error) {
-	keychain := &Keychain{}
+	return CreateEncryptedKeychain(coloniesDirName, os.Getenv(KEYCHAIN_PASSPHRASE_ENV))
+}
+
+// CreateEncryptedKeychain creates a keychain where private keys are encrypted at rest with a key derived from the
+// passphrase, private keys are stored in plaintext if the passphrase is empty
+func CreateEncryptedKeychain(coloniesDirName string, passphrase string) (*Keychain, error) {
+	keychain := &Keychain{passphrase: passphrase}
 
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
@@ -48,20 +92,233 @@ func CreateKeychain(coloniesDirName string) (*Keychain, error) {
 	return keychain, nil
 }
 
+// CreateInMemoryKeychain creates a keychain that never writes private keys to disk, the keys are lost when the
+// process exits
+func CreateInMemoryKeychain() *Keychain {
+	return &Keychain{inMemory: true, prvKeys: make(map[string]string)}
+}
+
+func (keychain *Keychain) IsEncrypted() bool {
+	return keychain.passphrase != ""
+}
+
+func (keychain *Keychain) deriveKey(salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(keychain.passphrase), salt, scryptN, scryptR, scryptP, SECRET_KEY_SIZE)
+}
+
+func (keychain *Keychain) encryptPrvKey(prvKey string) (string, error) {
+	salt := make([]byte, saltSize)
+	_, err := io.ReadFull(rand.Reader, salt)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := keychain.deriveKey(salt)
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext, err := Encrypt(key, []byte(prvKey))
+	if err != nil {
+		return "", err
+	}
+
+	return encryptedPrvKeyPrefix + hex.EncodeToString(salt) + ":" + hex.EncodeToString(ciphertext), nil
+}
+
+func (keychain *Keychain) decryptPrvKey(id string, encrypted string) (string, error) {
+	if !keychain.IsEncrypted() {
+		return "", errors.New("Private key for <" + id + "> is encrypted, set " + KEYCHAIN_PASSPHRASE_ENV + " to decrypt it")
+	}
+
+	s := strings.Split(strings.TrimPrefix(encrypted, encryptedPrvKeyPrefix), ":")
+	if len(s) != 2 {
+		return "", errors.New("Private key for <" + id + "> is corrupted")
+	}
+
+	salt, err := hex.DecodeString(s[0])
+	if err != nil {
+		return "", errors.New("Private key for <" + id + "> is corrupted")
+	}
+
+	ciphertext, err := hex.DecodeString(s[1])
+	if err != nil {
+		return "", errors.New("Private key for <" + id + "> is corrupted")
+	}
+
+	key, err := keychain.deriveKey(salt)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := Decrypt(key, ciphertext)
+	if err != nil {
+		return "", errors.New("Failed to decrypt private key for <" + id + ">, invalid passphrase")
+	}
+
+	return string(plaintext), nil
+}
+
+func (keychain *Keychain) writePrvKeyFile(id string, content string) error {
+	fileName := keychain.dirName + "/" + id
+	err := os.WriteFile(fileName, []byte(content), 0600)
+	if err != nil {
+		return err
+	}
+
+	// WriteFile does not change the permissions of an existing file
+	return os.Chmod(fileName, 0600)
+}
+
 func (keychain *Keychain) AddPrvKey(id string, prvKey string) error {
-	prvKeyBytes := []byte(prvKey)
-	return os.WriteFile(keychain.dirName+"/"+id, prvKeyBytes, 0600)
+	if keychain.inMemory {
+		keychain.mutex.Lock()
+		defer keychain.mutex.Unlock()
+		keychain.prvKeys[id] = prvKey
+		return nil
+	}
+
+	if keychain.IsEncrypted() {
+		encryptedPrvKey, err := keychain.encryptPrvKey(prvKey)
+		if err != nil {
+			return err
+		}
+		return keychain.writePrvKeyFile(id, encryptedPrvKey)
+	}
+
+	return keychain.writePrvKeyFile(id, prvKey)
 }
 
 func (keychain *Keychain) GetPrvKey(id string) (string, error) {
-	prvKeyBytes, err := ioutil.ReadFile(keychain.dirName + "/" + id)
+	if keychain.inMemory {
+		keychain.mutex.Lock()
+		defer keychain.mutex.Unlock()
+		prvKey, ok := keychain.prvKeys[id]
+		if !ok {
+			return "", errors.New("No private key found for <" + id + ">")
+		}
+		return prvKey, nil
+	}
+
+	fileName := keychain.dirName + "/" + id
+	info, err := os.Stat(fileName)
+	if err != nil {
+		return "", err
+	}
+
+	err = checkPermissions(fileName, info, 0077)
+	if err != nil {
+		return "", err
+	}
+
+	prvKeyBytes, err := ioutil.ReadFile(fileName)
 	if err != nil {
 		return "", err
 	}
 
-	return strings.Trim(strings.Trim(string(prvKeyBytes), "\n"), " "), nil
+	prvKey := strings.Trim(strings.Trim(string(prvKeyBytes), "\n"), " ")
+	if strings.HasPrefix(prvKey, encryptedPrvKeyPrefix) {
+		return keychain.decryptPrvKey(id, prvKey)
+	}
+
+	return prvKey, nil
+}
+
+// StagePrvKey stores a private key as pending before it is taken into use, e.g. before a key is rotated on the
+// server. It fails if the keychain cannot store the key, so that the current key is never replaced by a key that is
+// lost. The pending key is taken into use with CommitPrvKey or removed with RemovePendingPrvKey.
+func (keychain *Keychain) StagePrvKey(id string, prvKey string) error {
+	if !keychain.inMemory && !keychain.IsEncrypted() {
+		prvKeyBytes, err := ioutil.ReadFile(keychain.dirName + "/" + id)
+		if err == nil && strings.HasPrefix(strings.TrimSpace(string(prvKeyBytes)), encryptedPrvKeyPrefix) {
+			return errors.New("Private key for <" + id + "> is encrypted, set " + KEYCHAIN_PASSPHRASE_ENV + " to store a new private key")
+		}
+	}
+
+	return keychain.AddPrvKey(id+pendingPrvKeySuffix, prvKey)
+}
+
+// CommitPrvKey replaces the private key of id with the pending private key staged with StagePrvKey
+func (keychain *Keychain) CommitPrvKey(id string) error {
+	prvKey, err := keychain.GetPrvKey(id + pendingPrvKeySuffix)
+	if err != nil {
+		return err
+	}
+
+	err = keychain.AddPrvKey(id, prvKey)
+	if err != nil {
+		return err
+	}
+
+	return keychain.RemovePendingPrvKey(id)
+}
+
+// RemovePendingPrvKey removes a pending private key staged with StagePrvKey
+func (keychain *Keychain) RemovePendingPrvKey(id string) error {
+	if keychain.inMemory {
+		keychain.mutex.Lock()
+		defer keychain.mutex.Unlock()
+		delete(keychain.prvKeys, id+pendingPrvKeySuffix)
+		return nil
+	}
+
+	err := os.Remove(keychain.dirName + "/" + id + pendingPrvKeySuffix)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// Encrypt encrypts all private keys that are stored in plaintext, it is used to protect an existing keychain with a
+// passphrase. The number of encrypted private keys is returned.
+func (keychain *Keychain) Encrypt() (int, error) {
+	if keychain.inMemory {
+		return 0, errors.New("An in-memory keychain cannot be encrypted")
+	}
+
+	if !keychain.IsEncrypted() {
+		return 0, errors.New("No passphrase set, set " + KEYCHAIN_PASSPHRASE_ENV + " to encrypt the keychain")
+	}
+
+	files, err := ioutil.ReadDir(keychain.dirName)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+
+		prvKeyBytes, err := ioutil.ReadFile(keychain.dirName + "/" + file.Name())
+		if err != nil {
+			return count, err
+		}
+
+		prvKey := strings.Trim(strings.Trim(string(prvKeyBytes), "\n"), " ")
+		if strings.HasPrefix(prvKey, encryptedPrvKeyPrefix) {
+			continue
+		}
+
+		err = keychain.AddPrvKey(file.Name(), prvKey)
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
 }
 
 func (keychain *Keychain) Remove() error {
+	if keychain.inMemory {
+		keychain.mutex.Lock()
+		defer keychain.mutex.Unlock()
+		keychain.prvKeys = make(map[string]string)
+		return nil
+	}
+
 	return os.RemoveAll(keychain.dirName)
 }
@@ -50,3 +50,200 @@ func TestKeychainFailure(t *testing.T) {
 	err = os.Remove(keychain.dirName)
 	assert.Nil(t, err)
 }
+
+func TestEncryptedKeychain(t *testing.T) {
+	keychain, err := CreateEncryptedKeychain(".colonies_test", "passphrase")
+	assert.Nil(t, err)
+	assert.True(t, keychain.IsEncrypted())
+
+	crypto := crypto.CreateCrypto()
+	prvKey, err := crypto.GeneratePrivateKey()
+	assert.Nil(t, err)
+
+	id := core.GenerateRandomID()
+	err = keychain.AddPrvKey(id, prvKey)
+	assert.Nil(t, err)
+
+	// The private key should not be stored in plaintext
+	prvKeyBytes, err := os.ReadFile(keychain.dirName + "/" + id)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(prvKeyBytes), prvKey)
+
+	prvKeyFromKeychain, err := keychain.GetPrvKey(id)
+	assert.Nil(t, err)
+	assert.Equal(t, prvKey, prvKeyFromKeychain)
+
+	invalidKeychain, err := CreateEncryptedKeychain(".colonies_test", "invalid_passphrase")
+	assert.Nil(t, err)
+	_, err = invalidKeychain.GetPrvKey(id)
+	assert.NotNil(t, err)
+
+	plainKeychain, err := CreateEncryptedKeychain(".colonies_test", "")
+	assert.Nil(t, err)
+	_, err = plainKeychain.GetPrvKey(id)
+	assert.NotNil(t, err)
+
+	keychain.Remove()
+}
+
+func TestEncryptKeychain(t *testing.T) {
+	plainKeychain, err := CreateEncryptedKeychain(".colonies_test", "")
+	assert.Nil(t, err)
+
+	crypto := crypto.CreateCrypto()
+	prvKey, err := crypto.GeneratePrivateKey()
+	assert.Nil(t, err)
+
+	id := core.GenerateRandomID()
+	err = plainKeychain.AddPrvKey(id, prvKey)
+	assert.Nil(t, err)
+
+	_, err = plainKeychain.Encrypt()
+	assert.NotNil(t, err) // No passphrase
+
+	keychain, err := CreateEncryptedKeychain(".colonies_test", "passphrase")
+	assert.Nil(t, err)
+
+	// Plaintext private keys can still be read
+	prvKeyFromKeychain, err := keychain.GetPrvKey(id)
+	assert.Nil(t, err)
+	assert.Equal(t, prvKey, prvKeyFromKeychain)
+
+	count, err := keychain.Encrypt()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	count, err = keychain.Encrypt()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count) // Already encrypted
+
+	_, err = plainKeychain.GetPrvKey(id)
+	assert.NotNil(t, err)
+
+	prvKeyFromKeychain, err = keychain.GetPrvKey(id)
+	assert.Nil(t, err)
+	assert.Equal(t, prvKey, prvKeyFromKeychain)
+
+	keychain.Remove()
+}
+
+func TestKeychainPermissions(t *testing.T) {
+	keychain, err := CreateKeychain(".colonies_test")
+	assert.Nil(t, err)
+
+	id := core.GenerateRandomID()
+	err = keychain.AddPrvKey(id, "prvkey")
+	assert.Nil(t, err)
+
+	info, err := os.Stat(keychain.dirName + "/" + id)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	err = os.Chmod(keychain.dirName+"/"+id, 0644)
+	assert.Nil(t, err)
+	_, err = keychain.GetPrvKey(id)
+	assert.NotNil(t, err) // Readable by other users
+
+	// Adding the private key again should fix the permissions
+	err = keychain.AddPrvKey(id, "prvkey")
+	assert.Nil(t, err)
+	_, err = keychain.GetPrvKey(id)
+	assert.Nil(t, err)
+
+	err = os.Chmod(keychain.dirName, 0777)
+	assert.Nil(t, err)
+	_, err = CreateKeychain(".colonies_test")
+	assert.NotNil(t, err) // Writable by other users
+
+	keychain.Remove()
+}
+
+func TestInMemoryKeychain(t *testing.T) {
+	keychain := CreateInMemoryKeychain()
+
+	crypto := crypto.CreateCrypto()
+	prvKey, err := crypto.GeneratePrivateKey()
+	assert.Nil(t, err)
+
+	id := core.GenerateRandomID()
+	err = keychain.AddPrvKey(id, prvKey)
+	assert.Nil(t, err)
+
+	prvKeyFromKeychain, err := keychain.GetPrvKey(id)
+	assert.Nil(t, err)
+	assert.Equal(t, prvKey, prvKeyFromKeychain)
+
+	_, err = keychain.Encrypt()
+	assert.NotNil(t, err)
+
+	keychain.Remove()
+	_, err = keychain.GetPrvKey(id)
+	assert.NotNil(t, err)
+}
+
+func TestStagePrvKey(t *testing.T) {
+	keychain, err := CreateKeychain(".colonies_test")
+	assert.Nil(t, err)
+
+	crypto := crypto.CreateCrypto()
+	prvKey, err := crypto.GeneratePrivateKey()
+	assert.Nil(t, err)
+	newPrvKey, err := crypto.GeneratePrivateKey()
+	assert.Nil(t, err)
+
+	id := core.GenerateRandomID()
+	err = keychain.AddPrvKey(id, prvKey)
+	assert.Nil(t, err)
+
+	// A staged key does not replace the current key until it is committed
+	err = keychain.StagePrvKey(id, newPrvKey)
+	assert.Nil(t, err)
+	prvKeyFromKeychain, err := keychain.GetPrvKey(id)
+	assert.Nil(t, err)
+	assert.Equal(t, prvKey, prvKeyFromKeychain)
+
+	err = keychain.CommitPrvKey(id)
+	assert.Nil(t, err)
+	prvKeyFromKeychain, err = keychain.GetPrvKey(id)
+	assert.Nil(t, err)
+	assert.Equal(t, newPrvKey, prvKeyFromKeychain)
+
+	// The pending key is removed when committed
+	err = keychain.CommitPrvKey(id)
+	assert.NotNil(t, err)
+
+	err = keychain.StagePrvKey(id, prvKey)
+	assert.Nil(t, err)
+	err = keychain.RemovePendingPrvKey(id)
+	assert.Nil(t, err)
+	err = keychain.CommitPrvKey(id)
+	assert.NotNil(t, err)
+
+	keychain.Remove()
+}
+
+func TestStagePrvKeyEncrypted(t *testing.T) {
+	encryptedKeychain, err := CreateEncryptedKeychain(".colonies_test", "passphrase")
+	assert.Nil(t, err)
+
+	crypto := crypto.CreateCrypto()
+	prvKey, err := crypto.GeneratePrivateKey()
+	assert.Nil(t, err)
+
+	id := core.GenerateRandomID()
+	err = encryptedKeychain.AddPrvKey(id, prvKey)
+	assert.Nil(t, err)
+
+	// A new key cannot be staged for an encrypted key without the passphrase
+	keychain, err := CreateEncryptedKeychain(".colonies_test", "")
+	assert.Nil(t, err)
+	err = keychain.StagePrvKey(id, prvKey)
+	assert.NotNil(t, err)
+
+	err = encryptedKeychain.StagePrvKey(id, prvKey)
+	assert.Nil(t, err)
+	err = encryptedKeychain.CommitPrvKey(id)
+	assert.Nil(t, err)
+
+	encryptedKeychain.Remove()
+}
@@ -4,4 +4,5 @@ type Validator interface {
 	RequireServerOwner(recoveredID string, serverID string) error
 	RequireColonyOwner(recoveredID string, colonyID string) error
 	RequireExecutorMembership(recoveredID string, colonyID string, approved bool) error
+	RequireExecutorMembershipOrColonyOwner(recoveredID string, colonyID string, approved bool) error
 }
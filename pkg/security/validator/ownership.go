@@ -3,4 +3,5 @@ package validator
 type ownership interface {
 	checkIfColonyExists(colonyID string) error
 	checkIfExecutorIsValid(executorID string, colonyID string, approved bool) error
+	checkIfColonyAdmin(adminID string, colonyID string) error
 }
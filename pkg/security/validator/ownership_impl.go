@@ -61,3 +61,16 @@ func (ownership *ownershipImpl) checkIfExecutorIsValid(executorID string, colony
 
 	return nil
 }
+
+func (ownership *ownershipImpl) checkIfColonyAdmin(adminID string, colonyID string) error {
+	admin, err := ownership.db.GetColonyAdmin(colonyID, adminID)
+	if err != nil {
+		return err
+	}
+
+	if admin == nil {
+		return errors.New("Id <" + adminID + "> is not an admin of Colony with Id <" + colonyID + ">")
+	}
+
+	return nil
+}
@@ -67,3 +67,28 @@ func TestCheckIfExecutorIsValid(t *testing.T) {
 
 	defer db.Close()
 }
+
+func TestCheckIfColonyAdmin(t *testing.T) {
+	db, err := postgresql.PrepareTests()
+	assert.Nil(t, err)
+
+	ownership := createOwnership(db)
+
+	colony := core.CreateColony(core.GenerateRandomID(), "test_colony_name_1")
+	err = db.AddColony(colony)
+	assert.Nil(t, err)
+
+	adminID := core.GenerateRandomID()
+	err = ownership.checkIfColonyAdmin(adminID, colony.ID)
+	assert.NotNil(t, err)
+
+	err = db.AddColonyAdmin(core.CreateColonyAdmin(colony.ID, adminID, "alice"))
+	assert.Nil(t, err)
+
+	err = ownership.checkIfColonyAdmin(adminID, colony.ID)
+	assert.Nil(t, err)
+	err = ownership.checkIfColonyAdmin(adminID, core.GenerateRandomID())
+	assert.NotNil(t, err)
+
+	defer db.Close()
+}
@@ -8,6 +8,7 @@ type OwnershipMock struct {
 	colonies          map[string]bool
 	executors         map[string]string
 	approvedExecutors map[string]bool
+	colonyAdmins      map[string]string
 }
 
 func createOwnershipMock() *OwnershipMock {
@@ -15,6 +16,7 @@ func createOwnershipMock() *OwnershipMock {
 	ownership.colonies = make(map[string]bool)
 	ownership.executors = make(map[string]string)
 	ownership.approvedExecutors = make(map[string]bool)
+	ownership.colonyAdmins = make(map[string]string)
 
 	return ownership
 }
@@ -31,6 +33,14 @@ func (ownership *OwnershipMock) approveExecutor(executorID string, colonyID stri
 	ownership.approvedExecutors[executorID] = true
 }
 
+func (ownership *OwnershipMock) addColonyAdmin(adminID string, colonyID string) {
+	ownership.colonyAdmins[adminID] = colonyID
+}
+
+func (ownership *OwnershipMock) removeColonyAdmin(adminID string) {
+	delete(ownership.colonyAdmins, adminID)
+}
+
 func (ownership *OwnershipMock) checkIfColonyExists(colonyID string) error {
 	colonyIDFromDB := ownership.colonies[colonyID]
 	if !colonyIDFromDB {
@@ -65,3 +75,11 @@ func (ownership *OwnershipMock) checkIfExecutorIsValid(executorID string, colony
 
 	return nil
 }
+
+func (ownership *OwnershipMock) checkIfColonyAdmin(adminID string, colonyID string) error {
+	if ownership.colonyAdmins[adminID] != colonyID {
+		return errors.New("Not an admin of the colony")
+	}
+
+	return nil
+}
@@ -64,3 +64,18 @@ func TestCheckIfExecutorIsValidMock(t *testing.T) {
 	err = ownership.checkIfExecutorIsValid(approvedExecutor.ID, colony.ID, false)
 	assert.Nil(t, err)
 }
+
+func TestCheckIfColonyAdminMock(t *testing.T) {
+	ownership := createOwnershipMock()
+
+	colony := core.CreateColony(core.GenerateRandomID(), "test_colony_name_1")
+	ownership.addColony(colony.ID)
+
+	adminID := core.GenerateRandomID()
+	assert.NotNil(t, ownership.checkIfColonyAdmin(adminID, colony.ID))
+
+	ownership.addColonyAdmin(adminID, colony.ID)
+	assert.Nil(t, ownership.checkIfColonyAdmin(adminID, colony.ID))
+	assert.NotNil(t, ownership.checkIfColonyAdmin(adminID, core.GenerateRandomID()))
+	assert.NotNil(t, ownership.checkIfColonyAdmin(core.GenerateRandomID(), colony.ID))
+}
@@ -26,9 +26,13 @@ func (validator *StandaloneValidator) RequireServerOwner(recoveredID string, ser
 	return nil
 }
 
+// RequireColonyOwner requires that the recovered Id is either the colony Id, i.e. the request was signed with the
+// colony private key, or one of the admins added to the colony
 func (validator *StandaloneValidator) RequireColonyOwner(recoveredID string, colonyID string) error {
 	if recoveredID != colonyID {
-		return errors.New("RecoveredID does not match Colony Id")
+		if validator.ownership.checkIfColonyAdmin(recoveredID, colonyID) != nil {
+			return errors.New("RecoveredID does not match Colony Id or any admin of the colony")
+		}
 	}
 
 	return validator.ownership.checkIfColonyExists(colonyID)
@@ -37,3 +41,19 @@ func (validator *StandaloneValidator) RequireColonyOwner(recoveredID string, col
 func (validator *StandaloneValidator) RequireExecutorMembership(recoveredID string, colonyID string, approved bool) error {
 	return validator.ownership.checkIfExecutorIsValid(recoveredID, colonyID, approved)
 }
+
+// RequireExecutorMembershipOrColonyOwner requires that the recovered Id is either a member of the colony, see
+// RequireExecutorMembership, or the colony owner, see RequireColonyOwner
+func (validator *StandaloneValidator) RequireExecutorMembershipOrColonyOwner(recoveredID string, colonyID string, approved bool) error {
+	membershipErr := validator.RequireExecutorMembership(recoveredID, colonyID, approved)
+	if membershipErr == nil {
+		return nil
+	}
+
+	ownerErr := validator.RequireColonyOwner(recoveredID, colonyID)
+	if ownerErr == nil {
+		return nil
+	}
+
+	return errors.New("RecoveredID is neither a member nor the owner of the colony: " + membershipErr.Error() + ", " + ownerErr.Error())
+}
@@ -35,6 +35,27 @@ func TestRequireColonyOwner(t *testing.T) {
 	ownership.addColony(colonyID)
 	assert.Nil(t, security.RequireColonyOwner(colonyID, colonyID))
 	assert.NotNil(t, security.RequireColonyOwner(core.GenerateRandomID(), colonyID))
+	assert.NotNil(t, security.RequireColonyOwner(core.GenerateRandomID(), core.GenerateRandomID()))
+}
+
+func TestRequireColonyOwnerAdmin(t *testing.T) {
+	ownership := createOwnershipMock()
+	security := createTestValidator(ownership)
+
+	colony1ID := core.GenerateRandomID()
+	colony2ID := core.GenerateRandomID()
+	ownership.addColony(colony1ID)
+	ownership.addColony(colony2ID)
+
+	adminID := core.GenerateRandomID()
+	assert.NotNil(t, security.RequireColonyOwner(adminID, colony1ID)) // Should not work, not an admin
+
+	ownership.addColonyAdmin(adminID, colony1ID)
+	assert.Nil(t, security.RequireColonyOwner(adminID, colony1ID))    // Should work
+	assert.NotNil(t, security.RequireColonyOwner(adminID, colony2ID)) // Should not work, admin of another colony
+
+	ownership.removeColonyAdmin(adminID)
+	assert.NotNil(t, security.RequireColonyOwner(adminID, colony1ID)) // Should not work, removed
 }
 
 func TestRequireExecutorMembership(t *testing.T) {
@@ -56,3 +77,27 @@ func TestRequireExecutorMembership(t *testing.T) {
 	assert.Nil(t, security.RequireExecutorMembership(executor1ID, colonyID, true))    // Should work
 	assert.NotNil(t, security.RequireExecutorMembership(executor2ID, colonyID, true)) // Should not work, not approved
 }
+
+func TestRequireExecutorMembershipOrColonyOwner(t *testing.T) {
+	ownership := createOwnershipMock()
+	security := createTestValidator(ownership)
+
+	colonyID := core.GenerateRandomID()
+	ownership.addColony(colonyID)
+	executorID := core.GenerateRandomID()
+	adminID := core.GenerateRandomID()
+	ownership.addExecutor(executorID, colonyID)
+	ownership.addColonyAdmin(adminID, colonyID)
+
+	assert.Nil(t, security.RequireExecutorMembershipOrColonyOwner(colonyID, colonyID, true))      // Should work, colony owner
+	assert.Nil(t, security.RequireExecutorMembershipOrColonyOwner(adminID, colonyID, true))       // Should work, colony admin
+	assert.Nil(t, security.RequireExecutorMembershipOrColonyOwner(executorID, colonyID, false))   // Should work
+	assert.NotNil(t, security.RequireExecutorMembershipOrColonyOwner(executorID, colonyID, true)) // Should not work, not approved
+
+	err := security.RequireExecutorMembershipOrColonyOwner(core.GenerateRandomID(), colonyID, true)
+	assert.NotNil(t, err) // Should not work
+	assert.Contains(t, err.Error(), "Colony Id")
+
+	ownership.approveExecutor(executorID, colonyID)
+	assert.Nil(t, security.RequireExecutorMembershipOrColonyOwner(executorID, colonyID, true)) // Should work
+}
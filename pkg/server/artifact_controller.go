@@ -0,0 +1,90 @@
+package server
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+func (controller *coloniesController) addArtifact(artifact *core.Artifact) (*core.Artifact, error) {
+	cmd := &command{threaded: true, artifactReplyChan: make(chan *core.Artifact, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			err := controller.db.AddArtifact(artifact)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			addedArtifact, err := controller.db.GetArtifactByID(artifact.ID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.artifactReplyChan <- addedArtifact
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case addedArtifact := <-cmd.artifactReplyChan:
+		return addedArtifact, nil
+	}
+}
+
+func (controller *coloniesController) getArtifact(artifactID string) (*core.Artifact, error) {
+	cmd := &command{threaded: true, artifactReplyChan: make(chan *core.Artifact, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			artifact, err := controller.db.GetArtifactByID(artifactID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.artifactReplyChan <- artifact
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case artifact := <-cmd.artifactReplyChan:
+		return artifact, nil
+	}
+}
+
+func (controller *coloniesController) getArtifacts(colonyID string, count int) ([]*core.Artifact, error) {
+	cmd := &command{threaded: true, artifactsReplyChan: make(chan []*core.Artifact, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			if count > MAX_COUNT {
+				cmd.errorChan <- errors.New("Count is larger than MaxCount limit <" + strconv.Itoa(MAX_COUNT) + ">")
+				return
+			}
+			artifacts, err := controller.db.FindArtifactsByColonyID(colonyID, count)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.artifactsReplyChan <- artifacts
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case artifacts := <-cmd.artifactsReplyChan:
+		return artifacts, nil
+	}
+}
+
+func (controller *coloniesController) deleteArtifact(artifactID string) error {
+	cmd := &command{threaded: true, errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			cmd.errorChan <- controller.db.DeleteArtifactByID(artifactID)
+		}}
+
+	controller.cmdQueue <- cmd
+	return <-cmd.errorChan
+}
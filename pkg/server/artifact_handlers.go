@@ -0,0 +1,273 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+func artifactKey(artifact *core.Artifact) string {
+	return artifact.ColonyID + "/" + artifact.ID
+}
+
+// parseArtifactMsgHeader verifies the signed RPC message sent in the header of requests to the artifact endpoint,
+// the content of the artifact is sent in the body and is thus not covered by the signature, instead the signed
+// message contains the hash of the content
+func (server *ColoniesServer) parseArtifactMsgHeader(c *gin.Context) (string, *rpc.RPCMsg, error) {
+	rpcMsg, err := rpc.CreateRPCMsgFromJSON(c.GetHeader(rpc.ArtifactMsgHeader))
+	if err != nil {
+		return "", nil, errors.New("Failed to parse " + rpc.ArtifactMsgHeader + " header, invalid JSON")
+	}
+
+	recoveredID, err := server.parseSignature(rpcMsg.Payload, rpcMsg.Signature)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return recoveredID, rpcMsg, nil
+}
+
+func (server *ColoniesServer) handleAddArtifactRequest(c *gin.Context) {
+	recoveredID, rpcMsg, err := server.parseArtifactMsgHeader(c)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	server.handleAddArtifactHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	server.audit(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+}
+
+func (server *ColoniesServer) handleAddArtifactHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateAddArtifactMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to add artifact, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != rpc.AddArtifactPayloadType || msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to add artifact, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	err = server.validator.RequireExecutorMembership(recoveredID, msg.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	if msg.Size < 0 || msg.Size > MAX_ARTIFACT_SIZE {
+		server.handleHTTPError(c, errors.New("Failed to add artifact, size is larger than MaxArtifactSize limit <"+strconv.FormatInt(MAX_ARTIFACT_SIZE, 10)+">"), http.StatusBadRequest)
+		return
+	}
+
+	artifact := core.CreateArtifact(msg.ColonyID, msg.Name, msg.Hash, msg.Size, msg.Archive)
+	artifact.ExecutorID = recoveredID
+
+	// At most one byte more than the declared size is read, so that a body larger than the declared size is detected
+	// without storing it
+	hasher := sha256.New()
+	body := io.TeeReader(io.LimitReader(c.Request.Body, msg.Size+1), hasher)
+	counter := &byteCounter{reader: body}
+	err = server.artifactStorage.Put(artifactKey(artifact), counter)
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	hash := hex.EncodeToString(hasher.Sum(nil))
+	if counter.count != msg.Size || hash != msg.Hash {
+		server.artifactStorage.Delete(artifactKey(artifact))
+		server.handleHTTPError(c, errors.New("Failed to add artifact, content does not match the hash or size of the artifact"), http.StatusBadRequest)
+		return
+	}
+
+	addedArtifact, err := server.controller.addArtifact(artifact)
+	if err != nil {
+		server.artifactStorage.Delete(artifactKey(artifact))
+		server.handleHTTPError(c, err, http.StatusBadRequest)
+		return
+	}
+
+	jsonString, err = addedArtifact.ToJSON()
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ArtifactId": addedArtifact.ID, "Name": addedArtifact.Name, "Size": addedArtifact.Size}).Debug("Adding artifact")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
+func (server *ColoniesServer) handleDownloadArtifactRequest(c *gin.Context) {
+	recoveredID, rpcMsg, err := server.parseArtifactMsgHeader(c)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	msg, err := rpc.CreateDownloadArtifactMsgFromJSON(rpcMsg.DecodePayload())
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to download artifact, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != rpc.DownloadArtifactPayloadType || msg.MsgType != rpcMsg.PayloadType {
+		server.handleHTTPError(c, errors.New("Failed to download artifact, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	artifact, err := server.controller.getArtifact(msg.ArtifactID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if artifact == nil {
+		server.handleHTTPError(c, errors.New("Failed to download artifact, artifact with Id <"+msg.ArtifactID+"> not found"), http.StatusNotFound)
+		return
+	}
+
+	err = server.validator.RequireExecutorMembership(recoveredID, artifact.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	reader, err := server.artifactStorage.Get(artifactKey(artifact))
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+	defer reader.Close()
+
+	log.WithFields(log.Fields{"ArtifactId": artifact.ID}).Debug("Downloading artifact")
+
+	c.DataFromReader(http.StatusOK, artifact.Size, "application/octet-stream", reader, nil)
+}
+
+func (server *ColoniesServer) handleGetArtifactHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateGetArtifactMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to get artifact, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to get artifact, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	artifact, err := server.controller.getArtifact(msg.ArtifactID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if artifact == nil {
+		server.handleHTTPError(c, errors.New("Failed to get artifact, artifact with Id <"+msg.ArtifactID+"> not found"), http.StatusNotFound)
+		return
+	}
+
+	err = server.validator.RequireExecutorMembership(recoveredID, artifact.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	jsonString, err = artifact.ToJSON()
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ArtifactId": artifact.ID}).Debug("Getting artifact")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
+func (server *ColoniesServer) handleGetArtifactsHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateGetArtifactsMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to get artifacts, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to get artifacts, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	err = server.validator.RequireExecutorMembership(recoveredID, msg.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	artifacts, err := server.controller.getArtifacts(msg.ColonyID, msg.Count)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	jsonString, err = core.ConvertArtifactArrayToJSON(artifacts)
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ColonyId": msg.ColonyID, "Count": msg.Count}).Debug("Getting artifacts")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
+func (server *ColoniesServer) handleDeleteArtifactHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateDeleteArtifactMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to delete artifact, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to delete artifact, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	artifact, err := server.controller.getArtifact(msg.ArtifactID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if artifact == nil {
+		server.handleHTTPError(c, errors.New("Failed to delete artifact, artifact with Id <"+msg.ArtifactID+"> not found"), http.StatusNotFound)
+		return
+	}
+
+	err = server.validator.RequireExecutorMembership(recoveredID, artifact.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	err = server.controller.deleteArtifact(artifact.ID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	err = server.artifactStorage.Delete(artifactKey(artifact))
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ArtifactId": artifact.ID}).Debug("Deleting artifact")
+
+	server.sendEmptyHTTPReply(c, payloadType)
+}
+
+// byteCounter counts the number of bytes read from the underlying reader
+type byteCounter struct {
+	reader io.Reader
+	count  int64
+}
+
+func (counter *byteCounter) Read(p []byte) (int, error) {
+	n, err := counter.reader.Read(p)
+	counter.count += int64(n)
+	return n, err
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddArtifactSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	_, err := client.AddArtifact(env.colony1ID, "model.bin", strings.NewReader("model data"), false, env.executor2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.AddArtifact(env.colony1ID, "model.bin", strings.NewReader("model data"), false, env.colony1PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.AddArtifact(env.colony1ID, "model.bin", strings.NewReader("model data"), false, env.executor1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
+
+func TestGetArtifactSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	addedArtifact, err := client.AddArtifact(env.colony1ID, "model.bin", strings.NewReader("model data"), false, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.GetArtifact(addedArtifact.ID, env.executor2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.GetArtifact(addedArtifact.ID, env.executor1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	_, err = client.GetArtifacts(env.colony1ID, 100, env.executor2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.GetArtifacts(env.colony1ID, 100, env.executor1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	var buf bytes.Buffer
+	_, err = client.DownloadArtifact(addedArtifact.ID, &buf, env.executor2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.DownloadArtifact(addedArtifact.ID, &buf, env.executor1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
+
+func TestDeleteArtifactSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	addedArtifact, err := client.AddArtifact(env.colony1ID, "model.bin", strings.NewReader("model data"), false, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	err = client.DeleteArtifact(addedArtifact.ID, env.executor2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	err = client.DeleteArtifact(addedArtifact.ID, env.colony1PrvKey)
+	assert.NotNil(t, err) // Should not work
+	err = client.DeleteArtifact(addedArtifact.ID, env.executor1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"bytes"
+	"crypto/tls"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
+	"github.com/colonyos/colonies/pkg/storage"
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddArtifact(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	content := "model data"
+	addedArtifact, err := client.AddArtifact(env.colonyID, "model.bin", strings.NewReader(content), false, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, addedArtifact.ColonyID, env.colonyID)
+	assert.Equal(t, addedArtifact.Name, "model.bin")
+	assert.Equal(t, addedArtifact.Size, int64(len(content)))
+	assert.Equal(t, addedArtifact.ExecutorID, env.executorID)
+
+	hash, _, err := storage.Hash(strings.NewReader(content))
+	assert.Nil(t, err)
+	assert.Equal(t, addedArtifact.Hash, hash)
+
+	artifactFromServer, err := client.GetArtifact(addedArtifact.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.True(t, addedArtifact.Equals(artifactFromServer))
+
+	var buf bytes.Buffer
+	_, err = client.DownloadArtifact(addedArtifact.ID, &buf, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, buf.String(), content)
+
+	_, err = client.GetArtifact(core.GenerateRandomID(), env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestAddArtifactInvalidHash(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	// The signed hash does not match the content
+	msg := rpc.CreateAddArtifactMsg(env.colonyID, "model.bin", core.GenerateRandomID(), 10, false)
+	jsonString, err := msg.ToJSON()
+	assert.Nil(t, err)
+	header, err := createTestArtifactMsgHeader(rpc.AddArtifactPayloadType, jsonString, env.executorPrvKey)
+	assert.Nil(t, err)
+	resp, err := postTestArtifact(header, "model data")
+	assert.Nil(t, err)
+	assert.True(t, resp.IsError())
+
+	// The content is larger than the signed size
+	hash, _, err := storage.Hash(strings.NewReader("model data"))
+	assert.Nil(t, err)
+	msg = rpc.CreateAddArtifactMsg(env.colonyID, "model.bin", hash, 5, false)
+	jsonString, err = msg.ToJSON()
+	assert.Nil(t, err)
+	header, err = createTestArtifactMsgHeader(rpc.AddArtifactPayloadType, jsonString, env.executorPrvKey)
+	assert.Nil(t, err)
+	resp, err = postTestArtifact(header, "model data")
+	assert.Nil(t, err)
+	assert.True(t, resp.IsError())
+
+	// A signed message of another type is not accepted
+	header, err = createTestArtifactMsgHeader(rpc.DeleteArtifactPayloadType, jsonString, env.executorPrvKey)
+	assert.Nil(t, err)
+	resp, err = postTestArtifact(header, "model data")
+	assert.Nil(t, err)
+	assert.True(t, resp.IsError())
+
+	artifacts, err := client.GetArtifacts(env.colonyID, 100, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, artifacts, 0)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestGetArtifacts(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	_, err := client.AddArtifact(env.colonyID, "model1.bin", strings.NewReader("model1"), false, env.executorPrvKey)
+	assert.Nil(t, err)
+	_, err = client.AddArtifact(env.colonyID, "model2.bin", strings.NewReader("model2"), false, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	artifacts, err := client.GetArtifacts(env.colonyID, 100, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, artifacts, 2)
+
+	artifacts, err = client.GetArtifacts(env.colonyID, 1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, artifacts, 1)
+
+	_, err = client.GetArtifacts(env.colonyID, MAX_COUNT+1, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestDeleteArtifact(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	addedArtifact, err := client.AddArtifact(env.colonyID, "model.bin", strings.NewReader("model data"), false, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	err = client.DeleteArtifact(addedArtifact.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.GetArtifact(addedArtifact.ID, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	var buf bytes.Buffer
+	_, err = client.DownloadArtifact(addedArtifact.ID, &buf, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	err = client.DeleteArtifact(addedArtifact.ID, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func createTestArtifactMsgHeader(payloadType string, jsonString string, prvKey string) (string, error) {
+	rpcMsg, err := rpc.CreateRPCMsg(payloadType, jsonString, prvKey)
+	if err != nil {
+		return "", err
+	}
+
+	return rpcMsg.ToJSON()
+}
+
+func postTestArtifact(header string, content string) (*resty.Response, error) {
+	protocol := "https"
+	if Insecure {
+		protocol = "http"
+	}
+
+	restyClient := resty.New()
+	restyClient.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: SkipTLSVerify})
+
+	return restyClient.R().
+		SetHeader(rpc.ArtifactMsgHeader, header).
+		SetBody(strings.NewReader(content)).
+		Post(protocol + "://" + TESTHOST + ":" + strconv.Itoa(TESTPORT) + "/artifact")
+}
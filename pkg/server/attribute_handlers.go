@@ -28,7 +28,7 @@ func (server *ColoniesServer) handleAddAttributeHTTPRequest(c *gin.Context, reco
 		return
 	}
 	if process == nil {
-		server.handleHTTPError(c, errors.New("Failed to add attribute, process is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to add attribute, process is nil"), http.StatusNotFound)
 		return
 	}
 
@@ -90,7 +90,7 @@ func (server *ColoniesServer) handleGetAttributeHTTPRequest(c *gin.Context, reco
 		return
 	}
 	if process == nil {
-		server.handleHTTPError(c, errors.New("Failed to get attribute, process is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to get attribute, process is nil"), http.StatusNotFound)
 		return
 	}
 
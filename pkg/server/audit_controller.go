@@ -0,0 +1,65 @@
+package server
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+// addAuditRecord stores an audit record, if the colony is not known from the payload, the record is added to the
+// colony of the identity that signed the call
+func (controller *coloniesController) addAuditRecord(auditRecord *core.AuditRecord) error {
+	cmd := &command{threaded: true, errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			if auditRecord.ColonyID == "" {
+				colony, err := controller.db.GetColonyByID(auditRecord.RecoveredID)
+				if err != nil {
+					cmd.errorChan <- err
+					return
+				}
+				if colony != nil {
+					auditRecord.ColonyID = colony.ID
+				} else {
+					executor, err := controller.db.GetExecutorByID(auditRecord.RecoveredID)
+					if err != nil {
+						cmd.errorChan <- err
+						return
+					}
+					if executor != nil {
+						auditRecord.ColonyID = executor.ColonyID
+					}
+				}
+			}
+
+			cmd.errorChan <- controller.db.AddAuditRecord(auditRecord)
+		}}
+
+	controller.cmdQueue <- cmd
+	return <-cmd.errorChan
+}
+
+func (controller *coloniesController) getAuditLog(colonyID string, count int) ([]*core.AuditRecord, error) {
+	cmd := &command{threaded: true, auditLogReplyChan: make(chan []*core.AuditRecord, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			if count > MAX_COUNT {
+				cmd.errorChan <- errors.New("Count is larger than MaxCount limit <" + strconv.Itoa(MAX_COUNT) + ">")
+				return
+			}
+			auditLog, err := controller.db.FindAuditLog(colonyID, count)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.auditLogReplyChan <- auditLog
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case auditLog := <-cmd.auditLogReplyChan:
+		return auditLog, nil
+	}
+}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// auditedPayloadTypes are the payload types that change the state of the server. Assign is left out since
+// executors poll it continuously, assignments are recorded in the process history instead. AddLog is left
+// out since logs are streamed in small chunks and are already stored together with the executor id.
+var auditedPayloadTypes = map[string]bool{
+	rpc.AddColonyPayloadType:              true,
+	rpc.DeleteColonyPayloadType:           true,
+	rpc.RenameColonyPayloadType:           true,
+	rpc.AddExecutorPayloadType:            true,
+	rpc.ApproveExecutorPayloadType:        true,
+	rpc.RejectExecutorPayloadType:         true,
+	rpc.DeleteExecutorPayloadType:         true,
+	rpc.AddFunctionPayloadType:            true,
+	rpc.DeleteFunctionPayloadType:         true,
+	rpc.SubmitFunctionSpecPayloadType:     true,
+	rpc.DeleteProcessPayloadType:          true,
+	rpc.DeleteAllProcessesPayloadType:     true,
+	rpc.CloseSuccessfulPayloadType:        true,
+	rpc.CloseFailedPayloadType:            true,
+	rpc.AddAttributePayloadType:           true,
+	rpc.SubmitWorkflowSpecPayloadType:     true,
+	rpc.DeleteProcessGraphPayloadType:     true,
+	rpc.DeleteAllProcessGraphsPayloadType: true,
+	rpc.RetryProcessGraphPayloadType:      true,
+	rpc.AddChildPayloadType:               true,
+	rpc.AddGeneratorPayloadType:           true,
+	rpc.UpdateGeneratorPayloadType:        true,
+	rpc.PackGeneratorPayloadType:          true,
+	rpc.DeleteGeneratorPayloadType:        true,
+	rpc.AddCronPayloadType:                true,
+	rpc.UpdateCronPayloadType:             true,
+	rpc.RunCronPayloadType:                true,
+	rpc.DeleteCronPayloadType:             true,
+	rpc.AddWorkflowTemplatePayloadType:    true,
+	rpc.DeleteWorkflowTemplatePayloadType: true,
+	rpc.AddArtifactPayloadType:            true,
+	rpc.DeleteArtifactPayloadType:         true,
+	rpc.AddWebhookPayloadType:             true,
+	rpc.DeleteWebhookPayloadType:          true,
+	rpc.AddSecretPayloadType:              true,
+	rpc.DeleteSecretPayloadType:           true,
+	rpc.RotateKeyPayloadType:              true,
+	rpc.AddColonyAdminPayloadType:         true,
+	rpc.DeleteColonyAdminPayloadType:      true,
+	rpc.ResetDatabasePayloadType:          true,
+}
+
+const auditColonyKey = "auditcolonyid"
+
+// setAuditColony records the colony a handler verified the request against, e.g. the colony of a stored cron, it
+// takes precedence over any colony Id in the payload so that calls always end up in the audit log of the colony
+// they affected
+func setAuditColony(c *gin.Context, colonyID string) {
+	c.Set(auditColonyKey, colonyID)
+}
+
+// audit records a handled RPC call in the audit log, the outcome is taken from the reply written by the handler
+func (server *ColoniesServer) audit(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	targetIDs, payloadColonyID := extractTargetIDs(jsonString)
+	colonyID := c.GetString(auditColonyKey)
+	if colonyID == "" {
+		colonyID = payloadColonyID
+	}
+
+	errMsg := ""
+	if lastErr := c.Errors.Last(); lastErr != nil {
+		errMsg = lastErr.Error()
+	}
+
+	auditRecord := core.CreateAuditRecord(colonyID, recoveredID, payloadType, targetIDs, c.Writer.Status(), errMsg)
+	err := server.controller.addAuditRecord(auditRecord)
+	if err != nil {
+		log.WithFields(log.Fields{"PayloadType": payloadType, "RecoveredID": recoveredID, "Error": err}).Error("Failed to add audit record")
+	}
+}
+
+// extractTargetIDs returns all Ids found in a payload as key=value pairs, e.g. processid=<id>, and the colony Id
+// if the payload contains one
+func extractTargetIDs(jsonString string) ([]string, string) {
+	var payload interface{}
+	if err := json.Unmarshal([]byte(jsonString), &payload); err != nil {
+		return []string{}, ""
+	}
+
+	ids := make(map[string]bool)
+	colonyID := ""
+	var visit func(value interface{})
+	visit = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			for key, child := range v {
+				str, ok := child.(string)
+				if ok && str != "" && strings.HasSuffix(key, "id") {
+					ids[key+"="+str] = true
+					if key == "colonyid" && colonyID == "" {
+						colonyID = str
+					}
+					continue
+				}
+				visit(child)
+			}
+		case []interface{}:
+			for _, child := range v {
+				visit(child)
+			}
+		}
+	}
+	visit(payload)
+
+	targetIDs := make([]string, 0, len(ids))
+	for id := range ids {
+		targetIDs = append(targetIDs, id)
+	}
+	sort.Strings(targetIDs)
+
+	return targetIDs, colonyID
+}
+
+func (server *ColoniesServer) handleGetAuditLogHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateGetAuditLogMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to get audit log, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to get audit log, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	err = server.validator.RequireColonyOwner(recoveredID, msg.ColonyID)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	auditLog, err := server.controller.getAuditLog(msg.ColonyID, msg.Count)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	jsonString, err = core.ConvertAuditRecordArrayToJSON(auditLog)
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ColonyId": msg.ColonyID, "Count": msg.Count}).Debug("Getting audit log")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
@@ -0,0 +1,25 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAuditLogSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	_, err := client.GetAuditLog(env.colony1ID, 100, env.executor1PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.GetAuditLog(env.colony1ID, 100, env.colony2PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.GetAuditLog(env.colony1ID, 100, env.colony1PrvKey)
+	assert.Nil(t, err)
+
+	server.Shutdown()
+	<-done
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractTargetIDs(t *testing.T) {
+	targetIDs, colonyID := extractTargetIDs(`{"msgtype":"deleteprocessmsg","processid":"1"}`)
+	assert.Equal(t, targetIDs, []string{"processid=1"})
+	assert.Equal(t, colonyID, "")
+
+	targetIDs, colonyID = extractTargetIDs(`{"msgtype":"submitfuncspecmsg","spec":{"nodename":"","conditions":{"colonyid":"2","executorids":["3"]}},"processgraphid":""}`)
+	assert.Equal(t, targetIDs, []string{"colonyid=2"})
+	assert.Equal(t, colonyID, "2")
+
+	targetIDs, _ = extractTargetIDs("invalid json")
+	assert.Len(t, targetIDs, 0)
+}
+
+func findAuditRecord(auditLog []*core.AuditRecord, payloadType string) *core.AuditRecord {
+	for _, auditRecord := range auditLog {
+		if auditRecord.PayloadType == payloadType {
+			return auditRecord
+		}
+	}
+
+	return nil
+}
+
+func TestGetAuditLog(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	funcSpec := utils.CreateTestFunctionSpec(env.colonyID)
+	addedProcess, err := client.Submit(funcSpec, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	err = client.DeleteProcess(addedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	// Only the colony owner can approve executors
+	err = client.ApproveExecutor(env.executorID, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	// Read-only calls are not audited
+	_, err = client.GetWaitingProcesses(env.colonyID, "", 100, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	auditLog, err := client.GetAuditLog(env.colonyID, 100, env.colonyPrvKey)
+	assert.Nil(t, err)
+
+	auditRecord := findAuditRecord(auditLog, "deleteprocessmsg")
+	assert.NotNil(t, auditRecord)
+	assert.Equal(t, auditRecord.RecoveredID, env.executorID)
+	assert.Equal(t, auditRecord.ColonyID, env.colonyID)
+	assert.Equal(t, auditRecord.TargetIDs, []string{"processid=" + addedProcess.ID})
+	assert.Equal(t, auditRecord.Status, http.StatusOK)
+	assert.True(t, auditRecord.Successful())
+
+	auditRecord = findAuditRecord(auditLog, "approveexecutormsg") // Newest first, i.e. the failed call
+	assert.NotNil(t, auditRecord)
+	assert.Equal(t, auditRecord.RecoveredID, env.executorID)
+	assert.Equal(t, auditRecord.Status, http.StatusForbidden)
+	assert.NotEmpty(t, auditRecord.Error)
+
+	assert.NotNil(t, findAuditRecord(auditLog, "submitfuncspecmsg"))
+	assert.Nil(t, findAuditRecord(auditLog, "getprocessesmsg"))
+
+	auditLog, err = client.GetAuditLog(env.colonyID, 1, env.colonyPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, auditLog, 1)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestGetAuditLogVerifiedColony(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	cron := utils.FakeCron(t, env.colony1ID)
+	addedCron, err := client.AddCron(cron, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	// The payload refers to colony2, but membership is verified against the stored cron in colony1
+	addedCron.ColonyID = env.colony2ID
+	_, err = client.UpdateCron(addedCron, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	auditLog, err := client.GetAuditLog(env.colony1ID, 100, env.colony1PrvKey)
+	assert.Nil(t, err)
+	auditRecord := findAuditRecord(auditLog, "updatecronmsg")
+	assert.NotNil(t, auditRecord)
+	assert.Equal(t, auditRecord.RecoveredID, env.executor1ID)
+	assert.Equal(t, auditRecord.ColonyID, env.colony1ID)
+
+	auditLog, err = client.GetAuditLog(env.colony2ID, 100, env.colony2PrvKey)
+	assert.Nil(t, err)
+	assert.Nil(t, findAuditRecord(auditLog, "updatecronmsg"))
+
+	server.Shutdown()
+	<-done
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/colonyos/colonies/pkg/cluster"
 	"github.com/colonyos/colonies/pkg/core"
@@ -16,52 +17,70 @@ import (
 )
 
 type command struct {
-	stop                   bool
-	errorChan              chan error
-	process                *core.Process
-	count                  int
-	colony                 *core.Colony
-	colonyID               string
-	colonyReplyChan        chan *core.Colony
-	coloniesReplyChan      chan []*core.Colony
-	processReplyChan       chan *core.Process
-	processesReplyChan     chan []*core.Process
-	processGraphReplyChan  chan *core.ProcessGraph
-	processGraphsReplyChan chan []*core.ProcessGraph
-	statisticsReplyChan    chan *core.Statistics
-	executorReplyChan      chan *core.Executor
-	executorsReplyChan     chan []*core.Executor
-	attributeReplyChan     chan *core.Attribute
-	generatorReplyChan     chan *core.Generator
-	generatorsReplyChan    chan []*core.Generator
-	cronReplyChan          chan *core.Cron
-	cronsReplyChan         chan []*core.Cron
-	functionReplyChan      chan *core.Function
-	functionsReplyChan     chan []*core.Function
-	threaded               bool
-	handler                func(cmd *command)
+	stop                       bool
+	errorChan                  chan error
+	process                    *core.Process
+	count                      int
+	colony                     *core.Colony
+	colonyID                   string
+	colonyReplyChan            chan *core.Colony
+	coloniesReplyChan          chan []*core.Colony
+	processReplyChan           chan *core.Process
+	processesReplyChan         chan []*core.Process
+	processGraphReplyChan      chan *core.ProcessGraph
+	processGraphsReplyChan     chan []*core.ProcessGraph
+	statisticsReplyChan        chan *core.Statistics
+	executorReplyChan          chan *core.Executor
+	executorsReplyChan         chan []*core.Executor
+	attributeReplyChan         chan *core.Attribute
+	generatorReplyChan         chan *core.Generator
+	generatorsReplyChan        chan []*core.Generator
+	cronReplyChan              chan *core.Cron
+	cronsReplyChan             chan []*core.Cron
+	runHistoryReplyChan        chan []*core.RunRecord
+	processEventsReplyChan     chan []*core.ProcessEvent
+	auditLogReplyChan          chan []*core.AuditRecord
+	logReplyChan               chan *core.Log
+	logsReplyChan              chan []*core.Log
+	artifactReplyChan          chan *core.Artifact
+	artifactsReplyChan         chan []*core.Artifact
+	webhookReplyChan           chan *core.Webhook
+	webhooksReplyChan          chan []*core.Webhook
+	webhookDeliveriesReplyChan chan []*core.WebhookDelivery
+	secretReplyChan            chan *core.Secret
+	secretsReplyChan           chan []*core.Secret
+	colonyAdminReplyChan       chan *core.ColonyAdmin
+	colonyAdminsReplyChan      chan []*core.ColonyAdmin
+	workflowSpecReplyChan      chan *core.WorkflowSpec
+	workflowTemplateReplyChan  chan *core.WorkflowTemplate
+	workflowTemplatesReplyChan chan []*core.WorkflowTemplate
+	functionReplyChan          chan *core.Function
+	functionsReplyChan         chan []*core.Function
+	threaded                   bool
+	handler                    func(cmd *command)
 }
 
 type coloniesController struct {
-	db               database.Database
-	cmdQueue         chan *command
-	blockingCmdQueue chan *command
-	planner          planner.Planner
-	wsSubCtrl        *wsSubscriptionController
-	relayServer      *cluster.RelayServer
-	eventHandler     *eventHandler
-	stopFlag         bool
-	stopMutex        sync.Mutex
-	leaderMutex      sync.Mutex
-	thisNode         cluster.Node
-	clusterConfig    cluster.Config
-	etcdServer       *cluster.EtcdServer
-	leader           bool
-	generatorPeriod  int
-	cronPeriod       int
-	retention        bool
-	retentionPolicy  int64
-	retentionPeriod  int
+	db                database.Database
+	cmdQueue          chan *command
+	blockingCmdQueue  chan *command
+	planner           planner.Planner
+	wsSubCtrl         *wsSubscriptionController
+	relayServer       *cluster.RelayServer
+	eventHandler      *eventHandler
+	webhookDispatcher *webhookDispatcher
+	stopFlag          bool
+	stopMutex         sync.Mutex
+	leaderMutex       sync.Mutex
+	thisNode          cluster.Node
+	clusterConfig     cluster.Config
+	etcdServer        *cluster.EtcdServer
+	leader            bool
+	generatorPeriod   int
+	cronPeriod        int
+	retention         bool
+	retentionPolicy   int64
+	retentionPeriod   int
 }
 
 func createColoniesController(db database.Database,
@@ -72,7 +91,8 @@ func createColoniesController(db database.Database,
 	cronPeriod int,
 	retention bool,
 	retentionPolicy int64,
-	retentionPeriod int) *coloniesController {
+	retentionPeriod int,
+	allowPrivateWebhooks bool) *coloniesController {
 
 	controller := &coloniesController{}
 	controller.db = db
@@ -90,7 +110,8 @@ func createColoniesController(db database.Database,
 
 	controller.relayServer = cluster.CreateRelayServer(controller.thisNode, controller.clusterConfig)
 	controller.eventHandler = createEventHandler(controller.relayServer)
-	controller.wsSubCtrl = createWSSubscriptionController(controller.eventHandler)
+	controller.wsSubCtrl = createWSSubscriptionController(controller.eventHandler, controller.db)
+	controller.webhookDispatcher = createWebhookDispatcher(controller.db, allowPrivateWebhooks)
 	controller.planner = basic.CreatePlanner()
 
 	controller.cmdQueue = make(chan *command)
@@ -103,6 +124,8 @@ func createColoniesController(db database.Database,
 	go controller.generatorTriggerLoop()
 	go controller.cronTriggerLoop()
 	go controller.retentionWorker()
+	go controller.executorLivenessLoop()
+	go controller.colonyEventPruneLoop()
 
 	return controller
 }
@@ -159,6 +182,51 @@ func (controller *coloniesController) subscribeProcess(executorID string, subscr
 	return <-cmd.errorChan
 }
 
+func (controller *coloniesController) subscribeColonyEvents(executorID string, subscription *subscription) error {
+	cmd := &command{threaded: false, errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			controller.wsSubCtrl.addColonyEventsSubscriber(executorID, subscription)
+			cmd.errorChan <- nil
+		}}
+	controller.blockingCmdQueue <- cmd
+
+	return <-cmd.errorChan
+}
+
+// publishColonyEvent stores the event, which assigns its sequence number, and sends it to colony event subscribers
+// and webhooks
+func (controller *coloniesController) publishColonyEvent(event *core.ColonyEvent) {
+	err := controller.db.AddColonyEvent(event)
+	if err != nil {
+		log.WithFields(log.Fields{"ColonyId": event.ColonyID, "Kind": event.Kind, "Type": event.Type, "Error": err}).Error("Failed to store colony event")
+	}
+
+	controller.eventHandler.signalColonyEvent(event)
+	controller.webhookDispatcher.dispatch(event)
+}
+
+func (controller *coloniesController) signalColonyEvent(colonyID string, kind string, eventType string, targetID string, state int, executorID string) {
+	controller.publishColonyEvent(core.CreateColonyEvent(colonyID, kind, eventType, targetID, state, executorID))
+}
+
+func (controller *coloniesController) signalProcessEvent(process *core.Process, eventType string, executorID string) {
+	controller.publishColonyEvent(core.CreateProcessColonyEvent(process, eventType, executorID))
+}
+
+// signalProcessGraphResolved signals an event if a processgraph finished when it was resolved
+func (controller *coloniesController) signalProcessGraphResolved(processGraph *core.ProcessGraph, prevState int) {
+	if processGraph.State == prevState {
+		return
+	}
+
+	switch processGraph.State {
+	case core.SUCCESS:
+		controller.signalColonyEvent(processGraph.ColonyID, core.PROCESSGRAPH_KIND, core.SUCCESSFUL_EVENT, processGraph.ID, processGraph.State, "")
+	case core.FAILED:
+		controller.signalColonyEvent(processGraph.ColonyID, core.PROCESSGRAPH_KIND, core.FAILED_EVENT, processGraph.ID, processGraph.State, "")
+	}
+}
+
 func (controller *coloniesController) getColonies() ([]*core.Colony, error) {
 	cmd := &command{threaded: true, coloniesReplyChan: make(chan []*core.Colony),
 		errorChan: make(chan error, 1),
@@ -301,6 +369,7 @@ func (controller *coloniesController) addExecutor(executor *core.Executor, allow
 				cmd.errorChan <- err
 				return
 			}
+			controller.signalColonyEvent(addedExecutor.ColonyID, core.EXECUTOR_KIND, core.ADDED_EVENT, addedExecutor.ID, addedExecutor.State, "")
 			cmd.executorReplyChan <- addedExecutor
 		}}
 
@@ -364,7 +433,13 @@ func (controller *coloniesController) approveExecutor(executorID string) error {
 				cmd.errorChan <- err
 				return
 			}
-			cmd.errorChan <- controller.db.ApproveExecutor(executor)
+			err = controller.db.ApproveExecutor(executor)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			controller.signalColonyEvent(executor.ColonyID, core.EXECUTOR_KIND, core.APPROVED_EVENT, executor.ID, core.APPROVED, "")
+			cmd.errorChan <- nil
 		}}
 
 	controller.cmdQueue <- cmd
@@ -379,7 +454,13 @@ func (controller *coloniesController) rejectExecutor(executorID string) error {
 				cmd.errorChan <- err
 				return
 			}
-			cmd.errorChan <- controller.db.RejectExecutor(executor)
+			err = controller.db.RejectExecutor(executor)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			controller.signalColonyEvent(executor.ColonyID, core.EXECUTOR_KIND, core.REJECTED_EVENT, executor.ID, core.REJECTED, "")
+			cmd.errorChan <- nil
 		}}
 
 	controller.cmdQueue <- cmd
@@ -389,8 +470,20 @@ func (controller *coloniesController) rejectExecutor(executorID string) error {
 func (controller *coloniesController) deleteExecutor(executorID string) error {
 	cmd := &command{threaded: true, errorChan: make(chan error, 1),
 		handler: func(cmd *command) {
-			err := controller.db.DeleteExecutorByID(executorID)
-			cmd.errorChan <- err
+			executor, err := controller.db.GetExecutorByID(executorID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			err = controller.db.DeleteExecutorByID(executorID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			if executor != nil {
+				controller.signalColonyEvent(executor.ColonyID, core.EXECUTOR_KIND, core.REMOVED_EVENT, executor.ID, executor.State, "")
+			}
+			cmd.errorChan <- nil
 		}}
 
 	controller.cmdQueue <- cmd
@@ -412,6 +505,8 @@ func (controller *coloniesController) addProcessToDB(process *core.Process) (*co
 		return nil, err
 	}
 
+	controller.signalProcessEvent(addedProcess, core.SUBMITTED_EVENT, "")
+
 	return addedProcess, nil
 }
 
@@ -560,6 +655,27 @@ func (controller *coloniesController) getProcess(processID string) (*core.Proces
 	}
 }
 
+func (controller *coloniesController) getProcessEvents(processID string) ([]*core.ProcessEvent, error) {
+	cmd := &command{threaded: true, processEventsReplyChan: make(chan []*core.ProcessEvent, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			events, err := controller.db.FindProcessEvents(processID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.processEventsReplyChan <- events
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case events := <-cmd.processEventsReplyChan:
+		return events, nil
+	}
+}
+
 func (controller *coloniesController) findProcessHistory(colonyID string, executorID string, seconds int, state int) ([]*core.Process, error) {
 	cmd := &command{threaded: true, processesReplyChan: make(chan []*core.Process),
 		errorChan: make(chan error, 1),
@@ -702,11 +818,35 @@ func (controller *coloniesController) updateProcessGraph(graph *core.ProcessGrap
 }
 
 func (controller *coloniesController) createProcessGraph(workflowSpec *core.WorkflowSpec, args []interface{}, rootInput []interface{}) (*core.ProcessGraph, error) {
+	return controller.createSubProcessGraph(workflowSpec, args, rootInput, "")
+}
+
+// createSubProcessGraph creates a processgraph, if parentProcessID is set the processgraph is a sub-workflow
+// and the parent process is closed when the processgraph finishes
+func (controller *coloniesController) createSubProcessGraph(workflowSpec *core.WorkflowSpec, args []interface{}, rootInput []interface{}, parentProcessID string) (*core.ProcessGraph, error) {
+	workflowSpec, err := controller.resolveWorkflowTemplate(workflowSpec)
+	if err != nil {
+		log.WithFields(log.Fields{"Error": err}).Error("Failed to instantiate workflow template")
+		return nil, err
+	}
+
+	// Nothing is added to the database unless the whole workflow is valid
+	err = VerifyWorkflowSpec(workflowSpec)
+	if err != nil {
+		log.WithFields(log.Fields{"Error": err}).Error("Invalid workflow")
+		return nil, err
+	}
+
 	processgraph, err := core.CreateProcessGraph(workflowSpec.ColonyID)
 	if err != nil {
 		log.WithFields(log.Fields{"Error": err}).Error("Failed to create processgraph")
 		return nil, err
 	}
+	processgraph.ParentProcessID = parentProcessID
+	processgraph.FailurePolicy = workflowSpec.FailurePolicy
+	if workflowSpec.Deadline > 0 {
+		processgraph.Deadline = time.Now().Add(time.Duration(workflowSpec.Deadline) * time.Second)
+	}
 
 	// Create all processes
 	processMap := make(map[string]*core.Process)
@@ -742,6 +882,11 @@ func (controller *coloniesController) createProcessGraph(workflowSpec *core.Work
 				}
 			}
 
+			if process.FunctionSpec.Workflow != nil {
+				// Sub-workflows are never assigned to executors, they run as separate processgraphs
+				process.State = core.RUNNING
+			}
+
 			processgraph.AddRoot(process.ID)
 		} else {
 			// The process has to wait for its parents
@@ -753,16 +898,6 @@ func (controller *coloniesController) createProcessGraph(workflowSpec *core.Work
 		processMap[process.FunctionSpec.NodeName] = process
 	}
 
-	processgraph.ProcessIDs = processIDs
-	err = controller.db.AddProcessGraph(processgraph)
-	if err != nil {
-		msg := "Failed to create processgraph, failed to add processgraph"
-		log.WithFields(log.Fields{"Error": err}).Error(msg)
-		return nil, errors.New(msg)
-	}
-
-	log.WithFields(log.Fields{"ProcessGraphId": processgraph.ID}).Debug("Submitting workflow")
-
 	// Create dependencies
 	for _, process := range processMap {
 		for _, dependsOn := range process.FunctionSpec.Conditions.Dependencies {
@@ -777,6 +912,17 @@ func (controller *coloniesController) createProcessGraph(workflowSpec *core.Work
 		}
 	}
 
+	processgraph.ProcessIDs = processIDs
+	err = controller.db.AddProcessGraph(processgraph)
+	if err != nil {
+		msg := "Failed to create processgraph, failed to add processgraph"
+		log.WithFields(log.Fields{"Error": err}).Error(msg)
+		return nil, errors.New(msg)
+	}
+
+	log.WithFields(log.Fields{"ProcessGraphId": processgraph.ID}).Debug("Submitting workflow")
+	controller.signalColonyEvent(processgraph.ColonyID, core.PROCESSGRAPH_KIND, core.SUBMITTED_EVENT, processgraph.ID, processgraph.State, "")
+
 	// Now, start all processes
 	for _, process := range processMap {
 		// This function is called from the controller, so it OK to use the database layer directly, in fact
@@ -789,13 +935,187 @@ func (controller *coloniesController) createProcessGraph(workflowSpec *core.Work
 			return nil, errors.New(msg)
 		}
 		if !addedProcess.WaitForParents {
-			controller.eventHandler.signal(addedProcess)
+			if addedProcess.FunctionSpec.Workflow != nil {
+				err = controller.startSubWorkflow(addedProcess)
+				if err != nil {
+					return nil, err
+				}
+			} else {
+				controller.eventHandler.signal(addedProcess)
+			}
 		}
 	}
 
 	return processgraph, nil
 }
 
+// startSubWorkflow creates a processgraph for a process running a sub-workflow, the input of the process,
+// i.e. the output of its parents, is used as input to the roots of the sub-workflow
+func (controller *coloniesController) startSubWorkflow(process *core.Process) error {
+	input := process.Input
+	if len(process.Parents) > 0 {
+		input = make([]interface{}, 0)
+		for _, parentID := range process.Parents {
+			parentProcess, err := controller.db.GetProcessByID(parentID)
+			if err != nil {
+				return err
+			}
+			input = append(input, parentProcess.Output...)
+		}
+		err := controller.db.SetInput(process.ID, input)
+		if err != nil {
+			return err
+		}
+	}
+
+	depth, err := controller.subWorkflowDepth(process)
+	if err != nil {
+		return err
+	}
+	if depth >= MAX_SUB_WORKFLOW_DEPTH {
+		return errors.New("Failed to start sub-workflow, max sub-workflow depth <" + strconv.Itoa(MAX_SUB_WORKFLOW_DEPTH) + "> exceeded")
+	}
+
+	workflowSpec := *process.FunctionSpec.Workflow
+	workflowSpec.ColonyID = process.FunctionSpec.Conditions.ColonyID
+	subProcessGraph, err := controller.createSubProcessGraph(&workflowSpec, make([]interface{}, 0), input, process.ID)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{"ProcessId": process.ID, "ProcessGraphId": subProcessGraph.ID}).Debug("Started sub-workflow")
+
+	return nil
+}
+
+// subWorkflowDepth returns the number of sub-workflows the process is nested in
+func (controller *coloniesController) subWorkflowDepth(process *core.Process) (int, error) {
+	depth := 0
+	processGraphID := process.ProcessGraphID
+	for processGraphID != "" {
+		graph, err := controller.db.GetProcessGraphByID(processGraphID)
+		if err != nil {
+			return 0, err
+		}
+		if graph == nil || graph.ParentProcessID == "" {
+			break
+		}
+		parentProcess, err := controller.db.GetProcessByID(graph.ParentProcessID)
+		if err != nil {
+			return 0, err
+		}
+		if parentProcess == nil {
+			break
+		}
+		depth++
+		processGraphID = parentProcess.ProcessGraphID
+	}
+
+	return depth, nil
+}
+
+// startReleased notifies executors about processes released when resolving a processgraph, and starts
+// sub-workflows of released processes
+func (controller *coloniesController) startReleased(processGraph *core.ProcessGraph) {
+	for _, releasedProcess := range processGraph.Released() {
+		if releasedProcess.FunctionSpec.Workflow != nil {
+			err := controller.startSubWorkflow(releasedProcess)
+			if err != nil {
+				log.WithFields(log.Fields{"ProcessId": releasedProcess.ID, "Error": err}).Error("Failed to start sub-workflow")
+				err = controller.handleDefunctProcessgraph(processGraph.ID, releasedProcess.ID, err)
+				if err != nil {
+					log.Error(err)
+				}
+			}
+		} else {
+			controller.eventHandler.signal(releasedProcess)
+		}
+	}
+}
+
+// findInputBindingProcesses returns the processes the input bindings of process are resolved against. Instances
+// of a map node only have the map node as parent, so their bindings are resolved against the parents of the map
+// node, i.e. the dependencies declared in the workflow spec
+func (controller *coloniesController) findInputBindingProcesses(process *core.Process, parentProcesses []*core.Process) ([]*core.Process, error) {
+	if len(parentProcesses) != 1 || !parentProcesses[0].FunctionSpec.Map || parentProcesses[0].FunctionSpec.NodeName != process.FunctionSpec.NodeName {
+		return parentProcesses, nil
+	}
+
+	var mapParentProcesses []*core.Process
+	for _, parentID := range parentProcesses[0].Parents {
+		parentProcess, err := controller.db.GetProcessByID(parentID)
+		if err != nil {
+			return nil, err
+		}
+		mapParentProcesses = append(mapParentProcesses, parentProcess)
+	}
+
+	return mapParentProcesses, nil
+}
+
+// finishSubWorkflow closes the parent process of a sub-workflow once the sub-workflow has finished, the
+// output of the leaves of the sub-workflow becomes the output of the parent process
+func (controller *coloniesController) finishSubWorkflow(processGraph *core.ProcessGraph) error {
+	if processGraph.ParentProcessID == "" {
+		return nil
+	}
+
+	if processGraph.State != core.SUCCESS && processGraph.State != core.FAILED {
+		return nil
+	}
+
+	parentProcess, err := controller.db.GetProcessByID(processGraph.ParentProcessID)
+	if err != nil {
+		return err
+	}
+	if parentProcess == nil || parentProcess.State != core.RUNNING {
+		return nil
+	}
+
+	if processGraph.State == core.SUCCESS {
+		output, err := processGraph.LeafOutput()
+		if err != nil {
+			return err
+		}
+		err = controller.db.SetOutput(parentProcess.ID, output)
+		if err != nil {
+			return err
+		}
+		_, _, err = controller.db.MarkSuccessful(parentProcess.ID)
+		if err != nil {
+			return err
+		}
+		parentProcess.State = core.SUCCESS
+	} else {
+		err = controller.db.MarkFailed(parentProcess.ID, []string{"Sub-workflow with Id <" + processGraph.ID + "> failed"})
+		if err != nil {
+			return err
+		}
+		parentProcess.State = core.FAILED
+	}
+
+	log.WithFields(log.Fields{"ProcessId": parentProcess.ID, "ProcessGraphId": processGraph.ID}).Debug("Sub-workflow finished")
+
+	parentProcessGraph, err := controller.db.GetProcessGraphByID(parentProcess.ProcessGraphID)
+	if err != nil {
+		return err
+	}
+	if parentProcessGraph == nil {
+		return errors.New("Failed to finish sub-workflow, processgraph with Id <" + parentProcess.ProcessGraphID + "> not found")
+	}
+	parentProcessGraph.SetStorage(controller.db)
+	err = parentProcessGraph.Resolve()
+	if err != nil {
+		return err
+	}
+
+	controller.startReleased(parentProcessGraph)
+	controller.eventHandler.signal(parentProcess)
+
+	// The parent processgraph may itself be a sub-workflow
+	return controller.finishSubWorkflow(parentProcessGraph)
+}
+
 func (controller *coloniesController) submitWorkflowSpec(workflowSpec *core.WorkflowSpec) (*core.ProcessGraph, error) {
 	cmd := &command{threaded: false, processGraphReplyChan: make(chan *core.ProcessGraph, 1),
 		errorChan: make(chan error, 1),
@@ -1027,6 +1347,68 @@ func (controller *coloniesController) deleteProcessGraph(processID string) error
 	return <-cmd.errorChan
 }
 
+// retryProcessGraph re-runs a failed processgraph from the failed processes onward, successful processes are
+// kept. The deadline of the processgraph, if any, is restarted.
+func (controller *coloniesController) retryProcessGraph(processGraphID string) (*core.ProcessGraph, error) {
+	cmd := &command{threaded: true, processGraphReplyChan: make(chan *core.ProcessGraph, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			graph, err := controller.db.GetProcessGraphByID(processGraphID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			if graph == nil {
+				cmd.errorChan <- core.CreateError(core.ERROR_NOT_FOUND, "Failed to retry processgraph, processgraph with Id <"+processGraphID+"> not found")
+				return
+			}
+			if graph.State != core.FAILED {
+				cmd.errorChan <- errors.New("Failed to retry processgraph, only failed processgraphs can be retried")
+				return
+			}
+			if graph.ParentProcessID != "" {
+				cmd.errorChan <- errors.New("Failed to retry processgraph, processgraph is a sub-workflow, retry the parent workflow instead")
+				return
+			}
+
+			if !graph.Deadline.IsZero() {
+				graph.Deadline = time.Now().Add(graph.Deadline.Sub(graph.SubmissionTime))
+			}
+			err = controller.db.ResetProcessGraph(graph)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+
+			graph.SetStorage(controller.db)
+			retried, err := graph.Retry()
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+
+			log.WithFields(log.Fields{"ProcessGraphId": graph.ID, "Retried": retried}).Debug("Retrying processgraph")
+
+			controller.startReleased(graph)
+
+			err = controller.updateProcessGraph(graph)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+
+			cmd.processGraphReplyChan <- graph
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case graph := <-cmd.processGraphReplyChan:
+		return graph, nil
+	}
+}
+
 func (controller *coloniesController) deleteAllProcessGraphs(colonyID string, state int) error {
 	cmd := &command{threaded: true, errorChan: make(chan error, 1),
 		handler: func(cmd *command) {
@@ -1050,7 +1432,7 @@ func (controller *coloniesController) deleteAllProcessGraphs(colonyID string, st
 	return <-cmd.errorChan
 }
 
-func (controller *coloniesController) closeSuccessful(processID string, executorID string, output []interface{}) error {
+func (controller *coloniesController) closeSuccessful(processID string, executorID string, output []interface{}, namedOutput map[string]interface{}) error {
 	cmd := &command{threaded: true, errorChan: make(chan error, 1),
 		handler: func(cmd *command) {
 			process, err := controller.db.GetProcessByID(processID)
@@ -1063,6 +1445,14 @@ func (controller *coloniesController) closeSuccessful(processID string, executor
 				err = controller.db.SetOutput(processID, output)
 			}
 
+			if len(namedOutput) > 0 {
+				err = controller.db.SetNamedOutput(processID, namedOutput)
+				if err != nil {
+					cmd.errorChan <- err
+					return
+				}
+			}
+
 			waitingTime, processingTime, err := controller.db.MarkSuccessful(processID)
 			if err != nil {
 				cmd.errorChan <- err
@@ -1076,6 +1466,7 @@ func (controller *coloniesController) closeSuccessful(processID string, executor
 					cmd.errorChan <- err
 					return
 				}
+				prevState := processGraph.State
 				processGraph.SetStorage(controller.db)
 				err = processGraph.Resolve()
 				if err != nil {
@@ -1090,6 +1481,7 @@ func (controller *coloniesController) closeSuccessful(processID string, executor
 					cmd.errorChan <- err
 					return
 				}
+				controller.signalProcessGraphResolved(processGraph, prevState)
 
 				// This is process is now closed. This means that children processes can now execute,
 				// assuming all their parents are closed successfully
@@ -1098,6 +1490,15 @@ func (controller *coloniesController) closeSuccessful(processID string, executor
 					cmd.errorChan <- err
 					return
 				}
+
+				// Instances of map nodes are not children of the closed process, but may have been released as well
+				controller.startReleased(processGraph)
+
+				err = controller.finishSubWorkflow(processGraph)
+				if err != nil {
+					cmd.errorChan <- err
+					return
+				}
 			}
 
 			process.State = core.SUCCESS
@@ -1163,6 +1564,7 @@ func (controller *coloniesController) closeSuccessful(processID string, executor
 			}
 
 			controller.eventHandler.signal(process)
+			controller.signalProcessEvent(process, core.SUCCESSFUL_EVENT, executorID)
 			cmd.errorChan <- nil
 		}}
 
@@ -1178,7 +1580,7 @@ func (controller *coloniesController) notifyChildren(process *core.Process) erro
 		if err != nil {
 			return err
 		}
-		if parentProcess.State == core.SUCCESS {
+		if parentProcess.State == core.SUCCESS || parentProcess.State == core.SKIPPED {
 			counter++
 		}
 	}
@@ -1219,6 +1621,7 @@ func (controller *coloniesController) closeFailed(processID string, errs []strin
 					cmd.errorChan <- err
 					return
 				}
+				prevState := processGraph.State
 				processGraph.SetStorage(controller.db)
 				err = processGraph.Resolve()
 				if err != nil {
@@ -1233,12 +1636,22 @@ func (controller *coloniesController) closeFailed(processID string, errs []strin
 					cmd.errorChan <- err
 					return
 				}
+				controller.signalProcessGraphResolved(processGraph, prevState)
 
+				err = controller.finishSubWorkflow(processGraph)
+				if err != nil {
+					cmd.errorChan <- err
+					return
+				}
+
+				// Children of processes that are allowed to fail are released
+				controller.startReleased(processGraph)
 			}
 
 			process.State = core.FAILED
 
 			controller.eventHandler.signal(process)
+			controller.signalProcessEvent(process, core.FAILED_EVENT, process.AssignedExecutorID)
 			cmd.errorChan <- nil
 		}}
 
@@ -1246,6 +1659,37 @@ func (controller *coloniesController) closeFailed(processID string, errs []strin
 	return <-cmd.errorChan
 }
 
+// failExpiredProcessGraph resolves a processgraph that has passed its deadline, which fails all its remaining processes
+func (controller *coloniesController) failExpiredProcessGraph(processGraphID string) error {
+	cmd := &command{threaded: true, errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			processGraph, err := controller.db.GetProcessGraphByID(processGraphID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			if processGraph == nil {
+				cmd.errorChan <- core.CreateError(core.ERROR_NOT_FOUND, "Failed to resolve processgraph, processgraph with Id <"+processGraphID+"> not found")
+				return
+			}
+
+			log.WithFields(log.Fields{"ProcessGraphId": processGraph.ID, "Deadline": processGraph.Deadline}).Debug("Resolving processgraph (deadline exceeded)")
+			prevState := processGraph.State
+			processGraph.SetStorage(controller.db)
+			err = processGraph.Resolve()
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			controller.signalProcessGraphResolved(processGraph, prevState)
+
+			cmd.errorChan <- controller.finishSubWorkflow(processGraph)
+		}}
+
+	controller.cmdQueue <- cmd
+	return <-cmd.errorChan
+}
+
 func (controller *coloniesController) handleDefunctProcessgraph(processGraphID string, processID string, err error) error {
 	err2 := controller.db.MarkFailed(processID, []string{err.Error()})
 	if err2 != nil {
@@ -1326,8 +1770,10 @@ func (controller *coloniesController) assign(executorID string, colonyID string)
 					return
 				}
 
-				// Now, we need to collect the output from the parents and use ut as our input
+				// Now, we need to collect the output from the parents and use ut as our input, except for
+				// instances of map nodes which already got their input when the map node was expanded
 				var output []interface{}
+				var parentProcesses []*core.Process
 				for _, parentID := range selectedProcess.Parents {
 					parentProcess, err := controller.db.GetProcessByID(parentID)
 					if err != nil {
@@ -1336,13 +1782,42 @@ func (controller *coloniesController) assign(executorID string, colonyID string)
 						return
 					}
 					output = append(output, parentProcess.Output...)
+					parentProcesses = append(parentProcesses, parentProcess)
 				}
-				if len(selectedProcess.Parents) > 0 {
+				if len(selectedProcess.Parents) > 0 && len(selectedProcess.Input) == 0 {
 					controller.db.SetInput(selectedProcess.ID, output)
 					selectedProcess.Input = output
 				}
+
+				// Resolve input bindings, e.g. "inputs": {"model": "train.out.model"}, to named inputs
+				if len(selectedProcess.FunctionSpec.Inputs) > 0 {
+					bindingProcesses, err := controller.findInputBindingProcesses(selectedProcess, parentProcesses)
+					if err != nil {
+						log.Error(err)
+						cmd.errorChan <- err
+						return
+					}
+					namedInput, err := core.ResolveInputBindings(selectedProcess.FunctionSpec.Inputs, bindingProcesses)
+					if err != nil {
+						err2 := controller.handleDefunctProcessgraph(processGraph.ID, selectedProcess.ID, err)
+						if err2 != nil {
+							log.Error(err2)
+						}
+						log.Error(err)
+						cmd.errorChan <- err
+						return
+					}
+					err = controller.db.SetNamedInput(selectedProcess.ID, namedInput)
+					if err != nil {
+						log.Error(err)
+						cmd.errorChan <- err
+						return
+					}
+					selectedProcess.NamedInput = namedInput
+				}
 			}
 
+			controller.signalProcessEvent(selectedProcess, core.ASSIGNED_EVENT, executorID)
 			cmd.processReplyChan <- selectedProcess
 		}}
 
@@ -1364,8 +1839,15 @@ func (controller *coloniesController) unassignExecutor(processID string) error {
 				return
 			}
 
-			cmd.errorChan <- controller.db.Unassign(process)
+			executorID := process.AssignedExecutorID
+			err = controller.db.Unassign(process)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
 			controller.eventHandler.signal(process)
+			controller.signalProcessEvent(process, core.UNASSIGNED_EVENT, executorID)
+			cmd.errorChan <- nil
 		}}
 
 	controller.cmdQueue <- cmd
@@ -1381,8 +1863,14 @@ func (controller *coloniesController) resetProcess(processID string) error {
 				return
 			}
 
-			cmd.errorChan <- controller.db.ResetProcess(process)
+			err = controller.db.ResetProcess(process)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
 			controller.eventHandler.signal(process)
+			controller.signalProcessEvent(process, core.RESET_EVENT, "")
+			cmd.errorChan <- nil
 		}}
 
 	controller.cmdQueue <- cmd
@@ -1710,6 +2198,7 @@ func (controller *coloniesController) stop() {
 	controller.stopMutex.Unlock()
 	controller.cmdQueue <- &command{stop: true}
 	controller.eventHandler.stop()
+	controller.webhookDispatcher.stop()
 	controller.relayServer.Shutdown()
 	controller.etcdServer.Stop()
 	controller.etcdServer.WaitToStop()
@@ -3,6 +3,7 @@ package server
 import (
 	"time"
 
+	"github.com/colonyos/colonies/pkg/core"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -85,6 +86,91 @@ func (controller *coloniesController) timeoutLoop() {
 				log.WithFields(log.Fields{"ProcessId": process.ID, "MaxWaitTime": process.FunctionSpec.MaxWaitTime}).Debug("Process closed as failed as maximum waiting time limit exceeded")
 			}
 		}
+
+		if controller.tryBecomeLeader() {
+			controller.failExpiredProcessGraphs()
+		}
+	}
+}
+
+func (controller *coloniesController) failExpiredProcessGraphs() {
+	processGraphs, err := controller.db.FindProcessGraphsWithExpiredDeadline()
+	if err != nil {
+		log.WithFields(log.Fields{"Error": err}).Error("Failed to find processgraphs with expired deadline")
+		return
+	}
+
+	for _, processGraph := range processGraphs {
+		err := controller.failExpiredProcessGraph(processGraph.ID)
+		if err != nil {
+			log.WithFields(log.Fields{"ProcessGraphId": processGraph.ID, "Error": err}).Error("Failed to fail processgraph with expired deadline")
+			continue
+		}
+		log.WithFields(log.Fields{"ProcessGraphId": processGraph.ID, "Deadline": processGraph.Deadline}).Debug("Processgraph failed as deadline exceeded")
+	}
+}
+
+// executorLivenessLoop signals an offline event when an executor has not been heard from for
+// EXECUTOR_OFFLINE_TIMEOUT seconds, only the leader checks the executors so that every event is signalled once
+func (controller *coloniesController) executorLivenessLoop() {
+	prevCheck := time.Now()
+	for {
+		time.Sleep(EXECUTOR_LIVENESS_PERIOD * time.Second)
+
+		controller.stopMutex.Lock()
+		stopped := controller.stopFlag
+		controller.stopMutex.Unlock()
+		if stopped {
+			return
+		}
+
+		now := time.Now()
+		if controller.tryBecomeLeader() {
+			controller.signalOfflineExecutors(prevCheck, now)
+		}
+		prevCheck = now
+	}
+}
+
+func (controller *coloniesController) signalOfflineExecutors(prevCheck time.Time, now time.Time) {
+	executors, err := controller.db.GetExecutors()
+	if err != nil {
+		log.WithFields(log.Fields{"Error": err}).Error("Failed to get executors when checking liveness")
+		return
+	}
+
+	for _, executor := range executors {
+		if executor.State != core.APPROVED || executor.LastHeardFromTime.IsZero() {
+			continue
+		}
+
+		// Only executors that went offline since the previous check are signalled
+		offlineTime := executor.LastHeardFromTime.Add(EXECUTOR_OFFLINE_TIMEOUT * time.Second)
+		if offlineTime.After(prevCheck) && !offlineTime.After(now) {
+			log.WithFields(log.Fields{"ExecutorId": executor.ID, "LastHeardFromTime": executor.LastHeardFromTime}).Debug("Executor went offline")
+			controller.signalColonyEvent(executor.ColonyID, core.EXECUTOR_KIND, core.OFFLINE_EVENT, executor.ID, executor.State, "")
+		}
+	}
+}
+
+// colonyEventPruneLoop deletes colony events that are older than COLONY_EVENT_WINDOW seconds
+func (controller *coloniesController) colonyEventPruneLoop() {
+	for {
+		time.Sleep(COLONY_EVENT_PRUNE_PERIOD * time.Second)
+
+		controller.stopMutex.Lock()
+		stopped := controller.stopFlag
+		controller.stopMutex.Unlock()
+		if stopped {
+			return
+		}
+
+		if controller.tryBecomeLeader() {
+			err := controller.db.PruneColonyEvents(COLONY_EVENT_WINDOW)
+			if err != nil {
+				log.WithFields(log.Fields{"Error": err}).Error("Failed to prune colony events")
+			}
+		}
 	}
 }
 
@@ -2,11 +2,12 @@ package server
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"io/ioutil"
 	"net/http"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/colonyos/colonies/pkg/cluster"
@@ -16,9 +17,11 @@ import (
 	"github.com/colonyos/colonies/pkg/security"
 	"github.com/colonyos/colonies/pkg/security/crypto"
 	"github.com/colonyos/colonies/pkg/security/validator"
+	"github.com/colonyos/colonies/pkg/storage"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -39,6 +42,9 @@ type ColoniesServer struct {
 	retention               bool
 	retentionPolicy         int64
 	retentionPeriod         int
+	artifactStorage         storage.Storage
+	secretKey               []byte
+	allowPrivateWebhooks    bool
 }
 
 func CreateColoniesServer(db database.Database,
@@ -56,7 +62,10 @@ func CreateColoniesServer(db database.Database,
 	allowExecutorReregister bool,
 	retention bool,
 	retentionPolicy int64,
-	retentionPeriod int) *ColoniesServer {
+	retentionPeriod int,
+	artifactStorage storage.Storage,
+	secretKey []byte,
+	allowPrivateWebhooks bool) *ColoniesServer {
 	server := &ColoniesServer{}
 	server.ginHandler = gin.Default()
 	server.ginHandler.Use(cors.Default())
@@ -69,7 +78,7 @@ func CreateColoniesServer(db database.Database,
 	}
 
 	server.httpServer = httpServer
-	server.controller = createColoniesController(db, thisNode, clusterConfig, etcdDataPath, generatorPeriod, cronPeriod, retention, retentionPolicy, retentionPeriod)
+	server.controller = createColoniesController(db, thisNode, clusterConfig, etcdDataPath, generatorPeriod, cronPeriod, retention, retentionPolicy, retentionPeriod, allowPrivateWebhooks)
 	server.serverID = serverID
 	server.tls = tls
 	server.port = port
@@ -81,6 +90,9 @@ func CreateColoniesServer(db database.Database,
 	server.allowExecutorReregister = allowExecutorReregister
 	server.retention = retention
 	server.retentionPolicy = retentionPolicy
+	server.artifactStorage = artifactStorage
+	server.secretKey = secretKey
+	server.allowPrivateWebhooks = allowPrivateWebhooks
 
 	log.WithFields(log.Fields{"Port": port,
 		"ServerID":                serverID,
@@ -99,7 +111,8 @@ func CreateColoniesServer(db database.Database,
 		"AllowExecutorReregister": allowExecutorReregister,
 		"ExclusiveAssign":         exclusiveAssign,
 		"Retention":               retention,
-		"RetentionPolicy":         retentionPolicy}).
+		"RetentionPolicy":         retentionPolicy,
+		"AllowPrivateWebhooks":    allowPrivateWebhooks}).
 		Info("Starting Colonies server")
 
 	server.setupRoutes()
@@ -111,6 +124,8 @@ func (server *ColoniesServer) setupRoutes() {
 	server.ginHandler.POST("/api", server.handleAPIRequest)
 	server.ginHandler.GET("/health", server.handleHealthRequest)
 	server.ginHandler.GET("/pubsub", server.handleWSRequest)
+	server.ginHandler.POST("/artifact", server.handleAddArtifactRequest)
+	server.ginHandler.GET("/artifact", server.handleDownloadArtifactRequest)
 }
 
 func (server *ColoniesServer) parseSignature(jsonString string, signature string) (string, error) {
@@ -120,7 +135,7 @@ func (server *ColoniesServer) parseSignature(jsonString string, signature string
 		return "", err
 	}
 
-	return recoveredID, nil
+	return server.resolveKeyID(recoveredID)
 }
 
 func (server *ColoniesServer) handleHealthRequest(c *gin.Context) {
@@ -197,6 +212,8 @@ func (server *ColoniesServer) handleAPIRequest(c *gin.Context) {
 		server.handleGetProcessesHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
 	case rpc.GetProcessPayloadType:
 		server.handleGetProcessHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.GetProcessEventsPayloadType:
+		server.handleGetProcessEventsHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
 	case rpc.DeleteProcessPayloadType:
 		server.handleDeleteProcessHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
 	case rpc.DeleteAllProcessesPayloadType:
@@ -217,6 +234,8 @@ func (server *ColoniesServer) handleAPIRequest(c *gin.Context) {
 	// Workflow and processgraph handlers
 	case rpc.SubmitWorkflowSpecPayloadType:
 		server.handleSubmitWorkflowHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.ValidateWorkflowSpecPayloadType:
+		server.handleValidateWorkflowHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
 	case rpc.GetProcessGraphPayloadType:
 		server.handleGetProcessGraphHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
 	case rpc.GetProcessGraphsPayloadType:
@@ -225,12 +244,16 @@ func (server *ColoniesServer) handleAPIRequest(c *gin.Context) {
 		server.handleDeleteProcessGraphHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
 	case rpc.DeleteAllProcessGraphsPayloadType:
 		server.handleDeleteAllProcessGraphsHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.RetryProcessGraphPayloadType:
+		server.handleRetryProcessGraphHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
 	case rpc.AddChildPayloadType:
 		server.handleAddChildHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
 
 	// Generators handlers
 	case rpc.AddGeneratorPayloadType:
 		server.handleAddGeneratorHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.UpdateGeneratorPayloadType:
+		server.handleUpdateGeneratorHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
 	case rpc.GetGeneratorPayloadType:
 		server.handleGetGeneratorHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
 	case rpc.ResolveGeneratorPayloadType:
@@ -245,6 +268,10 @@ func (server *ColoniesServer) handleAPIRequest(c *gin.Context) {
 	// Cron handlers
 	case rpc.AddCronPayloadType:
 		server.handleAddCronHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.UpdateCronPayloadType:
+		server.handleUpdateCronHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.GetRunHistoryPayloadType:
+		server.handleGetRunHistoryHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
 	case rpc.GetCronPayloadType:
 		server.handleGetCronHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
 	case rpc.GetCronsPayloadType:
@@ -254,6 +281,16 @@ func (server *ColoniesServer) handleAPIRequest(c *gin.Context) {
 	case rpc.DeleteCronPayloadType:
 		server.handleDeleteCronHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
 
+	// Workflow template handlers
+	case rpc.AddWorkflowTemplatePayloadType:
+		server.handleAddWorkflowTemplateHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.GetWorkflowTemplatePayloadType:
+		server.handleGetWorkflowTemplateHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.GetWorkflowTemplatesPayloadType:
+		server.handleGetWorkflowTemplatesHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.DeleteWorkflowTemplatePayloadType:
+		server.handleDeleteWorkflowTemplateHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+
 	// Server handlers
 	case rpc.GetStatisiticsPayloadType:
 		server.handleStatisticsHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
@@ -261,6 +298,52 @@ func (server *ColoniesServer) handleAPIRequest(c *gin.Context) {
 		server.handleGetClusterHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
 	case rpc.ResetDatabasePayloadType:
 		server.handleResetDatabaseHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.GetAuditLogPayloadType:
+		server.handleGetAuditLogHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+
+	// Artifact handlers
+	case rpc.GetArtifactPayloadType:
+		server.handleGetArtifactHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.GetArtifactsPayloadType:
+		server.handleGetArtifactsHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.DeleteArtifactPayloadType:
+		server.handleDeleteArtifactHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+
+	// Webhook handlers
+	case rpc.AddWebhookPayloadType:
+		server.handleAddWebhookHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.GetWebhooksPayloadType:
+		server.handleGetWebhooksHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.DeleteWebhookPayloadType:
+		server.handleDeleteWebhookHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.GetWebhookDeliveriesPayloadType:
+		server.handleGetWebhookDeliveriesHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+
+	// Secret handlers
+	case rpc.AddSecretPayloadType:
+		server.handleAddSecretHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.GetSecretsPayloadType:
+		server.handleGetSecretsHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.DeleteSecretPayloadType:
+		server.handleDeleteSecretHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+
+	// Colony admin handlers
+	case rpc.AddColonyAdminPayloadType:
+		server.handleAddColonyAdminHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.GetColonyAdminsPayloadType:
+		server.handleGetColonyAdminsHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.DeleteColonyAdminPayloadType:
+		server.handleDeleteColonyAdminHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+
+	// Key handlers
+	case rpc.RotateKeyPayloadType:
+		server.handleRotateKeyHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+
+	// Log handlers
+	case rpc.AddLogPayloadType:
+		server.handleAddLogHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	case rpc.GetLogsPayloadType:
+		server.handleGetLogsHTTPRequest(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
 
 	default:
 		errMsg := "invalid rpcMsg.PayloadType, " + rpcMsg.PayloadType
@@ -269,10 +352,14 @@ func (server *ColoniesServer) handleAPIRequest(c *gin.Context) {
 			return
 		}
 	}
+
+	if auditedPayloadTypes[rpcMsg.PayloadType] {
+		server.audit(c, recoveredID, rpcMsg.PayloadType, rpcMsg.DecodePayload())
+	}
 }
 
 func (server *ColoniesServer) generateRPCErrorMsg(err error, errorCode int) (*rpc.RPCReplyMsg, error) {
-	failure := core.CreateFailure(errorCode, err.Error())
+	failure := core.CreateFailureFromError(err, errorCode)
 	jsonString, err := failure.ToJSON()
 	if err != nil {
 		return nil, err
@@ -285,12 +372,35 @@ func (server *ColoniesServer) generateRPCErrorMsg(err error, errorCode int) (*rp
 	return rpcReplyMsg, nil
 }
 
+// isDatabaseError returns true if err was returned by the database driver
+func isDatabaseError(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return true
+	}
+
+	return errors.Is(err, sql.ErrConnDone) || errors.Is(err, sql.ErrTxDone) || errors.Is(err, driver.ErrBadConn)
+}
+
 func (server *ColoniesServer) handleHTTPError(c *gin.Context, err error, errorCode int) bool {
 	if err != nil {
-		if !strings.HasPrefix(err.Error(), "No processes can be selected for executor with Id") {
+		if !errors.Is(err, core.ErrNoWorkAvailable) {
 			log.Debug(err)
 		}
 
+		// Database errors are internal errors no matter which status the handler used, the status is only a
+		// fallback for errors the handler created itself
+		if isDatabaseError(err) {
+			err = core.CreateError(core.ERROR_INTERNAL, err.Error())
+		}
+
+		// Typed errors decide the status themselves, so that the same error is always reported the same way
+		if code := core.ErrorCode(err); code != "" {
+			errorCode = core.ErrorCodeToStatus(code)
+		}
+
+		c.Error(err) // Picked up by the audit log
+
 		rpcReplyMsg, err := server.generateRPCErrorMsg(err, errorCode)
 		if err != nil {
 			log.WithFields(log.Fields{"Error": err}).Error("Failed to call server.generateRPCErrorMsg()")
@@ -333,6 +443,12 @@ func (server *ColoniesServer) sendEmptyHTTPReply(c *gin.Context, payloadType str
 	c.String(http.StatusOK, rpcReplyMsgJSONString)
 }
 
+// Handler returns the HTTP handler of the server, which can be used to call the server without a network
+// connection, see clienttest.CreateHandlerTransport
+func (server *ColoniesServer) Handler() http.Handler {
+	return server.ginHandler
+}
+
 func (server *ColoniesServer) ServeForever() error {
 	if server.tls {
 		if err := server.httpServer.ListenAndServeTLS(server.tlsCertPath, server.tlsPrivateKeyPath); err != nil && errors.Is(err, http.ErrServerClosed) {
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+func (controller *coloniesController) addColonyAdmin(admin *core.ColonyAdmin) (*core.ColonyAdmin, error) {
+	cmd := &command{threaded: true, colonyAdminReplyChan: make(chan *core.ColonyAdmin, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			err := controller.db.AddColonyAdmin(admin)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			addedAdmin, err := controller.db.GetColonyAdmin(admin.ColonyID, admin.AdminID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.colonyAdminReplyChan <- addedAdmin
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case addedAdmin := <-cmd.colonyAdminReplyChan:
+		return addedAdmin, nil
+	}
+}
+
+func (controller *coloniesController) getColonyAdmin(colonyID string, adminID string) (*core.ColonyAdmin, error) {
+	cmd := &command{threaded: true, colonyAdminReplyChan: make(chan *core.ColonyAdmin, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			admin, err := controller.db.GetColonyAdmin(colonyID, adminID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.colonyAdminReplyChan <- admin
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case admin := <-cmd.colonyAdminReplyChan:
+		return admin, nil
+	}
+}
+
+func (controller *coloniesController) getColonyAdmins(colonyID string) ([]*core.ColonyAdmin, error) {
+	cmd := &command{threaded: true, colonyAdminsReplyChan: make(chan []*core.ColonyAdmin, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			admins, err := controller.db.FindColonyAdminsByColonyID(colonyID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.colonyAdminsReplyChan <- admins
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case admins := <-cmd.colonyAdminsReplyChan:
+		return admins, nil
+	}
+}
+
+func (controller *coloniesController) deleteColonyAdmin(colonyID string, adminID string) error {
+	cmd := &command{threaded: true, errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			cmd.errorChan <- controller.db.DeleteColonyAdmin(colonyID, adminID)
+		}}
+
+	controller.cmdQueue <- cmd
+	return <-cmd.errorChan
+}
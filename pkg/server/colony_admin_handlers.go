@@ -0,0 +1,142 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+func (server *ColoniesServer) handleAddColonyAdminHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateAddColonyAdminMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to add colony admin, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to add colony admin, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+	if msg.ColonyAdmin == nil {
+		server.handleHTTPError(c, errors.New("Failed to add colony admin, msg.ColonyAdmin is nil"), http.StatusBadRequest)
+		return
+	}
+
+	// Both the colony owner and existing admins can add new admins
+	err = server.validator.RequireColonyOwner(recoveredID, msg.ColonyAdmin.ColonyID)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	err = VerifyColonyAdmin(msg.ColonyAdmin)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	existingAdmin, err := server.controller.getColonyAdmin(msg.ColonyAdmin.ColonyID, msg.ColonyAdmin.AdminID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if existingAdmin != nil {
+		server.handleHTTPError(c, errors.New("Failed to add colony admin, <"+msg.ColonyAdmin.AdminID+"> is already an admin"), http.StatusBadRequest)
+		return
+	}
+
+	admin := core.CreateColonyAdmin(msg.ColonyAdmin.ColonyID, msg.ColonyAdmin.AdminID, msg.ColonyAdmin.Name)
+	admin.AddedBy = recoveredID
+	admin.Added = time.Now()
+	addedAdmin, err := server.controller.addColonyAdmin(admin)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if addedAdmin == nil {
+		server.handleHTTPError(c, errors.New("Failed to add colony admin, addedAdmin is nil"), http.StatusInternalServerError)
+		return
+	}
+
+	jsonString, err = addedAdmin.ToJSON()
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ColonyId": addedAdmin.ColonyID, "AdminId": addedAdmin.AdminID, "AddedBy": addedAdmin.AddedBy}).Debug("Adding colony admin")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
+func (server *ColoniesServer) handleGetColonyAdminsHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateGetColonyAdminsMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to get colony admins, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to get colony admins, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	err = server.validator.RequireColonyOwner(recoveredID, msg.ColonyID)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	admins, err := server.controller.getColonyAdmins(msg.ColonyID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	jsonString, err = core.ConvertColonyAdminArrayToJSON(admins)
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ColonyId": msg.ColonyID}).Debug("Getting colony admins")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
+func (server *ColoniesServer) handleDeleteColonyAdminHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateDeleteColonyAdminMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to delete colony admin, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to delete colony admin, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	// Both the colony owner and existing admins can remove admins
+	err = server.validator.RequireColonyOwner(recoveredID, msg.ColonyID)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	admin, err := server.controller.getColonyAdmin(msg.ColonyID, msg.AdminID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if admin == nil {
+		server.handleHTTPError(c, core.CreateError(core.ERROR_NOT_FOUND, "Failed to delete colony admin, admin <"+msg.AdminID+"> not found"), http.StatusNotFound)
+		return
+	}
+
+	err = server.controller.deleteColonyAdmin(msg.ColonyID, msg.AdminID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ColonyId": msg.ColonyID, "AdminId": msg.AdminID, "RemovedBy": recoveredID}).Debug("Deleting colony admin")
+
+	server.sendEmptyHTTPReply(c, payloadType)
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddColonyAdminSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	adminID, _ := generateTestAdmin(t)
+	_, err := client.AddColonyAdmin(env.colony1ID, adminID, "alice", env.executor1PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.AddColonyAdmin(env.colony1ID, adminID, "alice", env.colony2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.AddColonyAdmin(env.colony1ID, adminID, "alice", env.colony1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
+
+func TestColonyAdminOtherColonySecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	adminID, adminPrvKey := generateTestAdmin(t)
+	_, err := client.AddColonyAdmin(env.colony1ID, adminID, "alice", env.colony1PrvKey)
+	assert.Nil(t, err)
+
+	// An admin of colony1 is not an admin of colony2
+	admin2ID, _ := generateTestAdmin(t)
+	_, err = client.AddColonyAdmin(env.colony2ID, admin2ID, "bob", adminPrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.GetColonyAdmins(env.colony2ID, adminPrvKey)
+	assert.NotNil(t, err) // Should not work
+	err = client.ApproveExecutor(env.executor2ID, adminPrvKey)
+	assert.NotNil(t, err) // Should not work
+	err = client.ApproveExecutor(env.executor1ID, adminPrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
+
+func TestGetColonyAdminsSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	adminID, _ := generateTestAdmin(t)
+	_, err := client.AddColonyAdmin(env.colony1ID, adminID, "alice", env.colony1PrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.GetColonyAdmins(env.colony1ID, env.executor1PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.GetColonyAdmins(env.colony1ID, env.colony2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.GetColonyAdmins(env.colony1ID, env.colony1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
+
+func TestDeleteColonyAdminSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	adminID, _ := generateTestAdmin(t)
+	_, err := client.AddColonyAdmin(env.colony1ID, adminID, "alice", env.colony1PrvKey)
+	assert.Nil(t, err)
+
+	err = client.DeleteColonyAdmin(env.colony1ID, adminID, env.executor1PrvKey)
+	assert.NotNil(t, err) // Should not work
+	err = client.DeleteColonyAdmin(env.colony1ID, adminID, env.colony2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	err = client.DeleteColonyAdmin(env.colony1ID, adminID, env.colony1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
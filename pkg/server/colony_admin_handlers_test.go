@@ -0,0 +1,165 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/security/crypto"
+	"github.com/colonyos/colonies/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func generateTestAdmin(t *testing.T) (string, string) {
+	crypto := crypto.CreateCrypto()
+	adminPrvKey, err := crypto.GeneratePrivateKey()
+	assert.Nil(t, err)
+	adminID, err := crypto.GenerateID(adminPrvKey)
+	assert.Nil(t, err)
+
+	return adminID, adminPrvKey
+}
+
+func TestAddColonyAdmin(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	admin1ID, admin1PrvKey := generateTestAdmin(t)
+	admin2ID, _ := generateTestAdmin(t)
+
+	addedAdmin, err := client.AddColonyAdmin(env.colonyID, admin1ID, "alice", env.colonyPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, addedAdmin.AdminID, admin1ID)
+	assert.Equal(t, addedAdmin.Name, "alice")
+	assert.Equal(t, addedAdmin.AddedBy, env.colonyID)
+
+	// Admins can add other admins
+	addedAdmin, err = client.AddColonyAdmin(env.colonyID, admin2ID, "bob", admin1PrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, addedAdmin.AddedBy, admin1ID)
+
+	_, err = client.AddColonyAdmin(env.colonyID, admin1ID, "alice", env.colonyPrvKey)
+	assert.NotNil(t, err) // Already an admin
+	_, err = client.AddColonyAdmin(env.colonyID, env.colonyID, "colony", env.colonyPrvKey)
+	assert.NotNil(t, err) // The colony itself cannot be added
+	_, err = client.AddColonyAdmin(env.colonyID, "invalid_id", "invalid", env.colonyPrvKey)
+	assert.NotNil(t, err)
+
+	admins, err := client.GetColonyAdmins(env.colonyID, admin1PrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, admins, 2)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestDeleteColonyAdmin(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	admin1ID, admin1PrvKey := generateTestAdmin(t)
+	admin2ID, admin2PrvKey := generateTestAdmin(t)
+
+	_, err := client.AddColonyAdmin(env.colonyID, admin1ID, "alice", env.colonyPrvKey)
+	assert.Nil(t, err)
+	_, err = client.AddColonyAdmin(env.colonyID, admin2ID, "bob", env.colonyPrvKey)
+	assert.Nil(t, err)
+
+	// Admins can remove other admins
+	err = client.DeleteColonyAdmin(env.colonyID, admin1ID, admin2PrvKey)
+	assert.Nil(t, err)
+
+	err = client.DeleteColonyAdmin(env.colonyID, admin1ID, admin2PrvKey)
+	assert.NotNil(t, err) // Not found
+
+	// A removed admin can no longer administer the colony
+	_, err = client.GetColonyAdmins(env.colonyID, admin1PrvKey)
+	assert.NotNil(t, err)
+	err = client.ApproveExecutor(env.executorID, admin1PrvKey)
+	assert.NotNil(t, err)
+
+	admins, err := client.GetColonyAdmins(env.colonyID, env.colonyPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, admins, 1)
+	assert.Equal(t, admins[0].AdminID, admin2ID)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestColonyAdminAdministerColony(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	adminID, adminPrvKey := generateTestAdmin(t)
+	_, err := client.AddColonyAdmin(env.colonyID, adminID, "alice", env.colonyPrvKey)
+	assert.Nil(t, err)
+
+	// Approve executors
+	executor, executorPrvKey, err := utils.CreateTestExecutorWithKey(env.colonyID)
+	assert.Nil(t, err)
+	_, err = client.AddExecutor(executor, adminPrvKey)
+	assert.Nil(t, err)
+	err = client.ApproveExecutor(executor.ID, adminPrvKey)
+	assert.Nil(t, err)
+
+	// Delete processes
+	funcSpec := utils.CreateTestFunctionSpec(env.colonyID)
+	addedProcess, err := client.Submit(funcSpec, executorPrvKey)
+	assert.Nil(t, err)
+	err = client.DeleteProcess(addedProcess.ID, adminPrvKey)
+	assert.Nil(t, err)
+	err = client.DeleteAllProcesses(env.colonyID, adminPrvKey)
+	assert.Nil(t, err)
+
+	// Manage crons
+	addedCron, err := client.AddCron(utils.FakeCron(t, env.colonyID), adminPrvKey)
+	assert.Nil(t, err)
+	err = client.DeleteCron(addedCron.ID, adminPrvKey)
+	assert.Nil(t, err)
+
+	// Only the holder of the colony private key can rotate it
+	crypto := crypto.CreateCrypto()
+	newColonyPrvKey, err := crypto.GeneratePrivateKey()
+	assert.Nil(t, err)
+	_, err = client.RotateKey(env.colonyID, "", newColonyPrvKey, adminPrvKey)
+	assert.NotNil(t, err)
+
+	// The audit log records which admin did what
+	auditLog, err := client.GetAuditLog(env.colonyID, 100, adminPrvKey)
+	assert.Nil(t, err)
+
+	for _, payloadType := range []string{"approveexecutormsg", "deleteprocessmsg", "deleteallprocessesmsg", "addcronmsg", "deletecronmsg"} {
+		auditRecord := findAuditRecord(auditLog, payloadType)
+		assert.NotNil(t, auditRecord)
+		assert.Equal(t, auditRecord.RecoveredID, adminID)
+		assert.Equal(t, auditRecord.Status, http.StatusOK)
+	}
+
+	auditRecord := findAuditRecord(auditLog, "addcolonyadminmsg")
+	assert.NotNil(t, auditRecord)
+	assert.Equal(t, auditRecord.RecoveredID, env.colonyID)
+	assert.Contains(t, auditRecord.TargetIDs, "adminid="+adminID)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestDeleteColonyRemovesAdmins(t *testing.T) {
+	env, client, server, serverPrvKey, done := setupTestEnv2(t)
+
+	adminID, adminPrvKey := generateTestAdmin(t)
+	_, err := client.AddColonyAdmin(env.colonyID, adminID, "alice", env.colonyPrvKey)
+	assert.Nil(t, err)
+
+	err = client.DeleteColony(env.colonyID, serverPrvKey)
+	assert.Nil(t, err)
+
+	// Adding a colony with the same Id must not restore the admins
+	colony := core.CreateColony(env.colonyID, "test_colony_name")
+	_, err = client.AddColony(colony, serverPrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.GetColonyAdmins(env.colonyID, adminPrvKey)
+	assert.NotNil(t, err)
+
+	server.Shutdown()
+	<-done
+}
@@ -81,6 +81,11 @@ func (server *ColoniesServer) handleDeleteColonyHTTPRequest(c *gin.Context, reco
 		return
 	}
 
+	err = server.artifactStorage.DeleteAll(msg.ColonyID)
+	if err != nil {
+		log.WithFields(log.Fields{"ColonyId": msg.ColonyID, "Error": err}).Error("Failed to delete artifacts of colony")
+	}
+
 	log.WithFields(log.Fields{"ColonyId": msg.ColonyID}).Debug("Deleting colony")
 
 	server.sendEmptyHTTPReply(c, payloadType)
@@ -170,7 +175,7 @@ func (server *ColoniesServer) handleGetColonyHTTPRequest(c *gin.Context, recover
 		return
 	}
 	if colony == nil {
-		server.handleHTTPError(c, errors.New("Failed to get colony, colony is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to get colony, colony is nil"), http.StatusNotFound)
 		return
 	}
 
@@ -197,12 +202,9 @@ func (server *ColoniesServer) handleColonyStatisticsHTTPRequest(c *gin.Context,
 		return
 	}
 
-	err = server.validator.RequireExecutorMembership(recoveredID, msg.ColonyID, true)
-	if err != nil {
-		err = server.validator.RequireColonyOwner(recoveredID, msg.ColonyID)
-		if server.handleHTTPError(c, err, http.StatusForbidden) {
-			return
-		}
+	err = server.validator.RequireExecutorMembershipOrColonyOwner(recoveredID, msg.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
 	}
 
 	stat, err := server.controller.getColonyStatistics(msg.ColonyID)
@@ -9,3 +9,20 @@ const GENERATOR_TRIGGER_PERIOD = 1000 // Period in milliseconds when generator i
 const CRON_TRIGGER_PERIOD = 1000      // Period in milliseconds when cron is run
 const MIN_PRIORITY = -50000
 const MAX_PRIORITY = 50000
+const MAX_SUB_WORKFLOW_DEPTH = 10                // Max number of nested sub-workflows, protects against workflow templates referencing themselves
+const MAX_LOG_CHUNK_SIZE = 64 * 1024             // Max size in bytes of a log chunk sent by an executor
+const MAX_LOG_SIZE = 10 * 1024 * 1024            // Max size in bytes of the log of a process
+const LOG_POLL_PERIOD = 500                      // Period in milliseconds when logs are checked while following a process
+const MAX_LOG_TIMEOUT = 60                       // Max time in seconds a get logs request waits for new logs
+const MAX_ARTIFACT_SIZE = 4 * 1024 * 1024 * 1024 // Max size in bytes of an artifact
+const WEBHOOK_QUEUE_SIZE = 1000                  // Max number of colony events waiting to be delivered to webhooks
+const WEBHOOK_DELIVERY_QUEUE_SIZE = 1000         // Max number of webhook deliveries waiting for a delivery worker
+const WEBHOOK_WORKERS = 10                       // Number of webhook deliveries made concurrently
+const WEBHOOK_MAX_RETRIES = 5                    // Max number of times a failed webhook delivery is retried
+const WEBHOOK_RETRY_BACKOFF = 1000               // Delay in milliseconds before the first retry, doubled for every retry
+const WEBHOOK_TIMEOUT = 10                       // Timeout in seconds of a webhook request
+const EXECUTOR_LIVENESS_PERIOD = 10              // Period in seconds when executors are checked for liveness
+const EXECUTOR_OFFLINE_TIMEOUT = 600             // Time in seconds after which an executor that has not been heard from is considered offline
+const COLONY_EVENT_WINDOW = 24 * 60 * 60         // Time in seconds colony events are stored, subscriptions can be resumed within the window
+const COLONY_EVENT_PRUNE_PERIOD = 60             // Period in seconds when colony events outside the window are deleted
+const COLONY_EVENT_REPLAY_BATCH = 1000           // Number of stored colony events fetched at a time when a subscription is resumed
@@ -15,6 +15,7 @@ type controller interface {
 	getThisNode() cluster.Node
 	subscribeProcesses(executorID string, subscription *subscription) error
 	subscribeProcess(executorID string, subscription *subscription) error
+	subscribeColonyEvents(executorID string, subscription *subscription) error
 	getColonies() ([]*core.Colony, error)
 	getColony(colonyID string) (*core.Colony, error)
 	addColony(colony *core.Colony) (*core.Colony, error)
@@ -30,6 +31,7 @@ type controller interface {
 	addProcess(process *core.Process) (*core.Process, error)
 	addChild(processGraphID string, parentProcessID string, childProcessID string, process *core.Process, executorID string, insert bool) (*core.Process, error)
 	getProcess(processID string) (*core.Process, error)
+	getProcessEvents(processID string) ([]*core.ProcessEvent, error)
 	findProcessHistory(colonyID string, executorID string, seconds int, state int) ([]*core.Process, error)
 	findWaitingProcesses(colonyID string, executorType string, count int) ([]*core.Process, error)
 	findRunningProcesses(colonyID string, executorType string, count int) ([]*core.Process, error)
@@ -46,8 +48,9 @@ type controller interface {
 	deleteProcess(processID string) error
 	deleteAllProcesses(colonyID string, state int) error
 	deleteProcessGraph(processID string) error
+	retryProcessGraph(processGraphID string) (*core.ProcessGraph, error)
 	deleteAllProcessGraphs(colonyID string, state int) error
-	closeSuccessful(processID string, executorID string, output []interface{}) error
+	closeSuccessful(processID string, executorID string, output []interface{}, namedOutput map[string]interface{}) error
 	notifyChildren(process *core.Process) error
 	closeFailed(processID string, errs []string) error
 	handleDefunctProcessgraph(processGraphID string, processID string, err error) error
@@ -64,6 +67,7 @@ type controller interface {
 	getFunctionByID(functionID string) (*core.Function, error)
 	deleteFunction(functionID string) error
 	addGenerator(generator *core.Generator) (*core.Generator, error)
+	updateGenerator(generator *core.Generator) (*core.Generator, error)
 	getGenerator(generatorID string) (*core.Generator, error)
 	resolveGenerator(generatorName string) (*core.Generator, error)
 	getGenerators(colonyID string, count int) ([]*core.Generator, error)
@@ -72,13 +76,42 @@ type controller interface {
 	triggerGenerators()
 	submitWorkflow(generator *core.Generator, counter int)
 	addCron(cron *core.Cron) (*core.Cron, error)
+	updateCron(cron *core.Cron) (*core.Cron, error)
 	deleteGenerator(generatorID string) error
 	getCron(cronID string) (*core.Cron, error)
 	getCrons(colonyID string, count int) ([]*core.Cron, error)
 	runCron(cronID string) (*core.Cron, error)
 	deleteCron(cronID string) error
 	calcNextRun(cron *core.Cron) time.Time
-	startCron(cron *core.Cron)
+	startCron(cron *core.Cron, scheduledTime time.Time)
+	getRunHistory(triggerID string, count int) ([]*core.RunRecord, error)
+	addAuditRecord(auditRecord *core.AuditRecord) error
+	getAuditLog(colonyID string, count int) ([]*core.AuditRecord, error)
+	addLog(process *core.Process, executorID string, message string) (*core.Log, error)
+	getLogs(processID string, offset int64, count int, timeout int) ([]*core.Log, error)
+	addArtifact(artifact *core.Artifact) (*core.Artifact, error)
+	getArtifact(artifactID string) (*core.Artifact, error)
+	getArtifacts(colonyID string, count int) ([]*core.Artifact, error)
+	deleteArtifact(artifactID string) error
+	addWebhook(webhook *core.Webhook) (*core.Webhook, error)
+	getWebhook(webhookID string) (*core.Webhook, error)
+	getWebhooks(colonyID string) ([]*core.Webhook, error)
+	deleteWebhook(webhookID string) error
+	getWebhookDeliveries(webhookID string, count int) ([]*core.WebhookDelivery, error)
+	addSecret(secret *core.Secret) (*core.Secret, error)
+	getSecret(colonyID string, name string) (*core.Secret, error)
+	getSecrets(colonyID string) ([]*core.Secret, error)
+	deleteSecret(colonyID string, name string) error
+	rotateKey(identityKey *core.IdentityKey) error
+	addColonyAdmin(admin *core.ColonyAdmin) (*core.ColonyAdmin, error)
+	getColonyAdmin(colonyID string, adminID string) (*core.ColonyAdmin, error)
+	getColonyAdmins(colonyID string) ([]*core.ColonyAdmin, error)
+	deleteColonyAdmin(colonyID string, adminID string) error
+	addWorkflowTemplate(template *core.WorkflowTemplate) (*core.WorkflowTemplate, error)
+	getWorkflowTemplate(colonyID string, name string, version int) (*core.WorkflowTemplate, error)
+	getWorkflowTemplates(colonyID string) ([]*core.WorkflowTemplate, error)
+	deleteWorkflowTemplate(colonyID string, name string, version int) error
+	instantiateWorkflowTemplate(workflowSpec *core.WorkflowSpec) (*core.WorkflowSpec, error)
 	triggerCrons()
 	cronTriggerLoop()
 	resetDatabase() error
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"errors"
+	"strconv"
 	"time"
 
 	"github.com/colonyos/colonies/pkg/core"
@@ -22,6 +24,7 @@ func (controller *coloniesController) addCron(cron *core.Cron) (*core.Cron, erro
 				cmd.errorChan <- err
 				return
 			}
+			controller.signalColonyEvent(addedCron.ColonyID, core.CRON_KIND, core.ADDED_EVENT, addedCron.ID, 0, "")
 			cmd.cronReplyChan <- addedCron
 		}}
 
@@ -34,6 +37,32 @@ func (controller *coloniesController) addCron(cron *core.Cron) (*core.Cron, erro
 	}
 }
 
+func (controller *coloniesController) updateCron(cron *core.Cron) (*core.Cron, error) {
+	cmd := &command{cronReplyChan: make(chan *core.Cron, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			err := controller.db.UpdateCronSettings(cron)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			updatedCron, err := controller.db.GetCronByID(cron.ID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.cronReplyChan <- updatedCron
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case updatedCron := <-cmd.cronReplyChan:
+		return updatedCron, nil
+	}
+}
+
 func (controller *coloniesController) deleteGenerator(generatorID string) error {
 	cmd := &command{errorChan: make(chan error, 1),
 		handler: func(cmd *command) {
@@ -95,7 +124,11 @@ func (controller *coloniesController) runCron(cronID string) (*core.Cron, error)
 				cmd.errorChan <- err
 				return
 			}
-			controller.startCron(cron)
+			if cron == nil {
+				cmd.cronReplyChan <- nil
+				return
+			}
+			controller.startCron(cron, time.Now())
 			cmd.cronReplyChan <- cron
 		}}
 
@@ -111,8 +144,20 @@ func (controller *coloniesController) runCron(cronID string) (*core.Cron, error)
 func (controller *coloniesController) deleteCron(cronID string) error {
 	cmd := &command{errorChan: make(chan error, 1),
 		handler: func(cmd *command) {
-			err := controller.db.DeleteCronByID(cronID)
-			cmd.errorChan <- err
+			cron, err := controller.db.GetCronByID(cronID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			err = controller.db.DeleteCronByID(cronID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			if cron != nil {
+				controller.signalColonyEvent(cron.ColonyID, core.CRON_KIND, core.REMOVED_EVENT, cron.ID, 0, "")
+			}
+			cmd.errorChan <- nil
 		}}
 
 	controller.cmdQueue <- cmd
@@ -142,10 +187,11 @@ func (controller *coloniesController) calcNextRun(cron *core.Cron) time.Time {
 	return nextRun
 }
 
-func (controller *coloniesController) startCron(cron *core.Cron) {
+func (controller *coloniesController) startCron(cron *core.Cron, scheduledTime time.Time) {
 	workflowSpec, err := core.ConvertJSONToWorkflowSpec(cron.WorkflowSpec)
 	if err != nil {
 		log.WithFields(log.Fields{"Error": err}).Error("Failed to parsing WorkflowSpec")
+		controller.addRunRecord(core.CreateFailedRunRecord(cron.ColonyID, cron.ID, core.CRON_TRIGGER, scheduledTime, err.Error()))
 		return
 	}
 
@@ -172,13 +218,60 @@ func (controller *coloniesController) startCron(cron *core.Cron) {
 	processGraph, err := controller.createProcessGraph(workflowSpec, make([]interface{}, 0), rootInput)
 	if err != nil {
 		log.WithFields(log.Fields{"Error": err, "CronId": cron.ID}).Error("Failed to create cron processgraph")
+		controller.addRunRecord(core.CreateFailedRunRecord(cron.ColonyID, cron.ID, core.CRON_TRIGGER, scheduledTime, err.Error()))
 		return
 	}
 
+	controller.addRunRecord(core.CreateRunRecord(cron.ColonyID, cron.ID, core.CRON_TRIGGER, scheduledTime, processGraph.ID))
+	controller.signalColonyEvent(cron.ColonyID, core.CRON_KIND, core.TRIGGERED_EVENT, cron.ID, 0, "")
+
 	nextRun := controller.calcNextRun(cron)
 	controller.db.UpdateCron(cron.ID, nextRun, time.Now(), processGraph.ID)
 }
 
+func (controller *coloniesController) addRunRecord(runRecord *core.RunRecord) {
+	err := controller.db.AddRunRecord(runRecord)
+	if err != nil {
+		log.WithFields(log.Fields{"Error": err, "TriggerId": runRecord.TriggerID}).Error("Failed to add run record")
+	}
+}
+
+// skipCron records that a scheduled run was skipped and schedules the next run of the cron
+func (controller *coloniesController) skipCron(cron *core.Cron, reason string) {
+	controller.addRunRecord(core.CreateSkippedRunRecord(cron.ColonyID, cron.ID, core.CRON_TRIGGER, cron.NextRun, reason))
+
+	nextRun := controller.calcNextRun(cron)
+	err := controller.db.UpdateCron(cron.ID, nextRun, cron.LastRun, cron.PrevProcessGraphID)
+	if err != nil {
+		log.WithFields(log.Fields{"Error": err, "CronId": cron.ID}).Error("Failed to update next run of skipped cron")
+	}
+}
+
+func (controller *coloniesController) getRunHistory(triggerID string, count int) ([]*core.RunRecord, error) {
+	cmd := &command{runHistoryReplyChan: make(chan []*core.RunRecord, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			if count > MAX_COUNT {
+				cmd.errorChan <- errors.New("Count is larger than MaxCount limit <" + strconv.Itoa(MAX_COUNT) + ">")
+				return
+			}
+			runHistory, err := controller.db.FindRunHistory(triggerID, count)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.runHistoryReplyChan <- runHistory
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case runHistory := <-cmd.runHistoryReplyChan:
+		return runHistory, nil
+	}
+}
+
 func (controller *coloniesController) triggerCrons() {
 	cmd := &command{handler: func(cmd *command) {
 		crons, err := controller.db.FindAllCrons()
@@ -187,6 +280,9 @@ func (controller *coloniesController) triggerCrons() {
 			return
 		}
 		for _, cron := range crons {
+			if cron.Paused {
+				continue
+			}
 			t := time.Time{}
 			if t.Unix() == cron.NextRun.Unix() { // This if-statement will be true the first time the cron is evaluted
 				nextRun := controller.calcNextRun(cron)
@@ -201,17 +297,19 @@ func (controller *coloniesController) triggerCrons() {
 					continue
 				}
 				if processgraph == nil {
-					controller.startCron(cron)
+					controller.startCron(cron, cron.NextRun)
 					continue
 				}
 				if cron.WaitForPrevProcessGraph {
 					if processgraph.State == core.SUCCESS || processgraph.State == core.FAILED {
 						log.WithFields(log.Fields{"CronId": cron.ID}).Debug("Triggering cron workflow")
-						controller.startCron(cron)
+						controller.startCron(cron, cron.NextRun)
+					} else {
+						controller.skipCron(cron, "Previous processgraph "+processgraph.ID+" has not finished")
 					}
 				} else {
 					log.WithFields(log.Fields{"CronId": cron.ID}).Debug("Triggering cron workflow")
-					controller.startCron(cron)
+					controller.startCron(cron, cron.NextRun)
 				}
 			}
 		}
@@ -5,7 +5,6 @@ import (
 	"net/http"
 
 	"github.com/colonyos/colonies/pkg/core"
-	cronlib "github.com/colonyos/colonies/pkg/cron"
 	"github.com/colonyos/colonies/pkg/rpc"
 	"github.com/gin-gonic/gin"
 	log "github.com/sirupsen/logrus"
@@ -28,40 +27,22 @@ func (server *ColoniesServer) handleAddCronHTTPRequest(c *gin.Context, recovered
 		return
 	}
 
-	err = server.validator.RequireExecutorMembership(recoveredID, msg.Cron.ColonyID, true)
+	err = server.validator.RequireExecutorMembershipOrColonyOwner(recoveredID, msg.Cron.ColonyID, true)
 	if server.handleHTTPError(c, err, http.StatusForbidden) {
 		return
 	}
 
 	// Validate that workflow and cron expression is valid
-	workflowSpec, err := core.ConvertJSONToWorkflowSpec(msg.Cron.WorkflowSpec)
+	err = VerifyCron(msg.Cron)
 	if server.handleHTTPError(c, err, http.StatusBadRequest) {
 		return
 	}
 
-	err = VerifyWorkflowSpec(workflowSpec)
+	msg.Cron.WorkflowSpec, err = server.pinWorkflowTemplate(msg.Cron.ColonyID, msg.Cron.WorkflowSpec)
 	if server.handleHTTPError(c, err, http.StatusBadRequest) {
 		return
 	}
 
-	if msg.Cron.Interval == 0 {
-		if server.handleHTTPError(c, errors.New("Cron interval must be -1 (disabled) or larger than 0"), http.StatusBadRequest) {
-			return
-		}
-	}
-
-	if msg.Cron.Interval == -1 {
-		_, err = cronlib.Next(msg.Cron.CronExpression)
-		if server.handleHTTPError(c, err, http.StatusBadRequest) {
-			return
-		}
-		if msg.Cron.Random {
-			if server.handleHTTPError(c, errors.New("Random cron is only supported when specifying intervals"), http.StatusBadRequest) {
-				return
-			}
-		}
-	}
-
 	msg.Cron.ID = core.GenerateRandomID()
 	addedCron, err := server.controller.addCron(msg.Cron)
 	if server.handleHTTPError(c, err, http.StatusBadRequest) {
@@ -82,6 +63,69 @@ func (server *ColoniesServer) handleAddCronHTTPRequest(c *gin.Context, recovered
 	server.sendHTTPReply(c, payloadType, jsonString)
 }
 
+func (server *ColoniesServer) handleUpdateCronHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateUpdateCronMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to update cron, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to update cron, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+	if msg.Cron == nil {
+		server.handleHTTPError(c, errors.New("Failed to update cron, msg.Cron is nil"), http.StatusBadRequest)
+		return
+	}
+
+	cron, err := server.controller.getCron(msg.Cron.ID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if cron == nil {
+		server.handleHTTPError(c, errors.New("Failed to update cron, cron is nil"), http.StatusNotFound)
+		return
+	}
+
+	setAuditColony(c, cron.ColonyID)
+
+	// Note that membership is verified against the stored cron, it is not possible to move a cron to another colony
+	err = server.validator.RequireExecutorMembershipOrColonyOwner(recoveredID, cron.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	err = VerifyCron(msg.Cron)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	msg.Cron.WorkflowSpec, err = server.pinWorkflowTemplate(cron.ColonyID, msg.Cron.WorkflowSpec)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	updatedCron, err := server.controller.updateCron(msg.Cron)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if updatedCron == nil {
+		server.handleHTTPError(c, errors.New("Failed to update cron, updatedCron is nil"), http.StatusInternalServerError)
+		return
+	}
+
+	jsonString, err = updatedCron.ToJSON()
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"CronId": updatedCron.ID, "Paused": updatedCron.Paused}).Debug("Updating cron")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
 func (server *ColoniesServer) handleGetCronHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
 	msg, err := rpc.CreateGetCronMsgFromJSON(jsonString)
 	if err != nil {
@@ -100,11 +144,11 @@ func (server *ColoniesServer) handleGetCronHTTPRequest(c *gin.Context, recovered
 		return
 	}
 	if cron == nil {
-		server.handleHTTPError(c, errors.New("Failed to get cron, cron is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to get cron, cron is nil"), http.StatusNotFound)
 		return
 	}
 
-	err = server.validator.RequireExecutorMembership(recoveredID, cron.ColonyID, true)
+	err = server.validator.RequireExecutorMembershipOrColonyOwner(recoveredID, cron.ColonyID, true)
 	if server.handleHTTPError(c, err, http.StatusForbidden) {
 		return
 	}
@@ -134,7 +178,7 @@ func (server *ColoniesServer) handleGetCronsHTTPRequest(c *gin.Context, recovere
 		return
 	}
 
-	err = server.validator.RequireExecutorMembership(recoveredID, msg.ColonyID, true)
+	err = server.validator.RequireExecutorMembershipOrColonyOwner(recoveredID, msg.ColonyID, true)
 	if server.handleHTTPError(c, err, http.StatusForbidden) {
 		return
 	}
@@ -171,20 +215,30 @@ func (server *ColoniesServer) handleRunCronHTTPRequest(c *gin.Context, recovered
 		return
 	}
 
-	cron, err := server.controller.runCron(msg.CronID)
+	cron, err := server.controller.getCron(msg.CronID)
 	if server.handleHTTPError(c, err, http.StatusBadRequest) {
 		return
 	}
 	if cron == nil {
-		server.handleHTTPError(c, errors.New("Failed to run cron, cron is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to run cron, cron is nil"), http.StatusNotFound)
 		return
 	}
 
-	err = server.validator.RequireExecutorMembership(recoveredID, cron.ColonyID, true)
+	setAuditColony(c, cron.ColonyID)
+	err = server.validator.RequireExecutorMembershipOrColonyOwner(recoveredID, cron.ColonyID, true)
 	if server.handleHTTPError(c, err, http.StatusForbidden) {
 		return
 	}
 
+	cron, err = server.controller.runCron(cron.ID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if cron == nil {
+		server.handleHTTPError(c, errors.New("Failed to run cron, cron is nil"), http.StatusNotFound)
+		return
+	}
+
 	jsonString, err = cron.ToJSON()
 	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
 		return
@@ -213,11 +267,12 @@ func (server *ColoniesServer) handleDeleteCronHTTPRequest(c *gin.Context, recove
 		return
 	}
 	if cron == nil {
-		server.handleHTTPError(c, errors.New("Failed to delete cron, cron is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to delete cron, cron is nil"), http.StatusNotFound)
 		return
 	}
 
-	err = server.validator.RequireExecutorMembership(recoveredID, cron.ColonyID, true)
+	setAuditColony(c, cron.ColonyID)
+	err = server.validator.RequireExecutorMembershipOrColonyOwner(recoveredID, cron.ColonyID, true)
 	if server.handleHTTPError(c, err, http.StatusForbidden) {
 		return
 	}
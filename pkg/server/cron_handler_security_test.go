@@ -19,7 +19,7 @@ func TestAddCronSecurity(t *testing.T) {
 	_, err := client.AddCron(cron, env.executor2PrvKey)
 	assert.NotNil(t, err)
 	_, err = client.AddCron(cron, env.colony1PrvKey)
-	assert.NotNil(t, err)
+	assert.Nil(t, err) // Colony owner and admins can also manage crons
 	_, err = client.AddCron(cron, env.colony2PrvKey)
 	assert.NotNil(t, err)
 	_, err = client.AddCron(cron, env.executor1PrvKey)
@@ -43,7 +43,7 @@ func TestGetCronSecurity(t *testing.T) {
 	_, err = client.GetCron(addedCron.ID, env.executor2PrvKey)
 	assert.NotNil(t, err)
 	_, err = client.GetCron(addedCron.ID, env.colony1PrvKey)
-	assert.NotNil(t, err)
+	assert.Nil(t, err) // Colony owner and admins can also manage crons
 	_, err = client.GetCron(addedCron.ID, env.colony2PrvKey)
 	assert.NotNil(t, err)
 	_, err = client.GetCron(addedCron.ID, env.executor1PrvKey)
@@ -53,6 +53,61 @@ func TestGetCronSecurity(t *testing.T) {
 	<-done
 }
 
+func TestUpdateCronSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	cron := utils.FakeCron(t, env.colony1ID)
+	addedCron, err := client.AddCron(cron, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	addedCron.Paused = true
+	_, err = client.UpdateCron(addedCron, env.executor2PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.UpdateCron(addedCron, env.colony1PrvKey)
+	assert.Nil(t, err) // Colony owner and admins can also manage crons
+	_, err = client.UpdateCron(addedCron, env.colony2PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.UpdateCron(addedCron, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestGetRunHistorySecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	cron := utils.FakeCron(t, env.colony1ID)
+	addedCron, err := client.AddCron(cron, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	generator := utils.FakeGenerator(t, env.colony1ID)
+	addedGenerator, err := client.AddGenerator(generator, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	for _, triggerID := range []string{addedCron.ID, addedGenerator.ID} {
+		_, err = client.GetRunHistory(triggerID, 100, env.executor2PrvKey)
+		assert.NotNil(t, err)
+		_, err = client.GetRunHistory(triggerID, 100, env.colony1PrvKey)
+		assert.NotNil(t, err)
+		_, err = client.GetRunHistory(triggerID, 100, env.colony2PrvKey)
+		assert.NotNil(t, err)
+		_, err = client.GetRunHistory(triggerID, 100, env.executor1PrvKey)
+		assert.Nil(t, err)
+	}
+
+	server.Shutdown()
+	<-done
+}
+
 func TestGetCronsSecurity(t *testing.T) {
 	env, client, server, _, done := setupTestEnv1(t)
 
@@ -67,7 +122,7 @@ func TestGetCronsSecurity(t *testing.T) {
 	_, err = client.GetCrons(env.colony1ID, 100, env.executor2PrvKey)
 	assert.NotNil(t, err)
 	_, err = client.GetCrons(env.colony1ID, 100, env.colony1PrvKey)
-	assert.NotNil(t, err)
+	assert.Nil(t, err) // Colony owner and admins can also manage crons
 	_, err = client.GetCrons(env.colony1ID, 100, env.colony2PrvKey)
 	assert.NotNil(t, err)
 	_, err = client.GetCrons(env.colony1ID, 100, env.executor1PrvKey)
@@ -91,7 +146,7 @@ func TestRunCronSecurity(t *testing.T) {
 	_, err = client.RunCron(addedCron.ID, env.executor2PrvKey)
 	assert.NotNil(t, err)
 	_, err = client.RunCron(addedCron.ID, env.colony1PrvKey)
-	assert.NotNil(t, err)
+	assert.Nil(t, err) // Colony owner and admins can also manage crons
 	_, err = client.RunCron(addedCron.ID, env.colony2PrvKey)
 	assert.NotNil(t, err)
 	_, err = client.RunCron(addedCron.ID, env.executor1PrvKey)
@@ -114,13 +169,16 @@ func TestDeleteCronSecurity(t *testing.T) {
 
 	err = client.DeleteCron(addedCron.ID, env.executor2PrvKey)
 	assert.NotNil(t, err)
-	err = client.DeleteCron(addedCron.ID, env.colony1PrvKey)
-	assert.NotNil(t, err)
 	err = client.DeleteCron(addedCron.ID, env.colony2PrvKey)
 	assert.NotNil(t, err)
 	err = client.DeleteCron(addedCron.ID, env.executor1PrvKey)
 	assert.Nil(t, err)
 
+	addedCron, err = client.AddCron(cron, env.executor1PrvKey)
+	assert.Nil(t, err)
+	err = client.DeleteCron(addedCron.ID, env.colony1PrvKey)
+	assert.Nil(t, err) // Colony owner and admins can also manage crons
+
 	server.Shutdown()
 	<-done
 }
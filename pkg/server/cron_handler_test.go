@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/colonyos/colonies/pkg/core"
 	"github.com/colonyos/colonies/pkg/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -320,6 +321,93 @@ func TestAddCronFail(t *testing.T) {
 	<-done
 }
 
+func TestUpdateCron(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	cron := utils.FakeCron(t, env.colonyID)
+	cron.Interval = 100
+	addedCron, err := client.AddCron(cron, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	addedCron.Interval = 200
+	addedCron.Paused = true
+	updatedCron, err := client.UpdateCron(addedCron, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, updatedCron.Interval, 200)
+	assert.True(t, updatedCron.Paused)
+
+	cronFromServer, err := client.GetCron(addedCron.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.True(t, cronFromServer.Paused)
+
+	addedCron.Interval = 0
+	_, err = client.UpdateCron(addedCron, env.executorPrvKey)
+	assert.NotNil(t, err) // Invalid interval
+
+	server.Shutdown()
+	<-done
+}
+
+func TestPausedCron(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	cron := utils.FakeCron(t, env.colonyID)
+	cron.Interval = 1
+	cron.Paused = true
+	_, err := client.AddCron(cron, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	time.Sleep(3 * time.Second)
+
+	// A paused cron should not have generated any processes
+	processes, err := client.GetWaitingProcesses(env.colonyID, "", 100, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, processes, 0)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestCronRunHistory(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	cron := utils.FakeCron(t, env.colonyID)
+	cron.Interval = 100
+	addedCron, err := client.AddCron(cron, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	runHistory, err := client.GetRunHistory(addedCron.ID, 100, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, runHistory, 0)
+
+	_, err = client.RunCron(addedCron.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	runHistory, err = client.GetRunHistory(addedCron.ID, 100, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, runHistory, 1)
+	assert.Equal(t, runHistory[0].TriggerType, core.CRON_TRIGGER)
+	assert.NotEqual(t, runHistory[0].ProcessGraphID, "")
+	assert.Equal(t, runHistory[0].State, core.WAITING)
+
+	// The state of the run should follow the state of the processgraph
+	process, err := client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	err = client.Fail(process.ID, []string{"error"}, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	runHistory, err = client.GetRunHistory(addedCron.ID, 100, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, runHistory, 1)
+	assert.Equal(t, runHistory[0].State, core.FAILED)
+
+	_, err = client.GetRunHistory(core.GenerateRandomID(), 100, env.executorPrvKey)
+	assert.NotNil(t, err) // No such cron or generator
+
+	server.Shutdown()
+	<-done
+}
+
 func TestGetCron(t *testing.T) {
 	env, client, server, _, done := setupTestEnv2(t)
 
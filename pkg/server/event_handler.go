@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"strconv"
 	"sync"
@@ -13,6 +14,7 @@ import (
 
 type eventHandler struct {
 	listeners         map[string]map[string]chan *core.Process
+	colonyListeners   map[string]map[string]*colonyListener
 	processIDs        map[string]string
 	msgQueue          chan *message
 	idCounter         int
@@ -23,6 +25,18 @@ type eventHandler struct {
 	stopRelayListener chan struct{}
 }
 
+type colonyListener struct {
+	kinds      []string
+	eventTypes []string
+	eventChan  chan *core.ColonyEvent
+}
+
+// Colony events are wrapped in an envelope when relayed to other Colonies servers so that they can be told apart
+// from processes
+type relayedColonyEvent struct {
+	ColonyEvent *core.ColonyEvent `json:"colonyevent"`
+}
+
 type message struct {
 	stop    bool // Just for testing purposes
 	handler func(msg *message)
@@ -31,6 +45,7 @@ type message struct {
 
 type replyMessage struct {
 	processChan  chan *core.Process
+	eventChan    chan *core.ColonyEvent
 	listenerID   string
 	allListeners int  // Just for testing purposes
 	listeners    int  // Just for testing purposes
@@ -42,6 +57,7 @@ func createEventHandler(relayServer *cluster.RelayServer) *eventHandler {
 	handler := &eventHandler{}
 	handler.listeners = make(map[string]map[string]chan *core.Process)
 	handler.processIDs = make(map[string]string)
+	handler.colonyListeners = make(map[string]map[string]*colonyListener)
 	handler.msgQueue = make(chan *message)
 	handler.relayServer = relayServer
 
@@ -65,6 +81,11 @@ func (handler *eventHandler) relayListener() {
 	for {
 		select {
 		case msg := <-handler.relayChan:
+			var relayed relayedColonyEvent
+			if err := json.Unmarshal(msg, &relayed); err == nil && relayed.ColonyEvent != nil {
+				handler.sendColonyEvent(relayed.ColonyEvent)
+				continue
+			}
 			process, err := core.ConvertJSONToProcess(string(msg))
 			if err != nil {
 				log.WithFields(log.Fields{"Error": err}).Warning("relayListener received invalid process JSON")
@@ -234,6 +255,120 @@ func (handler *eventHandler) subscribe(executorType string, state int, processID
 	return processChan, errChan
 }
 
+func (handler *eventHandler) registerColonyListener(colonyID string, kinds []string, eventTypes []string) (string, chan *core.ColonyEvent) {
+	if _, ok := handler.colonyListeners[colonyID]; !ok {
+		handler.colonyListeners[colonyID] = make(map[string]*colonyListener)
+	}
+
+	c := make(chan *core.ColonyEvent, 100)
+	listenerID := strconv.Itoa(handler.idCounter)
+	handler.colonyListeners[colonyID][listenerID] = &colonyListener{kinds: kinds, eventTypes: eventTypes, eventChan: c}
+	handler.idCounter++
+	return listenerID, c
+}
+
+func (handler *eventHandler) unregisterColonyListener(colonyID string, listenerID string) {
+	if _, ok := handler.colonyListeners[colonyID]; ok {
+		delete(handler.colonyListeners[colonyID], listenerID)
+	}
+
+	if len(handler.colonyListeners[colonyID]) == 0 {
+		delete(handler.colonyListeners, colonyID)
+	}
+}
+
+func (handler *eventHandler) sendColonyEvent(event *core.ColonyEvent) {
+	msg := &message{reply: make(chan replyMessage, 100), handler: func(msg *message) {
+		for listenerID, listener := range handler.colonyListeners[event.ColonyID] {
+			if listener.matches(event) {
+				select {
+				case listener.eventChan <- event:
+				default:
+					// The listener is closed rather than silently missing the event, the subscriber can then resume
+					// the subscription from its last sequence number
+					log.WithFields(log.Fields{"ColonyID": event.ColonyID, "Kind": event.Kind, "Type": event.Type}).Warning("Colony event listener is full, closing listener")
+					close(listener.eventChan)
+					handler.unregisterColonyListener(event.ColonyID, listenerID)
+				}
+			}
+		}
+	}}
+
+	handler.msgQueue <- msg // Send the message to the masterworker
+}
+
+func (listener *colonyListener) matches(event *core.ColonyEvent) bool {
+	return event.Matches(listener.kinds, listener.eventTypes)
+}
+
+// signalColonyEvent sends the event to all listeners of the colony, including listeners connected to other Colonies
+// servers in the cluster
+func (handler *eventHandler) signalColonyEvent(event *core.ColonyEvent) {
+	handler.sendColonyEvent(event)
+
+	go func() {
+		if handler.relayServer != nil {
+			jsonBytes, err := json.Marshal(relayedColonyEvent{ColonyEvent: event})
+			if err != nil {
+				log.WithFields(log.Fields{"Error": err}).Error("Failed to create colony event JSON in signalColonyEvent")
+				return
+			}
+			handler.relayServer.Broadcast(jsonBytes)
+		}
+	}()
+}
+
+func (handler *eventHandler) subscribeColonyEvents(colonyID string, kinds []string, eventTypes []string, ctx context.Context) (chan *core.ColonyEvent, chan error) {
+	// Register
+	msg := &message{reply: make(chan replyMessage, 100), handler: func(msg *message) {
+		listenerID, c := handler.registerColonyListener(colonyID, kinds, eventTypes)
+		r := replyMessage{eventChan: c, listenerID: listenerID}
+		msg.reply <- r
+	}}
+	handler.msgQueue <- msg
+
+	// Wait for the masterworker to execute the handler code
+	r := <-msg.reply
+
+	eventChan := make(chan *core.ColonyEvent, 100)
+	errChan := make(chan error)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				// Unregister
+				msg := &message{reply: make(chan replyMessage, 100), handler: func(msg *message) {
+					handler.unregisterColonyListener(colonyID, r.listenerID)
+				}}
+				handler.msgQueue <- msg
+				errChan <- errors.New("timeout")
+				return
+			case event, ok := <-r.eventChan:
+				if !ok {
+					// The listener was closed by the masterworker since it could not keep up
+					errChan <- errors.New("colony event listener is full")
+					return
+				}
+				eventChan <- event
+			}
+		}
+	}()
+
+	return eventChan, errChan
+}
+
+func (handler *eventHandler) numberOfColonyListeners(colonyID string) int { // Just for testing purposes
+	msg := &message{reply: make(chan replyMessage, 100), handler: func(msg *message) {
+		msg.reply <- replyMessage{listeners: len(handler.colonyListeners[colonyID])}
+	}}
+
+	handler.msgQueue <- msg
+	r := <-msg.reply
+
+	return r.listeners
+}
+
 func (handler *eventHandler) stop() {
 	handler.msgQueue <- &message{stop: true}
 	if handler.relayServer != nil {
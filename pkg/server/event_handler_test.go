@@ -343,6 +343,72 @@ func TestEventHandlerSubscribeProcessIDFailed(t *testing.T) {
 	assert.NotNil(t, retVal.err) // Not OK, will timeout
 }
 
+func TestEventHandlerSubscribeColonyEvents(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+	handler := createEventHandler(nil)
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 3000*time.Millisecond)
+	defer cancelCtx()
+	eventChan, _ := handler.subscribeColonyEvents(colonyID, []string{core.EXECUTOR_KIND}, nil, ctx)
+	assert.Equal(t, 1, handler.numberOfColonyListeners(colonyID))
+
+	// Events for other colonies or kinds should be filtered out
+	handler.signalColonyEvent(core.CreateColonyEvent(core.GenerateRandomID(), core.EXECUTOR_KIND, core.ADDED_EVENT, core.GenerateRandomID(), core.PENDING, ""))
+	handler.signalColonyEvent(core.CreateColonyEvent(colonyID, core.CRON_KIND, core.ADDED_EVENT, core.GenerateRandomID(), 0, ""))
+	event := core.CreateColonyEvent(colonyID, core.EXECUTOR_KIND, core.APPROVED_EVENT, core.GenerateRandomID(), core.APPROVED, "")
+	handler.signalColonyEvent(event)
+
+	select {
+	case receivedEvent := <-eventChan:
+		assert.True(t, event.Equals(receivedEvent))
+	case <-time.After(time.Second):
+		assert.Fail(t, "Timeout waiting for colony event")
+	}
+}
+
+func TestEventHandlerSubscribeColonyEventsFull(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+	handler := createEventHandler(nil)
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 3000*time.Millisecond)
+	defer cancelCtx()
+	eventChan, errChan := handler.subscribeColonyEvents(colonyID, nil, nil, ctx)
+	assert.Equal(t, 1, handler.numberOfColonyListeners(colonyID))
+
+	// The events are not read until all events have been signalled, the listener is closed instead of dropping
+	// events when it is full
+	for i := 0; i < 300; i++ {
+		handler.signalColonyEvent(core.CreateColonyEvent(colonyID, core.PROCESS_KIND, core.SUBMITTED_EVENT, core.GenerateRandomID(), core.WAITING, ""))
+	}
+	assert.Equal(t, 0, handler.numberOfColonyListeners(colonyID))
+
+	received := 0
+	for {
+		select {
+		case <-eventChan:
+			received++
+			continue
+		case err := <-errChan:
+			assert.NotNil(t, err)
+		case <-time.After(time.Second):
+			assert.Fail(t, "Timeout waiting for the listener to be closed")
+		}
+		break
+	}
+	assert.Less(t, received, 300)
+}
+
+func TestEventHandlerSubscribeColonyEventsTimeout(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+	handler := createEventHandler(nil)
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelCtx()
+	_, errChan := handler.subscribeColonyEvents(colonyID, nil, nil, ctx)
+	assert.NotNil(t, <-errChan)
+	assert.Equal(t, 0, handler.numberOfColonyListeners(colonyID))
+}
+
 func TestEventHandleRelayServer(t *testing.T) {
 	node1 := cluster.Node{Name: "etcd1", Host: "localhost", EtcdClientPort: 24100, EtcdPeerPort: 23100, RelayPort: 25100, APIPort: 26100}
 	node2 := cluster.Node{Name: "etcd2", Host: "localhost", EtcdClientPort: 24200, EtcdPeerPort: 23200, RelayPort: 25200, APIPort: 26200}
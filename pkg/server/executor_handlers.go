@@ -66,12 +66,9 @@ func (server *ColoniesServer) handleGetExecutorsHTTPRequest(c *gin.Context, reco
 		return
 	}
 
-	err = server.validator.RequireExecutorMembership(recoveredID, msg.ColonyID, false)
-	if err != nil {
-		err = server.validator.RequireColonyOwner(recoveredID, msg.ColonyID)
-		if server.handleHTTPError(c, err, http.StatusForbidden) {
-			return
-		}
+	err = server.validator.RequireExecutorMembershipOrColonyOwner(recoveredID, msg.ColonyID, false)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
 	}
 
 	executors, err := server.controller.getExecutorByColonyID(msg.ColonyID)
@@ -107,7 +104,7 @@ func (server *ColoniesServer) handleGetExecutorHTTPRequest(c *gin.Context, recov
 		return
 	}
 	if executor == nil {
-		server.handleHTTPError(c, errors.New("Failed to get executor, executor is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to get executor, executor is nil"), http.StatusNotFound)
 		return
 	}
 
@@ -144,10 +141,11 @@ func (server *ColoniesServer) handleApproveExecutorHTTPRequest(c *gin.Context, r
 		return
 	}
 	if executor == nil {
-		server.handleHTTPError(c, errors.New("Failed to approve executor, executor is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to approve executor, executor is nil"), http.StatusNotFound)
 		return
 	}
 
+	setAuditColony(c, executor.ColonyID)
 	err = server.validator.RequireColonyOwner(recoveredID, executor.ColonyID)
 	if server.handleHTTPError(c, err, http.StatusForbidden) {
 		return
@@ -181,10 +179,11 @@ func (server *ColoniesServer) handleRejectExecutorHTTPRequest(c *gin.Context, re
 		return
 	}
 	if executor == nil {
-		server.handleHTTPError(c, errors.New("Failed to reject executor, executor is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to reject executor, executor is nil"), http.StatusNotFound)
 		return
 	}
 
+	setAuditColony(c, executor.ColonyID)
 	err = server.validator.RequireColonyOwner(recoveredID, executor.ColonyID)
 	if server.handleHTTPError(c, err, http.StatusForbidden) {
 		return
@@ -218,10 +217,11 @@ func (server *ColoniesServer) handleDeleteExecutorHTTPRequest(c *gin.Context, re
 		return
 	}
 	if executor == nil {
-		server.handleHTTPError(c, errors.New("Failed to delete executor, executor is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to delete executor, executor is nil"), http.StatusNotFound)
 		return
 	}
 
+	setAuditColony(c, executor.ColonyID)
 	err = server.validator.RequireColonyOwner(recoveredID, executor.ColonyID)
 	if server.handleHTTPError(c, err, http.StatusForbidden) {
 		return
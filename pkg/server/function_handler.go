@@ -41,7 +41,7 @@ func (server *ColoniesServer) handleAddFunctionHTTPRequest(c *gin.Context, recov
 	functions, err := server.controller.getFunctionsByExecutorID(msg.Function.ExecutorID)
 	for _, function := range functions {
 		if function.FuncName == msg.Function.FuncName {
-			if server.handleHTTPError(c, errors.New("Function already exists"), http.StatusForbidden) {
+			if server.handleHTTPError(c, errors.New("Function already exists"), http.StatusConflict) {
 				return
 			}
 		}
@@ -98,7 +98,7 @@ func (server *ColoniesServer) handleGetFunctionsHTTPRequest(c *gin.Context, reco
 			return
 		}
 		if targetExecutor == nil {
-			if server.handleHTTPError(c, errors.New("Executor not found"), http.StatusForbidden) {
+			if server.handleHTTPError(c, errors.New("Executor not found"), http.StatusNotFound) {
 				return
 			}
 		}
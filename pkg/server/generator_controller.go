@@ -33,6 +33,32 @@ func (controller *coloniesController) addGenerator(generator *core.Generator) (*
 	}
 }
 
+func (controller *coloniesController) updateGenerator(generator *core.Generator) (*core.Generator, error) {
+	cmd := &command{generatorReplyChan: make(chan *core.Generator, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			err := controller.db.UpdateGeneratorSettings(generator)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			updatedGenerator, err := controller.db.GetGeneratorByID(generator.ID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.generatorReplyChan <- updatedGenerator
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case updatedGenerator := <-cmd.generatorReplyChan:
+		return updatedGenerator, nil
+	}
+}
+
 func (controller *coloniesController) triggerGenerators() {
 	cmd := &command{handler: func(cmd *command) {
 		generatorsFromDB, err := controller.db.FindAllGenerators()
@@ -41,6 +67,9 @@ func (controller *coloniesController) triggerGenerators() {
 			return
 		}
 		for _, generator := range generatorsFromDB {
+			if generator.Paused { // Args are still packed while paused, they are consumed when the generator is resumed
+				continue
+			}
 			counter, err := controller.db.CountGeneratorArgs(generator.ID)
 			if err != nil {
 				log.WithFields(log.Fields{"Error": err}).Error("Failed count generator args from db")
@@ -201,6 +230,7 @@ func (controller *coloniesController) submitWorkflow(generator *core.Generator,
 	workflowSpec, err := core.ConvertJSONToWorkflowSpec(generator.WorkflowSpec)
 	if err != nil {
 		log.WithFields(log.Fields{"Error": err}).Error("Failed to parse workflow spec")
+		controller.addRunRecord(core.CreateFailedRunRecord(generator.ColonyID, generator.ID, core.GENERATOR_TRIGGER, time.Now(), err.Error()))
 		return
 	}
 
@@ -222,14 +252,17 @@ func (controller *coloniesController) submitWorkflow(generator *core.Generator,
 		argsif[k] = v
 	}
 
-	_, err = controller.createProcessGraph(workflowSpec, argsif, make([]interface{}, 0))
+	processGraph, err := controller.createProcessGraph(workflowSpec, argsif, make([]interface{}, 0))
 	if err != nil {
 		log.WithFields(log.Fields{
 			"Error": err}).
 			Error("Failed to create generator processgraph")
+		controller.addRunRecord(core.CreateFailedRunRecord(generator.ColonyID, generator.ID, core.GENERATOR_TRIGGER, time.Now(), err.Error()))
 		return
 	}
 
+	controller.addRunRecord(core.CreateRunRecord(generator.ColonyID, generator.ID, core.GENERATOR_TRIGGER, time.Now(), processGraph.ID))
+
 	// Now it safe to remove the args since they are now attached to a process graph
 	for _, generatorArg := range generatorArgs {
 		count, err := controller.db.CountGeneratorArgs(generator.ID)
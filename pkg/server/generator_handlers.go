@@ -43,6 +43,11 @@ func (server *ColoniesServer) handleAddGeneratorHTTPRequest(c *gin.Context, reco
 		return
 	}
 
+	msg.Generator.WorkflowSpec, err = server.pinWorkflowTemplate(msg.Generator.ColonyID, msg.Generator.WorkflowSpec)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
 	msg.Generator.ID = core.GenerateRandomID()
 	addedGenerator, err := server.controller.addGenerator(msg.Generator)
 	if server.handleHTTPError(c, err, http.StatusBadRequest) {
@@ -63,6 +68,66 @@ func (server *ColoniesServer) handleAddGeneratorHTTPRequest(c *gin.Context, reco
 	server.sendHTTPReply(c, payloadType, jsonString)
 }
 
+func (server *ColoniesServer) handleUpdateGeneratorHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateUpdateGeneratorMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to update generator, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to update generator, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+	if msg.Generator == nil {
+		server.handleHTTPError(c, errors.New("Failed to update generator, msg.Generator is nil"), http.StatusBadRequest)
+		return
+	}
+
+	generator, err := server.controller.getGenerator(msg.Generator.ID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if generator == nil {
+		server.handleHTTPError(c, errors.New("Failed to update generator, generator is nil"), http.StatusNotFound)
+		return
+	}
+
+	err = server.validator.RequireExecutorMembership(recoveredID, generator.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	err = VerifyGenerator(msg.Generator)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	msg.Generator.WorkflowSpec, err = server.pinWorkflowTemplate(generator.ColonyID, msg.Generator.WorkflowSpec)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	updatedGenerator, err := server.controller.updateGenerator(msg.Generator)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if updatedGenerator == nil {
+		server.handleHTTPError(c, errors.New("Failed to update generator, updatedGenerator is nil"), http.StatusInternalServerError)
+		return
+	}
+
+	jsonString, err = updatedGenerator.ToJSON()
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"GeneratorId": updatedGenerator.ID, "Paused": updatedGenerator.Paused}).Debug("Updating generator")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
 func (server *ColoniesServer) handleGetGeneratorHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
 	msg, err := rpc.CreateGetGeneratorMsgFromJSON(jsonString)
 	if err != nil {
@@ -81,7 +146,7 @@ func (server *ColoniesServer) handleGetGeneratorHTTPRequest(c *gin.Context, reco
 		return
 	}
 	if generator == nil {
-		server.handleHTTPError(c, errors.New("Failed to get generator, generator is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to get generator, generator is nil"), http.StatusNotFound)
 		return
 	}
 
@@ -125,7 +190,7 @@ func (server *ColoniesServer) handleResolveGeneratorHTTPRequest(c *gin.Context,
 		return
 	}
 	if generator == nil {
-		server.handleHTTPError(c, errors.New("Failed to resolve generator, generator is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to resolve generator, generator is nil"), http.StatusNotFound)
 		return
 	}
 
@@ -199,7 +264,7 @@ func (server *ColoniesServer) handlePackGeneratorHTTPRequest(c *gin.Context, rec
 		return
 	}
 	if generator == nil {
-		server.handleHTTPError(c, errors.New("Failed to increment generator, generator is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to increment generator, generator is nil"), http.StatusNotFound)
 		return
 	}
 
@@ -236,7 +301,7 @@ func (server *ColoniesServer) handleDeleteGeneratorHTTPRequest(c *gin.Context, r
 		return
 	}
 	if generator == nil {
-		server.handleHTTPError(c, errors.New("Failed to delete generator, generator is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to delete generator, generator is nil"), http.StatusNotFound)
 		return
 	}
 
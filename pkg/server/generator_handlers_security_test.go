@@ -55,6 +55,31 @@ func TestGetGeneratorSecurity(t *testing.T) {
 	<-done
 }
 
+func TestUpdateGeneratorSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	generator := utils.FakeGenerator(t, env.colony1ID)
+	addedGenerator, err := client.AddGenerator(generator, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	addedGenerator.Paused = true
+	_, err = client.UpdateGenerator(addedGenerator, env.executor2PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.UpdateGenerator(addedGenerator, env.colony1PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.UpdateGenerator(addedGenerator, env.colony2PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.UpdateGenerator(addedGenerator, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
 func TestResolveGeneratorSecurity(t *testing.T) {
 	env, client, server, _, done := setupTestEnv1(t)
 
@@ -297,6 +297,28 @@ func TestGetGenerator(t *testing.T) {
 	<-done
 }
 
+func TestUpdateGenerator(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	generator := utils.FakeGenerator(t, env.colonyID)
+	addedGenerator, err := client.AddGenerator(generator, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	addedGenerator.Trigger = 20
+	addedGenerator.Paused = true
+	updatedGenerator, err := client.UpdateGenerator(addedGenerator, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, updatedGenerator.Trigger, 20)
+	assert.True(t, updatedGenerator.Paused)
+
+	addedGenerator.Trigger = 0
+	_, err = client.UpdateGenerator(addedGenerator, env.executorPrvKey)
+	assert.NotNil(t, err) // Invalid trigger
+
+	server.Shutdown()
+	<-done
+}
+
 func TestResolveGenerator(t *testing.T) {
 	env, client, server, _, done := setupTestEnv2(t)
 
@@ -0,0 +1,16 @@
+package server
+
+import (
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+// rotateKey sets the current key of a colony or executor, the previous key can no longer be used
+func (controller *coloniesController) rotateKey(identityKey *core.IdentityKey) error {
+	cmd := &command{threaded: true, errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			cmd.errorChan <- controller.db.SetIdentityKey(identityKey)
+		}}
+
+	controller.cmdQueue <- cmd
+	return <-cmd.errorChan
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// resolveKeyID returns the Id of the colony or executor a key belongs to. The Id derived from a key is the Id of
+// the identity unless the key has been rotated, keys that have been rotated out are rejected.
+func (server *ColoniesServer) resolveKeyID(keyID string) (string, error) {
+	identityID, rotated, err := server.db.GetIdentityIDByKeyID(keyID)
+	if err != nil {
+		return "", err
+	}
+	if rotated {
+		return "", errors.New("Private key has been rotated and can no longer be used")
+	}
+	if identityID != "" {
+		return identityID, nil
+	}
+
+	return keyID, nil
+}
+
+func (server *ColoniesServer) handleRotateKeyHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateRotateKeyMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to rotate key, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to rotate key, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	// Only the holder of the current private key can rotate it
+	identityID := msg.ColonyID
+	if msg.ExecutorID != "" {
+		if recoveredID != msg.ExecutorID {
+			server.handleHTTPError(c, errors.New("Failed to rotate key, RecoveredID does not match Executor Id"), http.StatusForbidden)
+			return
+		}
+		err = server.validator.RequireExecutorMembership(recoveredID, msg.ColonyID, false)
+		if server.handleHTTPError(c, err, http.StatusForbidden) {
+			return
+		}
+		identityID = msg.ExecutorID
+	} else {
+		// Colony admins are not allowed to rotate the colony key
+		if recoveredID != msg.ColonyID {
+			server.handleHTTPError(c, errors.New("Failed to rotate key, RecoveredID does not match Colony Id"), http.StatusForbidden)
+			return
+		}
+		err = server.validator.RequireColonyOwner(recoveredID, msg.ColonyID)
+		if server.handleHTTPError(c, err, http.StatusForbidden) {
+			return
+		}
+	}
+
+	// The signature proves that the new private key is held by the one rotating the key
+	newKeyID, err := server.crypto.RecoverID(core.KeyRotationProof(identityID), msg.NewKeySignature)
+	if err != nil {
+		server.handleHTTPError(c, errors.New("Failed to rotate key, invalid new key signature"), http.StatusBadRequest)
+		return
+	}
+
+	err = server.verifyNewKeyID(newKeyID, identityID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	identityKey := core.CreateIdentityKey(newKeyID, identityID, msg.ColonyID)
+	err = server.controller.rotateKey(identityKey)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	jsonString, err = identityKey.ToJSON()
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"IdentityId": identityID, "KeyId": newKeyID}).Debug("Rotating key")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
+// verifyNewKeyID checks that a new key is not already used by another identity
+func (server *ColoniesServer) verifyNewKeyID(newKeyID string, identityID string) error {
+	if newKeyID == identityID {
+		return errors.New("Failed to rotate key, the new private key must differ from the original private key")
+	}
+
+	// Keys that have been rotated out cannot be used again
+	keyIdentityID, _, err := server.db.GetIdentityIDByKeyID(newKeyID)
+	if err != nil {
+		return err
+	}
+	if keyIdentityID != "" {
+		return errors.New("Failed to rotate key, the new private key is already in use")
+	}
+
+	colony, err := server.controller.getColony(newKeyID)
+	if err != nil {
+		return err
+	}
+	if colony != nil {
+		return errors.New("Failed to rotate key, the new private key is already in use")
+	}
+
+	executor, err := server.controller.getExecutor(newKeyID)
+	if err != nil {
+		return err
+	}
+	if executor != nil {
+		return errors.New("Failed to rotate key, the new private key is already in use")
+	}
+
+	return nil
+}
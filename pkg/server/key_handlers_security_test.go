@@ -0,0 +1,36 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/security/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotateKeySecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	crypto := crypto.CreateCrypto()
+	newPrvKey, err := crypto.GeneratePrivateKey()
+	assert.Nil(t, err)
+
+	_, err = client.RotateKey(env.colony1ID, "", newPrvKey, env.executor1PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.RotateKey(env.colony1ID, "", newPrvKey, env.colony2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.RotateKey(env.colony1ID, env.executor1ID, newPrvKey, env.colony1PrvKey)
+	assert.NotNil(t, err) // Should not work, only the executor can rotate its key
+	_, err = client.RotateKey(env.colony1ID, env.executor1ID, newPrvKey, env.executor2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.RotateKey(env.colony2ID, env.executor1ID, newPrvKey, env.executor1PrvKey)
+	assert.NotNil(t, err) // Should not work, executor1 is not a member of colony2
+	_, err = client.RotateKey(env.colony1ID, env.executor1ID, newPrvKey, env.executor1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
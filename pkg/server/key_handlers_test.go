@@ -0,0 +1,100 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/security/crypto"
+	"github.com/colonyos/colonies/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotateColonyKey(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	crypto := crypto.CreateCrypto()
+	newColonyPrvKey, err := crypto.GeneratePrivateKey()
+	assert.Nil(t, err)
+
+	identityKey, err := client.RotateKey(env.colonyID, "", newColonyPrvKey, env.colonyPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, identityKey.IdentityID, env.colonyID)
+
+	_, err = client.GetSecrets(env.colonyID, env.colonyPrvKey)
+	assert.NotNil(t, err) // The old key has been rotated
+	_, err = client.GetSecrets(env.colonyID, newColonyPrvKey)
+	assert.Nil(t, err)
+
+	// Rotate again, the intermediate key should no longer work
+	newColonyPrvKey2, err := crypto.GeneratePrivateKey()
+	assert.Nil(t, err)
+	_, err = client.RotateKey(env.colonyID, "", newColonyPrvKey2, newColonyPrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.GetSecrets(env.colonyID, newColonyPrvKey)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "rotated")
+	_, err = client.GetSecrets(env.colonyID, env.colonyPrvKey)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "rotated")
+	_, err = client.GetSecrets(env.colonyID, newColonyPrvKey2)
+	assert.Nil(t, err)
+
+	// A key that has been rotated out cannot be used again
+	_, err = client.RotateKey(env.colonyID, "", newColonyPrvKey, newColonyPrvKey2)
+	assert.NotNil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestRotateExecutorKey(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	crypto := crypto.CreateCrypto()
+	newExecutorPrvKey, err := crypto.GeneratePrivateKey()
+	assert.Nil(t, err)
+
+	identityKey, err := client.RotateKey(env.colonyID, env.executorID, newExecutorPrvKey, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, identityKey.IdentityID, env.executorID)
+
+	funcSpec := utils.CreateTestFunctionSpec(env.colonyID)
+	_, err = client.Submit(funcSpec, env.executorPrvKey)
+	assert.NotNil(t, err) // The old key has been rotated
+	addedProcess, err := client.Submit(funcSpec, newExecutorPrvKey)
+	assert.Nil(t, err)
+
+	assignedProcess, err := client.Assign(env.colonyID, -1, newExecutorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.ID, addedProcess.ID)
+	assert.Equal(t, assignedProcess.AssignedExecutorID, env.executorID) // The executor Id does not change
+
+	err = client.Close(assignedProcess.ID, newExecutorPrvKey)
+	assert.Nil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestRotateKeyInvalidNewKey(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	// The new key cannot be the same as the original key
+	_, err := client.RotateKey(env.colonyID, "", env.colonyPrvKey, env.colonyPrvKey)
+	assert.NotNil(t, err)
+
+	// The new key cannot be used by another identity
+	_, err = client.RotateKey(env.colonyID, "", env.executorPrvKey, env.colonyPrvKey)
+	assert.NotNil(t, err)
+
+	crypto := crypto.CreateCrypto()
+	newPrvKey, err := crypto.GeneratePrivateKey()
+	assert.Nil(t, err)
+	_, err = client.RotateKey(env.colonyID, "", newPrvKey, env.colonyPrvKey)
+	assert.Nil(t, err)
+	_, err = client.RotateKey(env.colonyID, env.executorID, newPrvKey, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	server.Shutdown()
+	<-done
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+// addLog appends a chunk to the log of a process, the command is not threaded so that the offsets of
+// concurrently added chunks do not overlap
+func (controller *coloniesController) addLog(process *core.Process, executorID string, message string) (*core.Log, error) {
+	cmd := &command{logReplyChan: make(chan *core.Log, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			if len(message) > MAX_LOG_CHUNK_SIZE {
+				cmd.errorChan <- errors.New("Log chunk is larger than MaxLogChunkSize limit <" + strconv.Itoa(MAX_LOG_CHUNK_SIZE) + ">")
+				return
+			}
+			size, err := controller.db.GetLogSize(process.ID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			if size+int64(len(message)) > MAX_LOG_SIZE {
+				cmd.errorChan <- core.CreateError(core.ERROR_QUOTA_EXCEEDED, "Log of process with Id <"+process.ID+"> is larger than MaxLogSize limit <"+strconv.Itoa(MAX_LOG_SIZE)+">")
+				return
+			}
+			log := core.CreateLog(process, executorID, size, message)
+			err = controller.db.AddLog(log)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.logReplyChan <- log
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case log := <-cmd.logReplyChan:
+		return log, nil
+	}
+}
+
+func (controller *coloniesController) findLogs(processID string, offset int64, count int) ([]*core.Log, error) {
+	cmd := &command{threaded: true, logsReplyChan: make(chan []*core.Log, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			logs, err := controller.db.FindLogs(processID, offset, count)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.logsReplyChan <- logs
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case logs := <-cmd.logsReplyChan:
+		return logs, nil
+	}
+}
+
+// getLogs returns the chunks of a process log starting at offset. If no chunks are available and timeout is
+// larger than 0, it waits at most timeout seconds, capped at MAX_LOG_TIMEOUT, for new chunks, or until the
+// process has finished.
+func (controller *coloniesController) getLogs(processID string, offset int64, count int, timeout int) ([]*core.Log, error) {
+	if count > MAX_COUNT {
+		return nil, errors.New("Count is larger than MaxCount limit <" + strconv.Itoa(MAX_COUNT) + ">")
+	}
+
+	if timeout > MAX_LOG_TIMEOUT {
+		timeout = MAX_LOG_TIMEOUT
+	}
+
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+	for {
+		// The state must be checked before the logs are fetched, otherwise chunks added just before the
+		// process finished could be missed
+		process, err := controller.getProcess(processID)
+		if err != nil {
+			return nil, err
+		}
+		if process == nil {
+			return nil, core.CreateError(core.ERROR_NOT_FOUND, "Failed to get logs, process with Id <"+processID+"> not found")
+		}
+		finished := process.State == core.SUCCESS || process.State == core.FAILED || process.State == core.SKIPPED
+
+		logs, err := controller.findLogs(processID, offset, count)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(logs) > 0 || finished || time.Now().After(deadline) {
+			return logs, nil
+		}
+
+		time.Sleep(LOG_POLL_PERIOD * time.Millisecond)
+	}
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+func (server *ColoniesServer) handleAddLogHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateAddLogMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to add log, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to add log, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	process, err := server.controller.getProcess(msg.ProcessID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if process == nil {
+		server.handleHTTPError(c, errors.New("Failed to add log, process is nil"), http.StatusNotFound)
+		return
+	}
+
+	err = server.validator.RequireExecutorMembership(recoveredID, process.FunctionSpec.Conditions.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	if process.State != core.RUNNING {
+		err := errors.New("Failed to add log, process is not running")
+		server.handleHTTPError(c, err, http.StatusForbidden)
+		return
+	}
+
+	if process.AssignedExecutorID != recoveredID {
+		err := errors.New("Failed to add log, only executor with id <" + process.AssignedExecutorID + "> is allowed to add logs")
+		server.handleHTTPError(c, err, http.StatusForbidden)
+		return
+	}
+
+	addedLog, err := server.controller.addLog(process, recoveredID, msg.Message)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	jsonString, err = addedLog.ToJSON()
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ProcessId": process.ID, "Offset": addedLog.Offset}).Debug("Adding log")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
+func (server *ColoniesServer) handleGetLogsHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateGetLogsMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to get logs, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to get logs, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	process, err := server.controller.getProcess(msg.ProcessID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if process == nil {
+		server.handleHTTPError(c, errors.New("Failed to get logs, process is nil"), http.StatusNotFound)
+		return
+	}
+
+	err = server.validator.RequireExecutorMembership(recoveredID, process.FunctionSpec.Conditions.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	logs, err := server.controller.getLogs(process.ID, msg.Offset, msg.Count, msg.Timeout)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	jsonString, err = core.ConvertLogArrayToJSON(logs)
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ProcessId": process.ID, "Offset": msg.Offset, "Count": msg.Count}).Debug("Getting logs")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
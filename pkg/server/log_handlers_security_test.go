@@ -0,0 +1,64 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddLogSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	funcSpec := utils.CreateTestFunctionSpec(env.colony1ID)
+	addedProcess, err := client.Submit(funcSpec, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.Assign(env.colony1ID, -1, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.AddLog(addedProcess.ID, "hello\n", env.executor2PrvKey)
+	assert.NotNil(t, err) // Should not work
+
+	// Only the executor the process is assigned to can add logs
+	executor3, executor3PrvKey, err := utils.CreateTestExecutorWithKey(env.colony1ID)
+	assert.Nil(t, err)
+	_, err = client.AddExecutor(executor3, env.colony1PrvKey)
+	assert.Nil(t, err)
+	err = client.ApproveExecutor(executor3.ID, env.colony1PrvKey)
+	assert.Nil(t, err)
+	_, err = client.AddLog(addedProcess.ID, "hello\n", executor3PrvKey)
+	assert.NotNil(t, err) // Should not work
+
+	_, err = client.AddLog(addedProcess.ID, "hello\n", env.executor1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
+
+func TestGetLogsSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	funcSpec := utils.CreateTestFunctionSpec(env.colony1ID)
+	addedProcess, err := client.Submit(funcSpec, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.GetLogs(addedProcess.ID, 0, 100, 0, env.executor2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.GetLogs(addedProcess.ID, 0, 100, 0, env.colony1PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.GetLogs(addedProcess.ID, 0, 100, 0, env.executor1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddLog(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	funcSpec := utils.CreateTestFunctionSpec(env.colonyID)
+	addedProcess, err := client.Submit(funcSpec, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	// Logs can only be added to running processes
+	_, err = client.AddLog(addedProcess.ID, "line1\n", env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	_, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	log1, err := client.AddLog(addedProcess.ID, "line1\n", env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, log1.Offset, int64(0))
+	log2, err := client.AddLog(addedProcess.ID, "line2\n", env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, log2.Offset, log1.End())
+	assert.Equal(t, log2.ExecutorID, env.executorID)
+
+	_, err = client.AddLog(addedProcess.ID, strings.Repeat("x", MAX_LOG_CHUNK_SIZE+1), env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	logs, err := client.GetLogs(addedProcess.ID, 0, 100, 0, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.True(t, core.IsLogArraysEqual(logs, []*core.Log{log1, log2}))
+
+	logs, err = client.GetLogs(addedProcess.ID, log1.End(), 100, 0, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, logs, 1)
+	assert.Equal(t, logs[0].Message, "line2\n")
+
+	_, err = client.GetLogs(addedProcess.ID, 0, MAX_COUNT+1, 0, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestGetLogsFollow(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	funcSpec := utils.CreateTestFunctionSpec(env.colonyID)
+	addedProcess, err := client.Submit(funcSpec, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	go func() {
+		time.Sleep(1 * time.Second)
+		client.AddLog(addedProcess.ID, "hello\n", env.executorPrvKey)
+	}()
+
+	// Waits for the chunk added by the goroutine
+	logs, err := client.GetLogs(addedProcess.ID, 0, 100, 10, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, logs, 1)
+	assert.Equal(t, logs[0].Message, "hello\n")
+
+	err = client.Close(addedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	// Returns immediately since the process has finished
+	start := time.Now()
+	logs, err = client.GetLogs(addedProcess.ID, logs[0].End(), 100, 10, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, logs, 0)
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestGetLogsFollowSkipped(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	funcSpec := utils.CreateTestFunctionSpec(env.colonyID)
+	addedProcess, err := client.Submit(funcSpec, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	err = server.db.SetProcessState(addedProcess.ID, core.SKIPPED)
+	assert.Nil(t, err)
+
+	// Returns immediately since a skipped process never produces any logs, the timeout is capped at MAX_LOG_TIMEOUT
+	start := time.Now()
+	logs, err := client.GetLogs(addedProcess.ID, 0, 100, 3600, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, logs, 0)
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	server.Shutdown()
+	<-done
+}
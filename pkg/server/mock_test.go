@@ -32,7 +32,7 @@ func createFakeColoniesController() (*coloniesController, *dbMock) {
 	clusterConfig := cluster.Config{}
 	clusterConfig.AddNode(node)
 	dbMock := &dbMock{}
-	return createColoniesController(dbMock, node, clusterConfig, "/tmp/colonies/etcd", GENERATOR_TRIGGER_PERIOD, CRON_TRIGGER_PERIOD, false, -1, 500), dbMock
+	return createColoniesController(dbMock, node, clusterConfig, "/tmp/colonies/etcd", GENERATOR_TRIGGER_PERIOD, CRON_TRIGGER_PERIOD, false, -1, 500, false), dbMock
 }
 
 // controllerMock
@@ -69,6 +69,10 @@ func (v *controllerMock) subscribeProcess(executorID string, subscription *subsc
 	return nil
 }
 
+func (v *controllerMock) subscribeColonyEvents(executorID string, subscription *subscription) error {
+	return nil
+}
+
 func (v *controllerMock) getColonies() ([]*core.Colony, error) {
 	return nil, nil
 }
@@ -129,6 +133,10 @@ func (v *controllerMock) getProcess(processID string) (*core.Process, error) {
 	return nil, nil
 }
 
+func (v *controllerMock) getProcessEvents(processID string) ([]*core.ProcessEvent, error) {
+	return nil, nil
+}
+
 func (v *controllerMock) findProcessHistory(colonyID string, executorID string, seconds int, state int) ([]*core.Process, error) {
 	return nil, nil
 }
@@ -189,6 +197,10 @@ func (v *controllerMock) deleteAllProcesses(colonyID string, state int) error {
 	return nil
 }
 
+func (v *controllerMock) retryProcessGraph(processGraphID string) (*core.ProcessGraph, error) {
+	return nil, nil
+}
+
 func (v *controllerMock) deleteProcessGraph(processID string) error {
 	return nil
 }
@@ -197,7 +209,7 @@ func (v *controllerMock) deleteAllProcessGraphs(colonyID string, state int) erro
 	return nil
 }
 
-func (v *controllerMock) closeSuccessful(processID string, executorID string, output []interface{}) error {
+func (v *controllerMock) closeSuccessful(processID string, executorID string, output []interface{}, namedOutput map[string]interface{}) error {
 	return nil
 }
 
@@ -269,6 +281,10 @@ func (v *controllerMock) addGenerator(generator *core.Generator) (*core.Generato
 	return nil, nil
 }
 
+func (v *controllerMock) updateGenerator(generator *core.Generator) (*core.Generator, error) {
+	return nil, nil
+}
+
 func (v *controllerMock) getGenerator(generatorID string) (*core.Generator, error) {
 	if v.returnError == "getGenerator" {
 		return nil, errors.New("error")
@@ -306,6 +322,10 @@ func (v *controllerMock) addCron(cron *core.Cron) (*core.Cron, error) {
 	return nil, nil
 }
 
+func (v *controllerMock) updateCron(cron *core.Cron) (*core.Cron, error) {
+	return nil, nil
+}
+
 func (v *controllerMock) deleteGenerator(generatorID string) error {
 	return nil
 }
@@ -330,7 +350,119 @@ func (v *controllerMock) calcNextRun(cron *core.Cron) time.Time {
 	return time.Time{}
 }
 
-func (v *controllerMock) startCron(cron *core.Cron) {
+func (v *controllerMock) getRunHistory(triggerID string, count int) ([]*core.RunRecord, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) addAuditRecord(auditRecord *core.AuditRecord) error {
+	return nil
+}
+
+func (v *controllerMock) addLog(process *core.Process, executorID string, message string) (*core.Log, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) getLogs(processID string, offset int64, count int, timeout int) ([]*core.Log, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) addArtifact(artifact *core.Artifact) (*core.Artifact, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) getArtifact(artifactID string) (*core.Artifact, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) getArtifacts(colonyID string, count int) ([]*core.Artifact, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) deleteArtifact(artifactID string) error {
+	return nil
+}
+
+func (v *controllerMock) addWebhook(webhook *core.Webhook) (*core.Webhook, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) getWebhook(webhookID string) (*core.Webhook, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) getWebhooks(colonyID string) ([]*core.Webhook, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) deleteWebhook(webhookID string) error {
+	return nil
+}
+
+func (v *controllerMock) getWebhookDeliveries(webhookID string, count int) ([]*core.WebhookDelivery, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) addSecret(secret *core.Secret) (*core.Secret, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) getSecret(colonyID string, name string) (*core.Secret, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) getSecrets(colonyID string) ([]*core.Secret, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) deleteSecret(colonyID string, name string) error {
+	return nil
+}
+
+func (v *controllerMock) rotateKey(identityKey *core.IdentityKey) error {
+	return nil
+}
+
+func (v *controllerMock) addColonyAdmin(admin *core.ColonyAdmin) (*core.ColonyAdmin, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) getColonyAdmin(colonyID string, adminID string) (*core.ColonyAdmin, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) getColonyAdmins(colonyID string) ([]*core.ColonyAdmin, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) deleteColonyAdmin(colonyID string, adminID string) error {
+	return nil
+}
+
+func (v *controllerMock) getAuditLog(colonyID string, count int) ([]*core.AuditRecord, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) addWorkflowTemplate(template *core.WorkflowTemplate) (*core.WorkflowTemplate, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) getWorkflowTemplate(colonyID string, name string, version int) (*core.WorkflowTemplate, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) getWorkflowTemplates(colonyID string) ([]*core.WorkflowTemplate, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) deleteWorkflowTemplate(colonyID string, name string, version int) error {
+	return nil
+}
+
+func (v *controllerMock) instantiateWorkflowTemplate(workflowSpec *core.WorkflowSpec) (*core.WorkflowSpec, error) {
+	return nil, nil
+}
+
+func (v *controllerMock) startCron(cron *core.Cron, scheduledTime time.Time) {
 }
 
 func (v *controllerMock) triggerCrons() {
@@ -382,6 +514,10 @@ func (v *validatorMock) RequireExecutorMembership(recoveredID string, colonyID s
 	return nil
 }
 
+func (v *validatorMock) RequireExecutorMembershipOrColonyOwner(recoveredID string, colonyID string, approved bool) error {
+	return nil
+}
+
 type dbMock struct {
 	returnError string
 	returnValue string
@@ -680,6 +816,14 @@ func (db *dbMock) SetOutput(processID string, output []interface{}) error {
 	return nil
 }
 
+func (db *dbMock) SetNamedInput(processID string, namedInput map[string]interface{}) error {
+	return nil
+}
+
+func (db *dbMock) SetNamedOutput(processID string, namedOutput map[string]interface{}) error {
+	return nil
+}
+
 func (db *dbMock) SetErrors(processID string, errs []string) error {
 	return nil
 }
@@ -828,6 +972,10 @@ func (db *dbMock) GetProcessGraphByID(processGraphID string) (*core.ProcessGraph
 	return nil, nil
 }
 
+func (db *dbMock) FindProcessGraphsByParentProcessID(parentProcessID string) ([]*core.ProcessGraph, error) {
+	return nil, nil
+}
+
 func (db *dbMock) SetProcessGraphState(processGraphID string, state int) error {
 	return nil
 }
@@ -848,6 +996,14 @@ func (db *dbMock) FindFailedProcessGraphs(colonyID string, count int) ([]*core.P
 	return nil, nil
 }
 
+func (db *dbMock) FindProcessGraphsWithExpiredDeadline() ([]*core.ProcessGraph, error) {
+	return nil, nil
+}
+
+func (db *dbMock) ResetProcessGraph(processGraph *core.ProcessGraph) error {
+	return nil
+}
+
 func (db *dbMock) DeleteProcessGraphByID(processGraphID string) error {
 	return nil
 }
@@ -916,6 +1072,10 @@ func (db *dbMock) SetGeneratorFirstPack(generatorID string) error {
 	return nil
 }
 
+func (db *dbMock) UpdateGeneratorSettings(generator *core.Generator) error {
+	return nil
+}
+
 func (db *dbMock) GetGeneratorByID(generatorID string) (*core.Generator, error) {
 	return nil, nil
 }
@@ -976,6 +1136,10 @@ func (db *dbMock) UpdateCron(cronID string, nextRun time.Time, lastRun time.Time
 	return nil
 }
 
+func (db *dbMock) UpdateCronSettings(cron *core.Cron) error {
+	return nil
+}
+
 func (db *dbMock) GetCronByID(cronID string) (*core.Cron, error) {
 
 	return nil, nil
@@ -997,6 +1161,202 @@ func (db *dbMock) DeleteAllCronsByColonyID(colonyID string) error {
 	return nil
 }
 
+func (db *dbMock) FindProcessEvents(processID string) ([]*core.ProcessEvent, error) {
+	return nil, nil
+}
+
+func (db *dbMock) AddAuditRecord(auditRecord *core.AuditRecord) error {
+	return nil
+}
+
+func (db *dbMock) AddLog(log *core.Log) error {
+	return nil
+}
+
+func (db *dbMock) GetLogSize(processID string) (int64, error) {
+	return 0, nil
+}
+
+func (db *dbMock) FindLogs(processID string, offset int64, count int) ([]*core.Log, error) {
+	return nil, nil
+}
+
+func (db *dbMock) AddArtifact(artifact *core.Artifact) error {
+	return nil
+}
+
+func (db *dbMock) GetArtifactByID(artifactID string) (*core.Artifact, error) {
+	return nil, nil
+}
+
+func (db *dbMock) FindArtifactsByColonyID(colonyID string, count int) ([]*core.Artifact, error) {
+	return nil, nil
+}
+
+func (db *dbMock) DeleteArtifactByID(artifactID string) error {
+	return nil
+}
+
+func (db *dbMock) DeleteAllArtifactsByColonyID(colonyID string) error {
+	return nil
+}
+
+func (db *dbMock) AddWebhook(webhook *core.Webhook) error {
+	return nil
+}
+
+func (db *dbMock) GetWebhookByID(webhookID string) (*core.Webhook, error) {
+	return nil, nil
+}
+
+func (db *dbMock) FindWebhooksByColonyID(colonyID string) ([]*core.Webhook, error) {
+	return nil, nil
+}
+
+func (db *dbMock) DeleteWebhookByID(webhookID string) error {
+	return nil
+}
+
+func (db *dbMock) DeleteAllWebhooksByColonyID(colonyID string) error {
+	return nil
+}
+
+func (db *dbMock) AddWebhookDelivery(delivery *core.WebhookDelivery) error {
+	return nil
+}
+
+func (db *dbMock) FindWebhookDeliveries(webhookID string, count int) ([]*core.WebhookDelivery, error) {
+	return nil, nil
+}
+
+func (db *dbMock) AddColonyEvent(event *core.ColonyEvent) error {
+	return nil
+}
+
+func (db *dbMock) FindColonyEventsSince(colonyID string, since int64, count int) ([]*core.ColonyEvent, error) {
+	return nil, nil
+}
+
+func (db *dbMock) GetColonyEventSequence() (int64, error) {
+	return 0, nil
+}
+
+func (db *dbMock) DeleteAllColonyEventsByColonyID(colonyID string) error {
+	return nil
+}
+
+func (db *dbMock) PruneColonyEvents(window int64) error {
+	return nil
+}
+
+func (db *dbMock) AddSecret(secret *core.Secret) error {
+	return nil
+}
+
+func (db *dbMock) GetSecretByName(colonyID string, name string) (*core.Secret, error) {
+	return nil, nil
+}
+
+func (db *dbMock) FindSecretsByColonyID(colonyID string) ([]*core.Secret, error) {
+	return nil, nil
+}
+
+func (db *dbMock) DeleteSecretByName(colonyID string, name string) error {
+	return nil
+}
+
+func (db *dbMock) DeleteAllSecretsByColonyID(colonyID string) error {
+	return nil
+}
+
+func (db *dbMock) SetIdentityKey(identityKey *core.IdentityKey) error {
+	return nil
+}
+
+func (db *dbMock) GetIdentityKeyByKeyID(keyID string) (*core.IdentityKey, error) {
+	return nil, nil
+}
+
+func (db *dbMock) GetIdentityKeyByIdentityID(identityID string) (*core.IdentityKey, error) {
+	return nil, nil
+}
+
+func (db *dbMock) GetIdentityIDByKeyID(keyID string) (string, bool, error) {
+	return "", false, nil
+}
+
+func (db *dbMock) DeleteIdentityKeyByIdentityID(identityID string) error {
+	return nil
+}
+
+func (db *dbMock) DeleteAllIdentityKeysByColonyID(colonyID string) error {
+	return nil
+}
+
+func (db *dbMock) AddColonyAdmin(admin *core.ColonyAdmin) error {
+	return nil
+}
+
+func (db *dbMock) GetColonyAdmin(colonyID string, adminID string) (*core.ColonyAdmin, error) {
+	return nil, nil
+}
+
+func (db *dbMock) FindColonyAdminsByColonyID(colonyID string) ([]*core.ColonyAdmin, error) {
+	return nil, nil
+}
+
+func (db *dbMock) DeleteColonyAdmin(colonyID string, adminID string) error {
+	return nil
+}
+
+func (db *dbMock) DeleteAllColonyAdminsByColonyID(colonyID string) error {
+	return nil
+}
+
+func (db *dbMock) FindAuditLog(colonyID string, count int) ([]*core.AuditRecord, error) {
+	return nil, nil
+}
+
+func (db *dbMock) AddRunRecord(runRecord *core.RunRecord) error {
+	return nil
+}
+
+func (db *dbMock) FindRunHistory(triggerID string, count int) ([]*core.RunRecord, error) {
+	return nil, nil
+}
+
+func (db *dbMock) SetRunRecordStateByProcessGraphID(processGraphID string, state int) error {
+	return nil
+}
+
+func (db *dbMock) DeleteRunHistoryByTriggerID(triggerID string) error {
+	return nil
+}
+
+func (db *dbMock) AddWorkflowTemplate(template *core.WorkflowTemplate) error {
+	return nil
+}
+
+func (db *dbMock) GetWorkflowTemplateByID(templateID string) (*core.WorkflowTemplate, error) {
+	return nil, nil
+}
+
+func (db *dbMock) GetWorkflowTemplate(colonyID string, name string, version int) (*core.WorkflowTemplate, error) {
+	return nil, nil
+}
+
+func (db *dbMock) FindWorkflowTemplatesByColonyID(colonyID string) ([]*core.WorkflowTemplate, error) {
+	return nil, nil
+}
+
+func (db *dbMock) DeleteWorkflowTemplate(colonyID string, name string, version int) error {
+	return nil
+}
+
+func (db *dbMock) DeleteAllWorkflowTemplatesByColonyID(colonyID string) error {
+	return nil
+}
+
 func (db *dbMock) Lock(timeout int) error {
 
 	return nil
@@ -43,6 +43,11 @@ func (server *ColoniesServer) handleSubmitHTTPRequest(c *gin.Context, recoveredI
 		return
 	}
 
+	err = server.verifySecretRefs(msg.FunctionSpec)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
 	process := core.CreateProcess(msg.FunctionSpec)
 	addedProcess, err := server.controller.addProcess(process)
 	if server.handleHTTPError(c, err, http.StatusBadRequest) {
@@ -142,7 +147,18 @@ func (server *ColoniesServer) handleAssignProcessHTTPRequest(c *gin.Context, rec
 		return
 	}
 
-	jsonString, err = process.ToJSON()
+	// Secret values are only resolved in the process sent to the assigned executor, stored processes keep the references
+	resolvedProcess, err := server.resolveSecrets(process, executor.Type)
+	if err != nil {
+		closeErr := server.controller.closeFailed(process.ID, []string{err.Error()})
+		if closeErr != nil {
+			log.WithFields(log.Fields{"ProcessId": process.ID, "Error": closeErr}).Error("Failed to close process with unresolvable secrets")
+		}
+		server.handleHTTPError(c, err, http.StatusBadRequest)
+		return
+	}
+
+	jsonString, err = resolvedProcess.ToJSON()
 	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
 		return
 	}
@@ -165,19 +181,16 @@ func (server *ColoniesServer) handleGetProcessHistHTTPRequest(c *gin.Context, re
 		return
 	}
 
-	err = server.validator.RequireExecutorMembership(recoveredID, msg.ColonyID, true)
-	if err != nil {
-		err = server.validator.RequireColonyOwner(recoveredID, msg.ColonyID)
-		if server.handleHTTPError(c, err, http.StatusForbidden) {
-			return
-		}
+	err = server.validator.RequireExecutorMembershipOrColonyOwner(recoveredID, msg.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
 	}
 
 	processes, err := server.controller.findProcessHistory(msg.ColonyID, msg.ExecutorID, msg.Seconds, msg.State)
 	if server.handleHTTPError(c, err, http.StatusBadRequest) {
 		return
 	}
-	jsonString, err = core.ConvertProcessArrayToJSON(processes)
+	jsonString, err = core.ConvertProcessArrayToJSON(server.redactEnv(recoveredID, msg.ColonyID, processes))
 	if server.handleHTTPError(c, err, http.StatusBadRequest) {
 		return
 	}
@@ -205,12 +218,9 @@ func (server *ColoniesServer) handleGetProcessesHTTPRequest(c *gin.Context, reco
 		return
 	}
 
-	err = server.validator.RequireExecutorMembership(recoveredID, msg.ColonyID, true)
-	if err != nil {
-		err = server.validator.RequireColonyOwner(recoveredID, msg.ColonyID)
-		if server.handleHTTPError(c, err, http.StatusForbidden) {
-			return
-		}
+	err = server.validator.RequireExecutorMembershipOrColonyOwner(recoveredID, msg.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
 	}
 
 	log.WithFields(log.Fields{"ColonyId": msg.ColonyID, "Count": msg.Count}).Debug("Getting processes")
@@ -221,7 +231,7 @@ func (server *ColoniesServer) handleGetProcessesHTTPRequest(c *gin.Context, reco
 		if server.handleHTTPError(c, err, http.StatusBadRequest) {
 			return
 		}
-		jsonString, err := core.ConvertProcessArrayToJSON(processes)
+		jsonString, err := core.ConvertProcessArrayToJSON(server.redactEnv(recoveredID, msg.ColonyID, processes))
 		if server.handleHTTPError(c, err, http.StatusBadRequest) {
 			return
 		}
@@ -231,7 +241,7 @@ func (server *ColoniesServer) handleGetProcessesHTTPRequest(c *gin.Context, reco
 		if server.handleHTTPError(c, err, http.StatusBadRequest) {
 			return
 		}
-		jsonString, err := core.ConvertProcessArrayToJSON(processes)
+		jsonString, err := core.ConvertProcessArrayToJSON(server.redactEnv(recoveredID, msg.ColonyID, processes))
 		if server.handleHTTPError(c, err, http.StatusBadRequest) {
 			return
 		}
@@ -241,7 +251,7 @@ func (server *ColoniesServer) handleGetProcessesHTTPRequest(c *gin.Context, reco
 		if server.handleHTTPError(c, err, http.StatusBadRequest) {
 			return
 		}
-		jsonString, err := core.ConvertProcessArrayToJSON(processes)
+		jsonString, err := core.ConvertProcessArrayToJSON(server.redactEnv(recoveredID, msg.ColonyID, processes))
 		if server.handleHTTPError(c, err, http.StatusBadRequest) {
 			return
 		}
@@ -251,7 +261,7 @@ func (server *ColoniesServer) handleGetProcessesHTTPRequest(c *gin.Context, reco
 		if server.handleHTTPError(c, err, http.StatusBadRequest) {
 			return
 		}
-		jsonString, err := core.ConvertProcessArrayToJSON(processes)
+		jsonString, err := core.ConvertProcessArrayToJSON(server.redactEnv(recoveredID, msg.ColonyID, processes))
 		if server.handleHTTPError(c, err, http.StatusBadRequest) {
 			return
 		}
@@ -281,7 +291,7 @@ func (server *ColoniesServer) handleGetProcessHTTPRequest(c *gin.Context, recove
 		return
 	}
 	if process == nil {
-		server.handleHTTPError(c, errors.New("Failed to get process, process is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to get process, process is nil"), http.StatusNotFound)
 		return
 	}
 
@@ -290,6 +300,7 @@ func (server *ColoniesServer) handleGetProcessHTTPRequest(c *gin.Context, recove
 		return
 	}
 
+	process = server.redactEnv(recoveredID, process.FunctionSpec.Conditions.ColonyID, []*core.Process{process})[0]
 	jsonString, err = process.ToJSON()
 	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
 		return
@@ -300,6 +311,48 @@ func (server *ColoniesServer) handleGetProcessHTTPRequest(c *gin.Context, recove
 	server.sendHTTPReply(c, payloadType, jsonString)
 }
 
+func (server *ColoniesServer) handleGetProcessEventsHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateGetProcessEventsMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to get process events, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to get process events, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	process, err := server.controller.getProcess(msg.ProcessID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if process == nil {
+		server.handleHTTPError(c, errors.New("Failed to get process events, process is nil"), http.StatusNotFound)
+		return
+	}
+
+	err = server.validator.RequireExecutorMembership(recoveredID, process.FunctionSpec.Conditions.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	events, err := server.controller.getProcessEvents(process.ID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	jsonString, err = core.ConvertProcessEventArrayToJSON(events)
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ProcessId": process.ID}).Debug("Getting process events")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
 func (server *ColoniesServer) handleDeleteProcessHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
 	msg, err := rpc.CreateDeleteProcessMsgFromJSON(jsonString)
 	if err != nil {
@@ -318,11 +371,12 @@ func (server *ColoniesServer) handleDeleteProcessHTTPRequest(c *gin.Context, rec
 		return
 	}
 	if process == nil {
-		server.handleHTTPError(c, errors.New("Failed to delete process, process is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to delete process, process is nil"), http.StatusNotFound)
 		return
 	}
 
-	err = server.validator.RequireExecutorMembership(recoveredID, process.FunctionSpec.Conditions.ColonyID, true)
+	setAuditColony(c, process.FunctionSpec.Conditions.ColonyID)
+	err = server.validator.RequireExecutorMembershipOrColonyOwner(recoveredID, process.FunctionSpec.Conditions.ColonyID, true)
 	if server.handleHTTPError(c, err, http.StatusForbidden) {
 		return
 	}
@@ -411,7 +465,7 @@ func (server *ColoniesServer) handleCloseSuccessfulHTTPRequest(c *gin.Context, r
 		return
 	}
 
-	err = server.controller.closeSuccessful(process.ID, recoveredID, msg.Output)
+	err = server.controller.closeSuccessful(process.ID, recoveredID, msg.Output, msg.NamedOutput)
 	if server.handleHTTPError(c, err, http.StatusBadRequest) {
 		log.WithFields(log.Fields{"Error": err}).Debug("Failed to close process as successful")
 		server.handleHTTPError(c, err, http.StatusInternalServerError)
@@ -266,7 +266,7 @@ func TestGetProcessSecurity(t *testing.T) {
 	<-done
 }
 
-func TestDeleteProcessSecurity(t *testing.T) {
+func TestGetProcessEventsSecurity(t *testing.T) {
 	env, client, server, _, done := setupTestEnv1(t)
 
 	// The setup looks like this:
@@ -277,10 +277,31 @@ func TestDeleteProcessSecurity(t *testing.T) {
 	addedProcess, err := client.Submit(funcSpec, env.executor1PrvKey)
 	assert.Nil(t, err)
 
-	err = client.DeleteProcess(addedProcess.ID, env.executor2PrvKey)
+	_, err = client.GetProcessEvents(addedProcess.ID, env.executor2PrvKey)
 	assert.NotNil(t, err) // Should not work
 
-	err = client.DeleteProcess(addedProcess.ID, env.colony1PrvKey)
+	_, err = client.GetProcessEvents(addedProcess.ID, env.colony1PrvKey)
+	assert.NotNil(t, err) // Should not work
+
+	_, err = client.GetProcessEvents(addedProcess.ID, env.executor1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
+
+func TestDeleteProcessSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	funcSpec := utils.CreateTestFunctionSpec(env.colony1ID)
+	addedProcess, err := client.Submit(funcSpec, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	err = client.DeleteProcess(addedProcess.ID, env.executor2PrvKey)
 	assert.NotNil(t, err) // Should not work
 
 	err = client.DeleteProcess(addedProcess.ID, env.colony2PrvKey)
@@ -289,6 +310,12 @@ func TestDeleteProcessSecurity(t *testing.T) {
 	err = client.DeleteProcess(addedProcess.ID, env.executor1PrvKey)
 	assert.Nil(t, err) // Should work
 
+	addedProcess, err = client.Submit(funcSpec, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	err = client.DeleteProcess(addedProcess.ID, env.colony1PrvKey)
+	assert.Nil(t, err) // Should work, colony owner
+
 	server.Shutdown()
 	<-done
 }
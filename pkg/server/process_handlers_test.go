@@ -28,7 +28,7 @@ func TestSubmitProcess(t *testing.T) {
 	processes = append(processes, addedProcess1)
 	processes = append(processes, addedProcess2)
 
-	processesFromServer, err := client.GetWaitingProcesses(env.colonyID, "", 100, env.executorPrvKey)
+	processesFromServer, err := client.GetWaitingProcesses(env.colonyID, "", 100, env.colonyPrvKey)
 	assert.Nil(t, err)
 	assert.True(t, core.IsProcessArraysEqual(processes, processesFromServer))
 
@@ -36,6 +36,44 @@ func TestSubmitProcess(t *testing.T) {
 	<-done
 }
 
+func TestGetProcessRedactsEnv(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	funcSpec := utils.CreateTestFunctionSpecWithEnv(env.colonyID, map[string]string{"DB_USER": "postgres"})
+	addedProcess, err := client.Submit(funcSpec, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	// Env values are only returned to the colony owner and the executor the process is assigned to
+	process, err := client.GetProcess(addedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, process.FunctionSpec.Env["DB_USER"], core.REDACTED_ENV_VALUE)
+	for _, attribute := range process.Attributes {
+		if attribute.AttributeType == core.ENV {
+			assert.Equal(t, attribute.Value, core.REDACTED_ENV_VALUE)
+		}
+	}
+
+	processes, err := client.GetWaitingProcesses(env.colonyID, "", 100, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, processes, 1)
+	assert.Equal(t, processes[0].FunctionSpec.Env["DB_USER"], core.REDACTED_ENV_VALUE)
+
+	processes, err = client.GetWaitingProcesses(env.colonyID, "", 100, env.colonyPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, processes, 1)
+	assert.Equal(t, processes[0].FunctionSpec.Env["DB_USER"], "postgres")
+
+	_, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	process, err = client.GetProcess(addedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, process.FunctionSpec.Env["DB_USER"], "postgres")
+
+	server.Shutdown()
+	<-done
+}
+
 func TestSubmitProcessInvalidPriority(t *testing.T) {
 	env, client, server, _, done := setupTestEnv2(t)
 
@@ -539,6 +577,42 @@ func TestGetProcess(t *testing.T) {
 	<-done
 }
 
+func TestGetProcessEvents(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	funcSpec := utils.CreateTestFunctionSpec(env.colonyID)
+	funcSpec.MaxExecTime = 1 // 1 second
+	funcSpec.MaxRetries = 3
+	addedProcess, err := client.Submit(funcSpec, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	assignedProcess, err := client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	// The process is unassigned since it did not complete in time
+	waitForProcesses(t, server, []*core.Process{assignedProcess}, core.WAITING)
+
+	assignedProcess, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	err = client.Fail(assignedProcess.ID, []string{"error"}, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	events, err := client.GetProcessEvents(addedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, events, 5)
+
+	eventTypes := []string{core.SUBMITTED_EVENT, core.ASSIGNED_EVENT, core.UNASSIGNED_EVENT, core.ASSIGNED_EVENT, core.FAILED_EVENT}
+	for i, event := range events {
+		assert.Equal(t, event.Type, eventTypes[i])
+		assert.Equal(t, event.ProcessID, addedProcess.ID)
+	}
+	assert.Equal(t, events[1].ExecutorID, env.executorID)
+	assert.Equal(t, events[4].Message, "error")
+
+	server.Shutdown()
+	<-done
+}
+
 func TestDeleteProcess(t *testing.T) {
 	env, client, server, _, done := setupTestEnv2(t)
 
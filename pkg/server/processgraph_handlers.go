@@ -33,7 +33,20 @@ func (server *ColoniesServer) handleSubmitWorkflowHTTPRequest(c *gin.Context, re
 		return
 	}
 
-	processGraph, err := server.controller.submitWorkflowSpec(msg.WorkflowSpec)
+	workflowSpec := msg.WorkflowSpec
+	if workflowSpec.Template != nil {
+		workflowSpec, err = server.controller.instantiateWorkflowTemplate(workflowSpec)
+		if server.handleHTTPError(c, err, http.StatusBadRequest) {
+			return
+		}
+	}
+
+	err = VerifyWorkflowSpec(workflowSpec)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	processGraph, err := server.controller.submitWorkflowSpec(workflowSpec)
 	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
 		return
 	}
@@ -46,6 +59,57 @@ func (server *ColoniesServer) handleSubmitWorkflowHTTPRequest(c *gin.Context, re
 	server.sendHTTPReply(c, payloadType, jsonString)
 }
 
+func (server *ColoniesServer) handleValidateWorkflowHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateValidateWorkflowSpecMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to validate workflow, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to validate workflow, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	if msg.WorkflowSpec == nil {
+		server.handleHTTPError(c, errors.New("Failed to validate workflow, msg.WorkflowSpec is nil"), http.StatusBadRequest)
+		return
+	}
+
+	err = server.validator.RequireExecutorMembership(recoveredID, msg.WorkflowSpec.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	// Nothing is created here, all problems are collected and returned to the caller
+	var problems []string
+	workflowSpec := msg.WorkflowSpec
+	if workflowSpec.Template != nil {
+		workflowSpec, err = server.controller.instantiateWorkflowTemplate(workflowSpec)
+		if err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+
+	if workflowSpec != nil {
+		problems = append(problems, ValidateWorkflowSpec(workflowSpec)...)
+
+		executors, err := server.controller.getExecutorByColonyID(msg.WorkflowSpec.ColonyID)
+		if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+			return
+		}
+		problems = append(problems, findMissingExecutorTypes(workflowSpec, executors)...)
+	}
+
+	jsonString, err = core.CreateWorkflowValidation(problems).ToJSON()
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
 func (server *ColoniesServer) handleGetProcessGraphHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
 	msg, err := rpc.CreateGetProcessGraphMsgFromJSON(jsonString)
 	if err != nil {
@@ -64,7 +128,7 @@ func (server *ColoniesServer) handleGetProcessGraphHTTPRequest(c *gin.Context, r
 		return
 	}
 	if graph == nil {
-		server.handleHTTPError(c, errors.New("Failed to get processgraph, graph is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to get processgraph, graph is nil"), http.StatusNotFound)
 		return
 	}
 
@@ -169,7 +233,7 @@ func (server *ColoniesServer) handleDeleteProcessGraphHTTPRequest(c *gin.Context
 		return
 	}
 	if graph == nil {
-		server.handleHTTPError(c, errors.New("Failed to delete processgraph, graph is nil"), http.StatusInternalServerError)
+		server.handleHTTPError(c, errors.New("Failed to delete processgraph, graph is nil"), http.StatusNotFound)
 		return
 	}
 
@@ -188,6 +252,48 @@ func (server *ColoniesServer) handleDeleteProcessGraphHTTPRequest(c *gin.Context
 	server.sendEmptyHTTPReply(c, payloadType)
 }
 
+func (server *ColoniesServer) handleRetryProcessGraphHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateRetryProcessGraphMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to retry processgraph, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to retry processgraph, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	graph, err := server.controller.getProcessGraphByID(msg.ProcessGraphID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if graph == nil {
+		server.handleHTTPError(c, errors.New("Failed to retry processgraph, graph is nil"), http.StatusNotFound)
+		return
+	}
+
+	err = server.validator.RequireExecutorMembership(recoveredID, graph.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	retriedGraph, err := server.controller.retryProcessGraph(msg.ProcessGraphID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	jsonString, err = retriedGraph.ToJSON()
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ProcessGraphId": graph.ID}).Debug("Retrying processgraph")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
 func (server *ColoniesServer) handleDeleteAllProcessGraphsHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
 	msg, err := rpc.CreateDeleteAllProcessGraphsMsgFromJSON(jsonString)
 	if err != nil {
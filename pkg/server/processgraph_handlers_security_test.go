@@ -97,6 +97,56 @@ func TestDeleteProcessGraphSecurity(t *testing.T) {
 	<-done
 }
 
+func TestValidateWorkflowSpecSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	diamond := generateDiamondtWorkflowSpec(env.colony1ID)
+	_, err := client.ValidateWorkflowSpec(diamond, env.executor2PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.ValidateWorkflowSpec(diamond, env.colony1PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.ValidateWorkflowSpec(diamond, env.colony2PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.ValidateWorkflowSpec(diamond, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestRetryProcessGraphSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	diamond := generateDiamondtWorkflowSpec(env.colony1ID)
+	graph, err := client.SubmitWorkflowSpec(diamond, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	assignedProcess, err := client.Assign(env.colony1ID, -1, env.executor1PrvKey)
+	assert.Nil(t, err)
+	err = client.Fail(assignedProcess.ID, []string{}, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.RetryProcessGraph(graph.ID, env.executor2PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.RetryProcessGraph(graph.ID, env.colony1PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.RetryProcessGraph(graph.ID, env.colony2PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.RetryProcessGraph(graph.ID, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
 func TestDeleteAllProcessGraphsSecurity(t *testing.T) {
 	env, client, server, _, done := setupTestEnv1(t)
 
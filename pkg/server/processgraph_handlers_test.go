@@ -133,6 +133,270 @@ func TestProcessGraphFailed(t *testing.T) {
 	<-done
 }
 
+func TestProcessGraphContinueOnFailure(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	wf := generateDiamondtWorkflowSpec(env.colonyID)
+	wf.FailurePolicy = core.CONTINUE
+	submittedGraph, err := client.SubmitWorkflowSpec(wf, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	assignedProcess, err := client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	err = client.Close(assignedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	// Fail one branch, the other branch is still allowed to run
+	assignedProcess, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	err = client.Fail(assignedProcess.ID, []string{}, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	processGraph, err := client.GetProcessGraph(submittedGraph.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, processGraph.State, core.RUNNING)
+
+	assignedProcess, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	err = client.Close(assignedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	// task4 depends on the failed branch
+	_, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	processGraph, err = client.GetProcessGraph(submittedGraph.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, processGraph.State, core.FAILED)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestProcessGraphAllowFailure(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	wf := generateDiamondtWorkflowSpec(env.colonyID)
+	wf.FunctionSpecs[1].AllowFailure = true // task2
+	submittedGraph, err := client.SubmitWorkflowSpec(wf, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	assignedProcess, err := client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	err = client.Close(assignedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	for i := 0; i < 2; i++ {
+		assignedProcess, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+		assert.Nil(t, err)
+		if assignedProcess.FunctionSpec.NodeName == "task2" {
+			err = client.Fail(assignedProcess.ID, []string{}, env.executorPrvKey)
+		} else {
+			err = client.Close(assignedProcess.ID, env.executorPrvKey)
+		}
+		assert.Nil(t, err)
+	}
+
+	assignedProcess, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "task4")
+	err = client.Close(assignedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	processGraph, err := client.GetProcessGraph(submittedGraph.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, processGraph.State, core.SUCCESS)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestProcessGraphDeadline(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	wf := generateDiamondtWorkflowSpec(env.colonyID)
+	wf.Deadline = 1
+	submittedGraph, err := client.SubmitWorkflowSpec(wf, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	time.Sleep(3 * time.Second)
+
+	processGraph, err := client.GetProcessGraph(submittedGraph.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, processGraph.State, core.FAILED)
+
+	for _, processID := range processGraph.ProcessIDs {
+		process, err := client.GetProcess(processID, env.executorPrvKey)
+		assert.Nil(t, err)
+		assert.Equal(t, process.State, core.FAILED)
+	}
+
+	server.Shutdown()
+	<-done
+}
+
+func TestRetryProcessGraph(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	wf := generateDiamondtWorkflowSpec(env.colonyID)
+	submittedGraph, err := client.SubmitWorkflowSpec(wf, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	// Retrying a workflow that has not failed is not allowed
+	_, err = client.RetryProcessGraph(submittedGraph.ID, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	assignedProcess, err := client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "task1")
+	err = client.CloseWithOutput(assignedProcess.ID, []interface{}{"result1"}, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	assignedProcess, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	failedNodeName := assignedProcess.FunctionSpec.NodeName
+	err = client.Fail(assignedProcess.ID, []string{}, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	processGraph, err := client.GetProcessGraph(submittedGraph.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, processGraph.State, core.FAILED)
+
+	retriedGraph, err := client.RetryProcessGraph(submittedGraph.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, retriedGraph.State, core.RUNNING)
+
+	// task1 is not re-run, task2 and task3 get its output as input
+	var nodeNames []string
+	for i := 0; i < 2; i++ {
+		assignedProcess, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+		assert.Nil(t, err)
+		assert.Equal(t, assignedProcess.Input, []interface{}{"result1"})
+		nodeNames = append(nodeNames, assignedProcess.FunctionSpec.NodeName)
+		err = client.Close(assignedProcess.ID, env.executorPrvKey)
+		assert.Nil(t, err)
+	}
+	assert.Contains(t, nodeNames, failedNodeName)
+	assert.NotContains(t, nodeNames, "task1")
+
+	assignedProcess, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "task4")
+	err = client.Close(assignedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	processGraph, err = client.GetProcessGraph(submittedGraph.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, processGraph.State, core.SUCCESS)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestRetryProcessGraphWithSubWorkflowCancel(t *testing.T) {
+	// task1 runs a sub-workflow consisting of sub_task1, task2 runs in parallel
+	//
+	//   task1 -> sub_task1   task2
+
+	env, client, server, _, done := setupTestEnv2(t)
+
+	subFuncSpec := core.CreateEmptyFunctionSpec()
+	subFuncSpec.NodeName = "sub_task1"
+	subFuncSpec.Conditions.ExecutorType = env.executor.Type
+
+	funcSpec1 := core.CreateEmptyFunctionSpec()
+	funcSpec1.NodeName = "task1"
+	funcSpec1.Workflow = core.CreateWorkflowSpec(env.colonyID)
+	funcSpec1.Workflow.AddFunctionSpec(subFuncSpec)
+	funcSpec2 := core.CreateEmptyFunctionSpec()
+	funcSpec2.NodeName = "task2"
+	funcSpec2.Conditions.ExecutorType = env.executor.Type
+
+	wf := core.CreateWorkflowSpec(env.colonyID)
+	wf.AddFunctionSpec(funcSpec1)
+	wf.AddFunctionSpec(funcSpec2)
+	submittedGraph, err := client.SubmitWorkflowSpec(wf, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	assignProcesses := func() map[string]*core.Process {
+		assignedProcesses := make(map[string]*core.Process)
+		for i := 0; i < 2; i++ {
+			assignedProcess, err := client.Assign(env.colonyID, -1, env.executorPrvKey)
+			assert.Nil(t, err)
+			assignedProcesses[assignedProcess.FunctionSpec.NodeName] = assignedProcess
+		}
+		return assignedProcesses
+	}
+
+	// The sub-workflow fails, which fails task1 and cancels task2
+	assignedProcesses := assignProcesses()
+	err = client.Fail(assignedProcesses["sub_task1"].ID, []string{"error"}, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	processGraph, err := client.GetProcessGraph(submittedGraph.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, processGraph.State, core.FAILED)
+
+	// Retrying task1 starts a second sub-workflow processgraph with the same parent process
+	_, err = client.RetryProcessGraph(submittedGraph.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	// Failing task2 cancels task1 and the running sub-workflow, not only the one that failed before the retry
+	assignedProcesses = assignProcesses()
+	retriedSubProcess := assignedProcesses["sub_task1"]
+	assert.NotNil(t, retriedSubProcess)
+	err = client.Fail(assignedProcesses["task2"].ID, []string{"error"}, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	retriedSubProcess, err = client.GetProcess(retriedSubProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, retriedSubProcess.State, core.FAILED)
+
+	graphs, err := client.GetRunningProcessGraphs(env.colonyID, 100, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, graphs, 0)
+	graphs, err = client.GetFailedProcessGraphs(env.colonyID, 100, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, graphs, 3)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestValidateWorkflowSpec(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	wf := generateDiamondtWorkflowSpec(env.colonyID)
+	validation, err := client.ValidateWorkflowSpec(wf, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.True(t, validation.Valid())
+
+	// task2 -> task4 -> task2 is a cycle, and no executor can run gpu_executor_type
+	wf.FunctionSpecs[1].AddDependency("task4")
+	wf.FunctionSpecs[3].Conditions.ExecutorType = "gpu_executor_type"
+	validation, err = client.ValidateWorkflowSpec(wf, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.False(t, validation.Valid())
+	assert.Len(t, validation.Problems, 2)
+
+	_, err = client.SubmitWorkflowSpec(wf, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	// Nothing should have been created
+	graphs, err := client.GetWaitingProcessGraphs(env.colonyID, 100, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, graphs, 0)
+	processes, err := client.GetWaitingProcesses(env.colonyID, "", 100, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, processes, 0)
+
+	server.Shutdown()
+	<-done
+}
+
 func TestAddChild(t *testing.T) {
 	//         task1
 	//          / \
@@ -455,6 +719,343 @@ func TestSubmitWorkflowSpecWithInputOutput(t *testing.T) {
 	<-done
 }
 
+func TestSubmitWorkflowSpecWithCondition(t *testing.T) {
+	// task2 only runs if the output of task1 is larger than 0.5, otherwise task3 runs, task4 joins the branches
+	//
+	//         task1
+	//          / \
+	//     task2   task3
+	//          \ /
+	//         task4
+
+	env, client, server, _, done := setupTestEnv2(t)
+
+	wf := generateDiamondtWorkflowSpec(env.colonyID)
+	wf.FunctionSpecs[1].Condition = "task1.output[0] > 0.5"
+	wf.FunctionSpecs[2].Condition = "task1.output[0] <= 0.5"
+	submittedGraph, err := client.SubmitWorkflowSpec(wf, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	assignedProcess, err := client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "task1")
+	err = client.CloseWithOutput(assignedProcess.ID, []interface{}{0.2}, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	assignedProcess, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "task3")
+	err = client.Close(assignedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	assignedProcess, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "task4")
+	err = client.Close(assignedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	graph, err := client.GetProcessGraph(submittedGraph.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, graph.State, core.SUCCESS)
+
+	wf.FunctionSpecs[1].Condition = "task1.output[0] >"
+	_, err = client.SubmitWorkflowSpec(wf, env.executorPrvKey)
+	assert.NotNil(t, err) // Invalid condition
+
+	server.Shutdown()
+	<-done
+}
+
+func TestSubmitWorkflowSpecWithMap(t *testing.T) {
+	// task2 is a map node, one instance is created for each element in the output of task1, task3 joins them
+	//
+	//   task1
+	//     |
+	//   task2 (x3)
+	//     |
+	//   task3
+
+	env, client, server, _, done := setupTestEnv2(t)
+
+	funcSpec1 := core.CreateEmptyFunctionSpec()
+	funcSpec1.NodeName = "task1"
+	funcSpec1.Conditions.ExecutorType = env.executor.Type
+	funcSpec2 := core.CreateEmptyFunctionSpec()
+	funcSpec2.NodeName = "task2"
+	funcSpec2.Conditions.ExecutorType = env.executor.Type
+	funcSpec2.Map = true
+	funcSpec2.MaxParallel = 2
+	funcSpec2.AddDependency("task1")
+	funcSpec3 := core.CreateEmptyFunctionSpec()
+	funcSpec3.NodeName = "task3"
+	funcSpec3.Conditions.ExecutorType = env.executor.Type
+	funcSpec3.AddDependency("task2")
+
+	wf := core.CreateWorkflowSpec(env.colonyID)
+	wf.AddFunctionSpec(funcSpec1)
+	wf.AddFunctionSpec(funcSpec2)
+	wf.AddFunctionSpec(funcSpec3)
+	submittedGraph, err := client.SubmitWorkflowSpec(wf, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	assignedProcess, err := client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "task1")
+	err = client.CloseWithOutput(assignedProcess.ID, []interface{}{"a", "b", "c"}, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	inputs := make(map[string]bool)
+	for i := 0; i < 3; i++ {
+		assignedProcess, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+		assert.Nil(t, err)
+		assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "task2")
+		assert.Len(t, assignedProcess.Input, 1)
+		item := assignedProcess.Input[0].(string)
+		inputs[item] = true
+		err = client.CloseWithOutput(assignedProcess.ID, []interface{}{item + item}, env.executorPrvKey)
+		assert.Nil(t, err)
+	}
+	assert.Len(t, inputs, 3)
+
+	assignedProcess, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "task3")
+	assert.Len(t, assignedProcess.Input, 3) // Collected outputs of all instances
+	err = client.Close(assignedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	graph, err := client.GetProcessGraph(submittedGraph.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, graph.State, core.SUCCESS)
+	assert.Len(t, graph.ProcessIDs, 6)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestSubmitWorkflowSpecWithSubWorkflow(t *testing.T) {
+	// task2 runs a sub-workflow consisting of sub_task1 and sub_task2, task3 gets the output of sub_task2
+	//
+	//   task1
+	//     |
+	//   task2 -> sub_task1 -> sub_task2
+	//     |
+	//   task3
+
+	env, client, server, _, done := setupTestEnv2(t)
+
+	subFuncSpec1 := core.CreateEmptyFunctionSpec()
+	subFuncSpec1.NodeName = "sub_task1"
+	subFuncSpec1.Conditions.ExecutorType = env.executor.Type
+	subFuncSpec2 := core.CreateEmptyFunctionSpec()
+	subFuncSpec2.NodeName = "sub_task2"
+	subFuncSpec2.Conditions.ExecutorType = env.executor.Type
+	subFuncSpec2.AddDependency("sub_task1")
+
+	funcSpec1 := core.CreateEmptyFunctionSpec()
+	funcSpec1.NodeName = "task1"
+	funcSpec1.Conditions.ExecutorType = env.executor.Type
+	funcSpec2 := core.CreateEmptyFunctionSpec()
+	funcSpec2.NodeName = "task2"
+	funcSpec2.AddDependency("task1")
+	funcSpec2.Workflow = core.CreateWorkflowSpec(env.colonyID)
+	funcSpec2.Workflow.AddFunctionSpec(subFuncSpec1)
+	funcSpec2.Workflow.AddFunctionSpec(subFuncSpec2)
+	funcSpec3 := core.CreateEmptyFunctionSpec()
+	funcSpec3.NodeName = "task3"
+	funcSpec3.Conditions.ExecutorType = env.executor.Type
+	funcSpec3.AddDependency("task2")
+
+	wf := core.CreateWorkflowSpec(env.colonyID)
+	wf.AddFunctionSpec(funcSpec1)
+	wf.AddFunctionSpec(funcSpec2)
+	wf.AddFunctionSpec(funcSpec3)
+	submittedGraph, err := client.SubmitWorkflowSpec(wf, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	assignedProcess, err := client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "task1")
+	err = client.CloseWithOutput(assignedProcess.ID, []interface{}{"in"}, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	assignedProcess, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "sub_task1")
+	assert.Equal(t, assignedProcess.Input, []interface{}{"in"})
+	subGraph, err := client.GetProcessGraph(assignedProcess.ProcessGraphID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.NotEqual(t, subGraph.ID, submittedGraph.ID)
+	assert.NotEmpty(t, subGraph.ParentProcessID)
+	err = client.Close(assignedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	assignedProcess, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "sub_task2")
+	err = client.CloseWithOutput(assignedProcess.ID, []interface{}{"out"}, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	parentProcess, err := client.GetProcess(subGraph.ParentProcessID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, parentProcess.State, core.SUCCESS)
+	assert.Equal(t, parentProcess.Output, []interface{}{"out"})
+
+	assignedProcess, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "task3")
+	assert.Equal(t, assignedProcess.Input, []interface{}{"out"})
+	err = client.Close(assignedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	graph, err := client.GetProcessGraph(submittedGraph.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, graph.State, core.SUCCESS)
+	subGraph, err = client.GetProcessGraph(subGraph.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, subGraph.State, core.SUCCESS)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestSubmitWorkflowSpecWithInputBindings(t *testing.T) {
+	// evaluate gets the model produced by train as a named input, the output of both parents is still
+	// concatenated into the input of evaluate
+	//
+	//   fetch   train
+	//      \    /
+	//     evaluate
+
+	env, client, server, _, done := setupTestEnv2(t)
+
+	funcSpec1 := core.CreateEmptyFunctionSpec()
+	funcSpec1.NodeName = "fetch"
+	funcSpec1.Conditions.ExecutorType = env.executor.Type
+	funcSpec2 := core.CreateEmptyFunctionSpec()
+	funcSpec2.NodeName = "train"
+	funcSpec2.Conditions.ExecutorType = env.executor.Type
+	funcSpec3 := core.CreateEmptyFunctionSpec()
+	funcSpec3.NodeName = "evaluate"
+	funcSpec3.Conditions.ExecutorType = env.executor.Type
+	funcSpec3.AddDependency("fetch")
+	funcSpec3.AddDependency("train")
+	funcSpec3.Inputs = map[string]string{"model": "train.out.model", "data": "fetch.out"}
+
+	wf := core.CreateWorkflowSpec(env.colonyID)
+	wf.AddFunctionSpec(funcSpec1)
+	wf.AddFunctionSpec(funcSpec2)
+	wf.AddFunctionSpec(funcSpec3)
+	_, err := client.SubmitWorkflowSpec(wf, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	for i := 0; i < 2; i++ {
+		assignedProcess, err := client.Assign(env.colonyID, -1, env.executorPrvKey)
+		assert.Nil(t, err)
+		switch assignedProcess.FunctionSpec.NodeName {
+		case "fetch":
+			err = client.CloseWithOutput(assignedProcess.ID, []interface{}{"data"}, env.executorPrvKey)
+		case "train":
+			err = client.CloseWithNamedOutput(assignedProcess.ID, []interface{}{"log"}, map[string]interface{}{"model": "model.bin"}, env.executorPrvKey)
+		}
+		assert.Nil(t, err)
+	}
+
+	assignedProcess, err := client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "evaluate")
+	assert.Len(t, assignedProcess.Input, 2)
+	assert.Equal(t, assignedProcess.NamedInput["model"], "model.bin")
+	assert.Equal(t, assignedProcess.NamedInput["data"], []interface{}{"data"})
+
+	server.Shutdown()
+	<-done
+}
+
+func TestSubmitWorkflowSpecWithMapAndInputs(t *testing.T) {
+	// task2 is a map node over the output of task1, its instances bind the named output of task1
+	//
+	//   task1
+	//     |
+	//   task2 (x2)
+
+	env, client, server, _, done := setupTestEnv2(t)
+
+	funcSpec1 := core.CreateEmptyFunctionSpec()
+	funcSpec1.NodeName = "task1"
+	funcSpec1.Conditions.ExecutorType = env.executor.Type
+	funcSpec2 := core.CreateEmptyFunctionSpec()
+	funcSpec2.NodeName = "task2"
+	funcSpec2.Conditions.ExecutorType = env.executor.Type
+	funcSpec2.Map = true
+	funcSpec2.AddDependency("task1")
+	funcSpec2.Inputs = map[string]string{"model": "task1.out.model"}
+
+	wf := core.CreateWorkflowSpec(env.colonyID)
+	wf.AddFunctionSpec(funcSpec1)
+	wf.AddFunctionSpec(funcSpec2)
+	submittedGraph, err := client.SubmitWorkflowSpec(wf, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	assignedProcess, err := client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "task1")
+	err = client.CloseWithNamedOutput(assignedProcess.ID, []interface{}{"a", "b"}, map[string]interface{}{"model": "model.bin"}, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	for i := 0; i < 2; i++ {
+		assignedProcess, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+		assert.Nil(t, err)
+		assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "task2")
+		assert.Len(t, assignedProcess.Input, 1)
+		assert.Equal(t, assignedProcess.NamedInput["model"], "model.bin")
+		err = client.Close(assignedProcess.ID, env.executorPrvKey)
+		assert.Nil(t, err)
+	}
+
+	graph, err := client.GetProcessGraph(submittedGraph.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, graph.State, core.SUCCESS)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestSubmitWorkflowSpecWithMissingNamedOutput(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	funcSpec1 := core.CreateEmptyFunctionSpec()
+	funcSpec1.NodeName = "train"
+	funcSpec1.Conditions.ExecutorType = env.executor.Type
+	funcSpec2 := core.CreateEmptyFunctionSpec()
+	funcSpec2.NodeName = "evaluate"
+	funcSpec2.Conditions.ExecutorType = env.executor.Type
+	funcSpec2.AddDependency("train")
+	funcSpec2.Inputs = map[string]string{"model": "train.out.model"}
+
+	wf := core.CreateWorkflowSpec(env.colonyID)
+	wf.AddFunctionSpec(funcSpec1)
+	wf.AddFunctionSpec(funcSpec2)
+	graph, err := client.SubmitWorkflowSpec(wf, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	assignedProcess, err := client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	err = client.Close(assignedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	// The input of evaluate cannot be resolved since train has no output named model
+	_, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	graph, err = client.GetProcessGraph(graph.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, graph.State, core.FAILED)
+
+	server.Shutdown()
+	<-done
+}
+
 func TestSubmitWorkflowSpecFailed(t *testing.T) {
 	env, client, server, _, done := setupTestEnv2(t)
 
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+func (server *ColoniesServer) handleGetRunHistoryHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateGetRunHistoryMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to get run history, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to get run history, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	// The trigger is either a cron or a generator, look up its colony to verify membership
+	colonyID := ""
+	cron, err := server.controller.getCron(msg.TriggerID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if cron != nil {
+		colonyID = cron.ColonyID
+	} else {
+		generator, err := server.controller.getGenerator(msg.TriggerID)
+		if server.handleHTTPError(c, err, http.StatusBadRequest) {
+			return
+		}
+		if generator == nil {
+			server.handleHTTPError(c, errors.New("Failed to get run history, no cron or generator with Id <"+msg.TriggerID+"> found"), http.StatusBadRequest)
+			return
+		}
+		colonyID = generator.ColonyID
+	}
+
+	err = server.validator.RequireExecutorMembership(recoveredID, colonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	runHistory, err := server.controller.getRunHistory(msg.TriggerID, msg.Count)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	jsonString, err = core.ConvertRunRecordArrayToJSON(runHistory)
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"TriggerId": msg.TriggerID, "Count": msg.Count}).Debug("Getting run history")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
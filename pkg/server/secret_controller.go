@@ -0,0 +1,84 @@
+package server
+
+import (
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+// addSecret stores a secret, the value must already be encrypted
+func (controller *coloniesController) addSecret(secret *core.Secret) (*core.Secret, error) {
+	cmd := &command{threaded: true, secretReplyChan: make(chan *core.Secret, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			err := controller.db.AddSecret(secret)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			addedSecret, err := controller.db.GetSecretByName(secret.ColonyID, secret.Name)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.secretReplyChan <- addedSecret
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case addedSecret := <-cmd.secretReplyChan:
+		return addedSecret, nil
+	}
+}
+
+func (controller *coloniesController) getSecret(colonyID string, name string) (*core.Secret, error) {
+	cmd := &command{threaded: true, secretReplyChan: make(chan *core.Secret, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			secret, err := controller.db.GetSecretByName(colonyID, name)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.secretReplyChan <- secret
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case secret := <-cmd.secretReplyChan:
+		return secret, nil
+	}
+}
+
+func (controller *coloniesController) getSecrets(colonyID string) ([]*core.Secret, error) {
+	cmd := &command{threaded: true, secretsReplyChan: make(chan []*core.Secret, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			secrets, err := controller.db.FindSecretsByColonyID(colonyID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.secretsReplyChan <- secrets
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case secrets := <-cmd.secretsReplyChan:
+		return secrets, nil
+	}
+}
+
+func (controller *coloniesController) deleteSecret(colonyID string, name string) error {
+	cmd := &command{threaded: true, errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			cmd.errorChan <- controller.db.DeleteSecretByName(colonyID, name)
+		}}
+
+	controller.cmdQueue <- cmd
+	return <-cmd.errorChan
+}
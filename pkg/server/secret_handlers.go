@@ -0,0 +1,294 @@
+package server
+
+import (
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
+	"github.com/colonyos/colonies/pkg/security"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+func (server *ColoniesServer) requireSecretKey() error {
+	if server.secretKey == nil {
+		return errors.New("Secrets are disabled, the server has not been configured with a secret key")
+	}
+
+	return nil
+}
+
+func (server *ColoniesServer) encryptSecretValue(value string) (string, error) {
+	err := server.requireSecretKey()
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext, err := security.Encrypt(server.secretKey, []byte(value))
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(ciphertext), nil
+}
+
+func (server *ColoniesServer) decryptSecretValue(value string) (string, error) {
+	err := server.requireSecretKey()
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext, err := hex.DecodeString(value)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := security.Decrypt(server.secretKey, ciphertext)
+	if err != nil {
+		return "", err
+	}
+
+	return string(plaintext), nil
+}
+
+// verifySecretRefs checks that all secrets referenced by a function spec exists and have been granted to the executor
+// type of the function spec, so that a process that can never be executed is rejected when it is submitted
+func (server *ColoniesServer) verifySecretRefs(funcSpec *core.FunctionSpec) error {
+	for _, name := range core.SecretRefs(funcSpec.Env) {
+		err := server.requireSecretKey()
+		if err != nil {
+			return err
+		}
+
+		secret, err := server.controller.getSecret(funcSpec.Conditions.ColonyID, name)
+		if err != nil {
+			return err
+		}
+		if secret == nil {
+			return errors.New("Secret <" + name + "> referenced by env does not exist")
+		}
+		if !secret.IsGrantedTo(funcSpec.Conditions.ExecutorType) {
+			return errors.New("Secret <" + name + "> referenced by env has not been granted to executor type <" + funcSpec.Conditions.ExecutorType + ">")
+		}
+	}
+
+	return nil
+}
+
+func (server *ColoniesServer) resolveSecretRef(colonyID string, executorType string, value string, resolved map[string]string) (string, error) {
+	name, ok := core.ParseSecretRef(value)
+	if !ok {
+		return value, nil
+	}
+
+	if plaintext, ok := resolved[name]; ok {
+		return plaintext, nil
+	}
+
+	secret, err := server.controller.getSecret(colonyID, name)
+	if err != nil {
+		return "", err
+	}
+	if secret == nil {
+		return "", errors.New("Failed to resolve secret <" + name + ">, secret does not exist")
+	}
+	if !secret.IsGrantedTo(executorType) {
+		return "", errors.New("Failed to resolve secret <" + name + ">, secret has not been granted to executor type <" + executorType + ">")
+	}
+
+	plaintext, err := server.decryptSecretValue(secret.Value)
+	if err != nil {
+		return "", errors.New("Failed to resolve secret <" + name + ">, " + err.Error())
+	}
+	resolved[name] = plaintext
+
+	return plaintext, nil
+}
+
+// resolveSecrets returns a copy of the process where secret references in the env have been replaced by the secret
+// values. It is only called when a process is assigned, the values are never stored or sent to anyone else than the
+// executor the process is assigned to, and only if the secrets have been granted to the type of that executor.
+func (server *ColoniesServer) resolveSecrets(process *core.Process, executorType string) (*core.Process, error) {
+	if len(core.SecretRefs(process.FunctionSpec.Env)) == 0 {
+		return process, nil
+	}
+
+	colonyID := process.FunctionSpec.Conditions.ColonyID
+	resolved := make(map[string]string)
+
+	processCopy := *process
+	resolvedProcess := &processCopy
+	resolvedProcess.FunctionSpec.Env = make(core.EnvVars, len(process.FunctionSpec.Env))
+	for key, value := range process.FunctionSpec.Env {
+		plaintext, err := server.resolveSecretRef(colonyID, executorType, value, resolved)
+		if err != nil {
+			return nil, err
+		}
+		resolvedProcess.FunctionSpec.Env[key] = plaintext
+	}
+
+	resolvedProcess.Attributes = make([]core.Attribute, len(process.Attributes))
+	for i, attribute := range process.Attributes {
+		if attribute.AttributeType == core.ENV {
+			plaintext, err := server.resolveSecretRef(colonyID, executorType, attribute.Value, resolved)
+			if err != nil {
+				return nil, err
+			}
+			attribute.Value = plaintext
+		}
+		resolvedProcess.Attributes[i] = attribute
+	}
+
+	return resolvedProcess, nil
+}
+
+// redactEnv returns the processes with env values redacted, unless the caller is the colony owner or an admin of the
+// colony. Env values are kept in processes assigned to the caller since the caller already knows them.
+func (server *ColoniesServer) redactEnv(recoveredID string, colonyID string, processes []*core.Process) []*core.Process {
+	if server.validator.RequireColonyOwner(recoveredID, colonyID) == nil {
+		return processes
+	}
+
+	redactedProcesses := make([]*core.Process, len(processes))
+	for i, process := range processes {
+		if process.AssignedExecutorID == recoveredID {
+			redactedProcesses[i] = process
+		} else {
+			redactedProcesses[i] = core.RedactEnv(process)
+		}
+	}
+
+	return redactedProcesses
+}
+
+func (server *ColoniesServer) handleAddSecretHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateAddSecretMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to add secret, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to add secret, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+	if msg.Secret == nil {
+		server.handleHTTPError(c, errors.New("Failed to add secret, msg.Secret is nil"), http.StatusBadRequest)
+		return
+	}
+
+	err = server.validator.RequireColonyOwner(recoveredID, msg.Secret.ColonyID)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	err = VerifySecret(msg.Secret)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	encryptedValue, err := server.encryptSecretValue(msg.Secret.Value)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	secret := core.CreateSecret(msg.Secret.ColonyID, msg.Secret.Name, encryptedValue, msg.Secret.ExecutorTypes)
+	secret.Added = time.Now()
+	addedSecret, err := server.controller.addSecret(secret)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if addedSecret == nil {
+		server.handleHTTPError(c, errors.New("Failed to add secret, addedSecret is nil"), http.StatusInternalServerError)
+		return
+	}
+
+	addedSecret.Value = ""
+	jsonString, err = addedSecret.ToJSON()
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ColonyId": addedSecret.ColonyID, "Name": addedSecret.Name}).Debug("Adding secret")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
+func (server *ColoniesServer) handleGetSecretsHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateGetSecretsMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to get secrets, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to get secrets, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	err = server.validator.RequireColonyOwner(recoveredID, msg.ColonyID)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	secrets, err := server.controller.getSecrets(msg.ColonyID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	// Secret values are never returned
+	for _, secret := range secrets {
+		secret.Value = ""
+	}
+
+	jsonString, err = core.ConvertSecretArrayToJSON(secrets)
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ColonyId": msg.ColonyID}).Debug("Getting secrets")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
+func (server *ColoniesServer) handleDeleteSecretHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateDeleteSecretMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to delete secret, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to delete secret, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	err = server.validator.RequireColonyOwner(recoveredID, msg.ColonyID)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	secret, err := server.controller.getSecret(msg.ColonyID, msg.Name)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if secret == nil {
+		server.handleHTTPError(c, core.CreateError(core.ERROR_NOT_FOUND, "Failed to delete secret, secret <"+msg.Name+"> not found"), http.StatusNotFound)
+		return
+	}
+
+	err = server.controller.deleteSecret(msg.ColonyID, msg.Name)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ColonyId": msg.ColonyID, "Name": msg.Name}).Debug("Deleting secret")
+
+	server.sendEmptyHTTPReply(c, payloadType)
+}
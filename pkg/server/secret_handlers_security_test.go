@@ -0,0 +1,123 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddSecretSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	secret := core.CreateSecret(env.colony1ID, "db-password", "password", []string{"test_executor_type"})
+	_, err := client.AddSecret(secret, env.executor1PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.AddSecret(secret, env.colony2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.AddSecret(secret, env.colony1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
+
+func TestGetSecretsSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	secret := core.CreateSecret(env.colony1ID, "db-password", "password", []string{"test_executor_type"})
+	_, err := client.AddSecret(secret, env.colony1PrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.GetSecrets(env.colony1ID, env.executor1PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.GetSecrets(env.colony1ID, env.colony2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.GetSecrets(env.colony1ID, env.colony1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
+
+func TestDeleteSecretSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	secret := core.CreateSecret(env.colony1ID, "db-password", "password", []string{"test_executor_type"})
+	_, err := client.AddSecret(secret, env.colony1PrvKey)
+	assert.Nil(t, err)
+
+	err = client.DeleteSecret(env.colony1ID, "db-password", env.executor1PrvKey)
+	assert.NotNil(t, err) // Should not work
+	err = client.DeleteSecret(env.colony1ID, "db-password", env.colony2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	err = client.DeleteSecret(env.colony1ID, "db-password", env.colony1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
+
+func TestSubmitWithSecretRefSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	// A secret in colony2 cannot be referenced by a process in colony1
+	secret := core.CreateSecret(env.colony2ID, "db-password", "password", []string{"test_executor_type"})
+	_, err := client.AddSecret(secret, env.colony2PrvKey)
+	assert.Nil(t, err)
+
+	funcSpec := core.CreateEmptyFunctionSpec()
+	funcSpec.Conditions.ColonyID = env.colony1ID
+	funcSpec.Conditions.ExecutorType = "test_executor_type"
+	funcSpec.Env = core.EnvVars{"DB_PASSWORD": core.CreateSecretRef("db-password")}
+	_, err = client.Submit(funcSpec, env.executor1PrvKey)
+	assert.NotNil(t, err) // Should not work
+
+	server.Shutdown()
+	<-done
+}
+
+func TestSecretExecutorTypeSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	secret := core.CreateSecret(env.colonyID, "db-password", "password", []string{"db_executor_type"})
+	_, err := client.AddSecret(secret, env.colonyPrvKey)
+	assert.Nil(t, err)
+
+	// The secret has not been granted to the executor type of the process
+	funcSpec := utils.CreateTestFunctionSpecWithEnv(env.colonyID, map[string]string{"DB_PASSWORD": core.CreateSecretRef("db-password")})
+	_, err = client.Submit(funcSpec, env.executorPrvKey)
+	assert.NotNil(t, err) // Should not work
+
+	// The secret is no longer granted to the executor type when the process is assigned
+	secret = core.CreateSecret(env.colonyID, "db-password", "password", []string{"test_executor_type"})
+	_, err = client.AddSecret(secret, env.colonyPrvKey)
+	assert.Nil(t, err)
+	_, err = client.Submit(funcSpec, env.executorPrvKey)
+	assert.Nil(t, err) // Should work
+	secret = core.CreateSecret(env.colonyID, "db-password", "password", []string{"db_executor_type"})
+	_, err = client.AddSecret(secret, env.colonyPrvKey)
+	assert.Nil(t, err)
+	_, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.NotNil(t, err) // Should not work
+
+	server.Shutdown()
+	<-done
+}
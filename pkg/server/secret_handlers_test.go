@@ -0,0 +1,137 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddSecret(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	secret := core.CreateSecret(env.colonyID, "db-password", "password1", []string{"test_executor_type"})
+	addedSecret, err := client.AddSecret(secret, env.colonyPrvKey)
+	assert.Nil(t, err)
+	assert.NotNil(t, addedSecret)
+	assert.Equal(t, addedSecret.Name, "db-password")
+	assert.Equal(t, addedSecret.Value, "") // The value should never be returned
+
+	// Adding a secret with the same name replaces the value
+	secret = core.CreateSecret(env.colonyID, "db-password", "password2", []string{"test_executor_type"})
+	_, err = client.AddSecret(secret, env.colonyPrvKey)
+	assert.Nil(t, err)
+
+	secret = core.CreateSecret(env.colonyID, "api-token", "token", []string{"test_executor_type"})
+	_, err = client.AddSecret(secret, env.colonyPrvKey)
+	assert.Nil(t, err)
+
+	secrets, err := client.GetSecrets(env.colonyID, env.colonyPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, secrets, 2)
+	for _, secret := range secrets {
+		assert.Equal(t, secret.Value, "")
+	}
+
+	invalidSecret := core.CreateSecret(env.colonyID, "invalid name", "value", []string{"test_executor_type"})
+	_, err = client.AddSecret(invalidSecret, env.colonyPrvKey)
+	assert.NotNil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestDeleteSecret(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	secret := core.CreateSecret(env.colonyID, "db-password", "password", []string{"test_executor_type"})
+	_, err := client.AddSecret(secret, env.colonyPrvKey)
+	assert.Nil(t, err)
+
+	err = client.DeleteSecret(env.colonyID, "db-password", env.colonyPrvKey)
+	assert.Nil(t, err)
+
+	secrets, err := client.GetSecrets(env.colonyID, env.colonyPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, secrets, 0)
+
+	err = client.DeleteSecret(env.colonyID, "db-password", env.colonyPrvKey)
+	assert.NotNil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestSubmitWithMissingSecretRef(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	funcSpec := utils.CreateTestFunctionSpecWithEnv(env.colonyID, map[string]string{"DB_PASSWORD": core.CreateSecretRef("db-password")})
+	_, err := client.Submit(funcSpec, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestAssignResolvesSecretRefs(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	secret := core.CreateSecret(env.colonyID, "db-password", "password", []string{"test_executor_type"})
+	_, err := client.AddSecret(secret, env.colonyPrvKey)
+	assert.Nil(t, err)
+
+	funcSpec := utils.CreateTestFunctionSpecWithEnv(env.colonyID, map[string]string{"DB_PASSWORD": core.CreateSecretRef("db-password"), "DB_USER": "postgres"})
+	addedProcess, err := client.Submit(funcSpec, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, addedProcess.FunctionSpec.Env["DB_PASSWORD"], core.CreateSecretRef("db-password"))
+
+	assignedProcess, err := client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.FunctionSpec.Env["DB_PASSWORD"], "password")
+	assert.Equal(t, assignedProcess.FunctionSpec.Env["DB_USER"], "postgres")
+	for _, attribute := range assignedProcess.Attributes {
+		if attribute.AttributeType == core.ENV && attribute.Key == "DB_PASSWORD" {
+			assert.Equal(t, attribute.Value, "password")
+		}
+	}
+
+	// The stored process still only contains the reference
+	process, err := client.GetProcess(addedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, process.FunctionSpec.Env["DB_PASSWORD"], core.CreateSecretRef("db-password"))
+	for _, attribute := range process.Attributes {
+		if attribute.AttributeType == core.ENV && attribute.Key == "DB_PASSWORD" {
+			assert.Equal(t, attribute.Value, core.CreateSecretRef("db-password"))
+		}
+	}
+
+	server.Shutdown()
+	<-done
+}
+
+func TestAssignWithDeletedSecret(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	secret := core.CreateSecret(env.colonyID, "db-password", "password", []string{"test_executor_type"})
+	_, err := client.AddSecret(secret, env.colonyPrvKey)
+	assert.Nil(t, err)
+
+	funcSpec := utils.CreateTestFunctionSpecWithEnv(env.colonyID, map[string]string{"DB_PASSWORD": core.CreateSecretRef("db-password")})
+	addedProcess, err := client.Submit(funcSpec, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	err = client.DeleteSecret(env.colonyID, "db-password", env.colonyPrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	// The process cannot be executed and should have been closed as failed
+	process, err := client.GetProcess(addedProcess.ID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, process.State, core.FAILED)
+
+	server.Shutdown()
+	<-done
+}
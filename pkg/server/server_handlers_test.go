@@ -1,9 +1,20 @@
 package server
 
 import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/colonyos/colonies/pkg/client"
+	"github.com/colonyos/colonies/pkg/client/clienttest"
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
 	"github.com/colonyos/colonies/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -91,6 +102,64 @@ func TestGetClusterInfo(t *testing.T) {
 	<-done
 }
 
+func TestErrorCodes(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	_, err := client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.True(t, errors.Is(err, core.ErrNoWorkAvailable))
+	assert.False(t, errors.Is(err, core.ErrNotFound))
+
+	_, err = client.GetProcess(core.GenerateRandomID(), env.executorPrvKey)
+	assert.True(t, errors.Is(err, core.ErrNotFound))
+	var coloniesErr *core.ColoniesError
+	assert.True(t, errors.As(err, &coloniesErr))
+	assert.Equal(t, http.StatusNotFound, coloniesErr.Status)
+
+	_, err = client.GetColonies(env.executorPrvKey)
+	assert.True(t, errors.Is(err, core.ErrUnauthorized))
+
+	function := &core.Function{ExecutorID: env.executorID, ColonyID: env.colonyID, FuncName: "test_func"}
+	_, err = client.AddFunction(function, env.executorPrvKey)
+	assert.Nil(t, err)
+	_, err = client.AddFunction(function, env.executorPrvKey)
+	assert.True(t, errors.Is(err, core.ErrConflict))
+
+	addedProcess, err := client.Submit(utils.CreateTestFunctionSpec(env.colonyID), env.executorPrvKey)
+	assert.Nil(t, err)
+	_, err = client.GetLogs(addedProcess.ID, 0, MAX_COUNT+1, 0, env.executorPrvKey)
+	assert.True(t, errors.Is(err, core.ErrValidation))
+
+	_, err = client.GetCron(core.GenerateRandomID(), env.executorPrvKey)
+	assert.True(t, errors.Is(err, core.ErrNotFound))
+	cron := utils.FakeCron(t, env.colonyID)
+	cron.ID = core.GenerateRandomID()
+	_, err = client.UpdateCron(cron, env.executorPrvKey)
+	assert.True(t, errors.Is(err, core.ErrNotFound))
+	_, err = client.RunCron(core.GenerateRandomID(), env.executorPrvKey)
+	assert.True(t, errors.Is(err, core.ErrNotFound))
+	err = client.DeleteCron(core.GenerateRandomID(), env.executorPrvKey)
+	assert.True(t, errors.Is(err, core.ErrNotFound))
+
+	server.Shutdown()
+	<-done
+}
+
+func TestHandlerTransport(t *testing.T) {
+	env, _, server, _, done := setupTestEnv2(t)
+
+	inProcessClient := client.CreateColoniesClientWithTransport(clienttest.CreateHandlerTransport(server.Handler()))
+
+	colony, err := inProcessClient.GetColonyByID(env.colonyID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, env.colonyID, colony.ID)
+
+	_, err = inProcessClient.GetProcess(core.GenerateRandomID(), env.executorPrvKey)
+	assert.True(t, errors.Is(err, core.ErrNotFound))
+
+	server.Shutdown()
+	<-done
+}
+
 func TestCheckHealth(t *testing.T) {
 	_, client, server, _, done := setupTestEnv2(t)
 
@@ -117,3 +186,36 @@ func TestResetDatabase(t *testing.T) {
 	server.Shutdown()
 	<-done
 }
+
+func TestHandleHTTPErrorDatabaseError(t *testing.T) {
+	gin.SetMode(gin.ReleaseMode)
+	server := &ColoniesServer{}
+
+	handleHTTPError := func(err error) *core.Failure {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		assert.True(t, server.handleHTTPError(c, err, http.StatusBadRequest))
+
+		rpcReplyMsg, err := rpc.CreateRPCReplyMsgFromJSON(w.Body.String())
+		assert.Nil(t, err)
+		failure, err := core.ConvertJSONToFailure(rpcReplyMsg.DecodePayload())
+		assert.Nil(t, err)
+		assert.Equal(t, failure.Status, w.Code)
+
+		return failure
+	}
+
+	// Database errors are reported as internal errors even if the handler used another status
+	failure := handleHTTPError(&pq.Error{Message: "relation does not exist"})
+	assert.Equal(t, http.StatusInternalServerError, failure.Status)
+	assert.Equal(t, core.ERROR_INTERNAL, failure.Code)
+
+	failure = handleHTTPError(fmt.Errorf("failed to add process: %w", sql.ErrConnDone))
+	assert.Equal(t, http.StatusInternalServerError, failure.Status)
+	assert.Equal(t, core.ERROR_INTERNAL, failure.Code)
+
+	// Other untyped errors use the status of the handler
+	failure = handleHTTPError(errors.New("invalid msg"))
+	assert.Equal(t, http.StatusBadRequest, failure.Status)
+	assert.Equal(t, core.ERROR_VALIDATION, failure.Code)
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/colonyos/colonies/pkg/database"
 	"github.com/colonyos/colonies/pkg/database/postgresql"
 	"github.com/colonyos/colonies/pkg/rpc"
+	"github.com/colonyos/colonies/pkg/security"
 	"github.com/colonyos/colonies/pkg/security/crypto"
+	"github.com/colonyos/colonies/pkg/storage"
 	"github.com/colonyos/colonies/pkg/utils"
 	"github.com/gin-gonic/gin"
 	log "github.com/sirupsen/logrus"
@@ -144,7 +146,14 @@ func prepareTestsWithRetention(t *testing.T, retention bool) (*client.ColoniesCl
 	node := cluster.Node{Name: "etcd", Host: "localhost", EtcdClientPort: 24100, EtcdPeerPort: 23100, RelayPort: 25100, APIPort: TESTPORT}
 	clusterConfig := cluster.Config{}
 	clusterConfig.AddNode(node)
-	server := CreateColoniesServer(db, TESTPORT, serverID, EnableTLS, "../../cert/key.pem", "../../cert/cert.pem", node, clusterConfig, "/tmp/colonies/etcd", GENERATOR_TRIGGER_PERIOD, CRON_TRIGGER_PERIOD, true, false, retention, 1, 500)
+	artifactStorage, err := storage.CreateLocalStorage("/tmp/colonies/artifacts")
+	assert.Nil(t, err)
+	secretKeyStr, err := security.GenerateSecretKey()
+	assert.Nil(t, err)
+	secretKey, err := security.ParseSecretKey(secretKeyStr)
+	assert.Nil(t, err)
+
+	server := CreateColoniesServer(db, TESTPORT, serverID, EnableTLS, "../../cert/key.pem", "../../cert/cert.pem", node, clusterConfig, "/tmp/colonies/etcd", GENERATOR_TRIGGER_PERIOD, CRON_TRIGGER_PERIOD, true, false, retention, 1, 500, artifactStorage, secretKey, true)
 
 	done := make(chan bool)
 	go func() {
@@ -160,14 +169,14 @@ func createTestColoniesController(db database.Database) *coloniesController {
 	node := cluster.Node{Name: "etcd", Host: "localhost", EtcdClientPort: 24100, EtcdPeerPort: 23100, RelayPort: 25100, APIPort: TESTPORT}
 	clusterConfig := cluster.Config{}
 	clusterConfig.AddNode(node)
-	return createColoniesController(db, node, clusterConfig, "/tmp/colonies/etcd", GENERATOR_TRIGGER_PERIOD, CRON_TRIGGER_PERIOD, false, -1, 500)
+	return createColoniesController(db, node, clusterConfig, "/tmp/colonies/etcd", GENERATOR_TRIGGER_PERIOD, CRON_TRIGGER_PERIOD, false, -1, 500, false)
 }
 
 func createTestColoniesController2(db database.Database) *coloniesController {
 	node := cluster.Node{Name: "etcd2", Host: "localhost", EtcdClientPort: 26100, EtcdPeerPort: 27100, RelayPort: 28100, APIPort: TESTPORT}
 	clusterConfig := cluster.Config{}
 	clusterConfig.AddNode(node)
-	return createColoniesController(db, node, clusterConfig, "/tmp/colonies/etcd", GENERATOR_TRIGGER_PERIOD, CRON_TRIGGER_PERIOD, false, -1, 500)
+	return createColoniesController(db, node, clusterConfig, "/tmp/colonies/etcd", GENERATOR_TRIGGER_PERIOD, CRON_TRIGGER_PERIOD, false, -1, 500, false)
 }
 
 func generateDiamondtWorkflowSpec(colonyID string) *core.WorkflowSpec {
@@ -313,11 +322,21 @@ func StartCluster(t *testing.T, db database.Database, size int) []ServerInfo {
 	serverID, err := crypto.GenerateID(serverPrvKey)
 	assert.Nil(t, err)
 
+	// All servers in a cluster share the same artifact storage
+	artifactStorage, err := storage.CreateLocalStorage("/tmp/colonies/artifacts")
+	assert.Nil(t, err)
+
+	// All servers in a cluster must use the same secret key
+	secretKeyStr, err := security.GenerateSecretKey()
+	assert.Nil(t, err)
+	secretKey, err := security.ParseSecretKey(secretKeyStr)
+	assert.Nil(t, err)
+
 	sChan := make(chan ServerInfo)
 	for i, node := range clusterConfig.Nodes {
 		go func(i int, node cluster.Node) {
 			log.WithFields(log.Fields{"APIPort": node.APIPort}).Info("Starting ColoniesServer")
-			server := CreateColoniesServer(db, node.APIPort, serverID, false, "", "", node, clusterConfig, "/tmp/colonies/etcd"+strconv.Itoa(i), GENERATOR_TRIGGER_PERIOD, CRON_TRIGGER_PERIOD, true, false, false, -1, 500)
+			server := CreateColoniesServer(db, node.APIPort, serverID, false, "", "", node, clusterConfig, "/tmp/colonies/etcd"+strconv.Itoa(i), GENERATOR_TRIGGER_PERIOD, CRON_TRIGGER_PERIOD, true, false, false, -1, 500, artifactStorage, secretKey, true)
 			done := make(chan struct{})
 			s := ServerInfo{ServerID: serverID, ServerPrvKey: serverPrvKey, Server: server, Node: node, Done: done}
 			go func(i int) {
@@ -2,9 +2,15 @@ package server
 
 import (
 	"errors"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/colonyos/colonies/pkg/core"
+	cronlib "github.com/colonyos/colonies/pkg/cron"
 )
 
 func VerifyFunctionSpec(funcSpec *core.FunctionSpec) error {
@@ -16,21 +22,376 @@ func VerifyFunctionSpec(funcSpec *core.FunctionSpec) error {
 	return nil
 }
 
+// VerifyWorkflowSpec returns an error describing all problems found by ValidateWorkflowSpec
 func VerifyWorkflowSpec(workflowSpec *core.WorkflowSpec) error {
-	processMap := make(map[string]*core.Process)
+	problems := ValidateWorkflowSpec(workflowSpec)
+	if len(problems) > 0 {
+		return errors.New("Failed to submit workflow, " + strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// ValidateWorkflowSpec returns all problems found in a workflow spec, an empty list means that the workflow spec is valid
+func ValidateWorkflowSpec(workflowSpec *core.WorkflowSpec) []string {
+	var problems []string
+
+	if workflowSpec.Deadline < 0 {
+		problems = append(problems, "deadline cannot be negative")
+	}
+	if workflowSpec.FailurePolicy != "" && workflowSpec.FailurePolicy != core.FAIL_FAST && workflowSpec.FailurePolicy != core.CONTINUE {
+		problems = append(problems, "invalid failure policy <"+workflowSpec.FailurePolicy+">, expected <"+core.FAIL_FAST+"> or <"+core.CONTINUE+">")
+	}
+
+	funcSpecs := make(map[string]*core.FunctionSpec)
+	for i := range workflowSpec.FunctionSpecs {
+		funcSpec := &workflowSpec.FunctionSpecs[i]
+		if funcSpec.NodeName == "" {
+			problems = append(problems, "function spec "+strconv.Itoa(i)+" has no node name")
+			continue
+		}
+		if funcSpec.NodeName == core.CONDITION_OUTPUT {
+			problems = append(problems, "node name <"+core.CONDITION_OUTPUT+"> is reserved, conditions use it for the outputs of all parents")
+		}
+		if _, ok := funcSpecs[funcSpec.NodeName]; ok {
+			problems = append(problems, "duplicate node name <"+funcSpec.NodeName+">")
+			continue
+		}
+		funcSpecs[funcSpec.NodeName] = funcSpec
+	}
+
 	for _, funcSpec := range workflowSpec.FunctionSpecs {
-		process := core.CreateProcess(&funcSpec)
-		processMap[process.FunctionSpec.NodeName] = process
+		nodeName := funcSpec.NodeName
+		for _, dependsOn := range funcSpec.Conditions.Dependencies {
+			if funcSpecs[dependsOn] == nil {
+				problems = append(problems, "node <"+nodeName+"> depends on <"+dependsOn+"> which does not exist")
+			}
+		}
+
+		if funcSpec.Condition != "" {
+			if len(funcSpec.Conditions.Dependencies) == 0 {
+				problems = append(problems, "node <"+nodeName+"> has a condition but no dependencies")
+			} else if err := core.VerifyCondition(funcSpec.Condition); err != nil {
+				problems = append(problems, "invalid condition for node <"+nodeName+">: "+err.Error())
+			}
+		}
+
+		if funcSpec.MaxParallel < 0 {
+			problems = append(problems, "node <"+nodeName+"> has a negative maxparallel")
+		}
+		if funcSpec.Map && len(funcSpec.Conditions.Dependencies) == 0 {
+			problems = append(problems, "map node <"+nodeName+"> has no dependencies to map over")
+		}
+
+		for _, name := range sortedKeys(funcSpec.Inputs) {
+			bindingNodeName, err := core.VerifyInputBinding(funcSpec.Inputs[name])
+			if err != nil {
+				problems = append(problems, "invalid input <"+name+"> of node <"+nodeName+">: "+err.Error())
+				continue
+			}
+			isDependency := false
+			for _, dependsOn := range funcSpec.Conditions.Dependencies {
+				if dependsOn == bindingNodeName {
+					isDependency = true
+				}
+			}
+			if !isDependency {
+				problems = append(problems, "input <"+name+"> of node <"+nodeName+"> refers to <"+bindingNodeName+"> which is not a dependency")
+			}
+		}
+
+		subWorkflowSpec := funcSpec.Workflow
+		if subWorkflowSpec == nil {
+			continue
+		}
+		if subWorkflowSpec.Template != nil {
+			// Sub-workflows referencing a workflow template are instantiated when started
+			if len(subWorkflowSpec.FunctionSpecs) > 0 {
+				problems = append(problems, "sub-workflow of node <"+nodeName+"> has both a template and function specs")
+			}
+			continue
+		}
+		if len(subWorkflowSpec.FunctionSpecs) == 0 {
+			problems = append(problems, "sub-workflow of node <"+nodeName+"> has no function specs")
+			continue
+		}
+		for _, problem := range ValidateWorkflowSpec(subWorkflowSpec) {
+			problems = append(problems, "sub-workflow of node <"+nodeName+">: "+problem)
+		}
 	}
 
-	for _, process := range processMap {
-		for _, dependsOn := range process.FunctionSpec.Conditions.Dependencies {
-			parentProcess := processMap[dependsOn]
-			if parentProcess == nil {
-				msg := "Failed to submit workflow, invalid dependencies, are you depending on a nodename that does not exits?"
-				return errors.New(msg)
+	return append(problems, findDependencyCycles(workflowSpec, funcSpecs)...)
+}
+
+// findDependencyCycles reports nodes that are part of a dependency cycle, and nodes that can never run since
+// they depend on a cycle
+func findDependencyCycles(workflowSpec *core.WorkflowSpec, funcSpecs map[string]*core.FunctionSpec) []string {
+	// Repeatedly remove nodes whose dependencies have all been removed, what is left cannot be reached from the roots
+	removed := make(map[string]bool)
+	for {
+		changed := false
+		for nodeName, funcSpec := range funcSpecs {
+			if removed[nodeName] {
+				continue
+			}
+			ready := true
+			for _, dependsOn := range funcSpec.Conditions.Dependencies {
+				if funcSpecs[dependsOn] != nil && !removed[dependsOn] {
+					ready = false
+				}
 			}
+			if ready {
+				removed[nodeName] = true
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	var cycle []string
+	var unreachable []string
+	reported := make(map[string]bool)
+	for _, funcSpec := range workflowSpec.FunctionSpecs {
+		nodeName := funcSpec.NodeName
+		if funcSpecs[nodeName] == nil || removed[nodeName] || reported[nodeName] {
+			continue
+		}
+		reported[nodeName] = true
+		if dependsOnItself(nodeName, funcSpecs, removed) {
+			cycle = append(cycle, nodeName)
+		} else {
+			unreachable = append(unreachable, nodeName)
+		}
+	}
+
+	var problems []string
+	if len(cycle) > 0 {
+		problems = append(problems, "dependency cycle between nodes <"+strings.Join(cycle, ">, <")+">")
+	}
+	for _, nodeName := range unreachable {
+		problems = append(problems, "node <"+nodeName+"> is unreachable since it depends on a dependency cycle")
+	}
+
+	return problems
+}
+
+func dependsOnItself(nodeName string, funcSpecs map[string]*core.FunctionSpec, removed map[string]bool) bool {
+	visited := make(map[string]bool)
+	var visit func(current string) bool
+	visit = func(current string) bool {
+		for _, dependsOn := range funcSpecs[current].Conditions.Dependencies {
+			if dependsOn == nodeName {
+				return true
+			}
+			if funcSpecs[dependsOn] == nil || removed[dependsOn] || visited[dependsOn] {
+				continue
+			}
+			visited[dependsOn] = true
+			if visit(dependsOn) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return visit(nodeName)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// findMissingExecutorTypes reports executor types required by the workflow, including inline
+// sub-workflows, that no registered executor in the colony can serve
+func findMissingExecutorTypes(workflowSpec *core.WorkflowSpec, executors []*core.Executor) []string {
+	registered := make(map[string]bool)
+	for _, executor := range executors {
+		registered[executor.Type] = true
+	}
+
+	var problems []string
+	reported := make(map[string]bool)
+	var visit func(workflowSpec *core.WorkflowSpec)
+	visit = func(workflowSpec *core.WorkflowSpec) {
+		for i := range workflowSpec.FunctionSpecs {
+			funcSpec := &workflowSpec.FunctionSpecs[i]
+			if funcSpec.Workflow != nil {
+				visit(funcSpec.Workflow)
+				continue
+			}
+			executorType := funcSpec.Conditions.ExecutorType
+			if executorType == "" || registered[executorType] || reported[executorType] {
+				continue
+			}
+			reported[executorType] = true
+			problems = append(problems, "no executor of type "+executorType+" is registered in the colony")
+		}
+	}
+	visit(workflowSpec)
+
+	return problems
+}
+
+func VerifyWorkflowTemplate(template *core.WorkflowTemplate) error {
+	if template.Name == "" {
+		return errors.New("Workflow template name must be set")
+	}
+
+	if template.Workflow == nil || len(template.Workflow.FunctionSpecs) == 0 {
+		return errors.New("Workflow template <" + template.Name + "> has no function specs")
+	}
+
+	if template.Workflow.Template != nil {
+		return errors.New("Workflow template <" + template.Name + "> cannot reference another workflow template")
+	}
+
+	names := make(map[string]bool)
+	for _, parameter := range template.Parameters {
+		if parameter.Name == "" {
+			return errors.New("Workflow template <" + template.Name + "> has a parameter without a name")
+		}
+		if names[parameter.Name] {
+			return errors.New("Workflow template <" + template.Name + "> has duplicate parameter <" + parameter.Name + ">")
+		}
+		names[parameter.Name] = true
+	}
+
+	return VerifyWorkflowSpec(template.Workflow)
+}
+
+func VerifyCron(cron *core.Cron) error {
+	workflowSpec, err := core.ConvertJSONToWorkflowSpec(cron.WorkflowSpec)
+	if err != nil {
+		return err
+	}
+
+	err = VerifyWorkflowSpec(workflowSpec)
+	if err != nil {
+		return err
+	}
+
+	if cron.Interval == 0 {
+		return errors.New("Cron interval must be -1 (disabled) or larger than 0")
+	}
+
+	if cron.Interval == -1 {
+		_, err = cronlib.Next(cron.CronExpression)
+		if err != nil {
+			return err
 		}
+		if cron.Random {
+			return errors.New("Random cron is only supported when specifying intervals")
+		}
+	}
+
+	return nil
+}
+
+func VerifyGenerator(generator *core.Generator) error {
+	workflowSpec, err := core.ConvertJSONToWorkflowSpec(generator.WorkflowSpec)
+	if err != nil {
+		return err
+	}
+
+	err = VerifyWorkflowSpec(workflowSpec)
+	if err != nil {
+		return err
+	}
+
+	if generator.Trigger <= 0 {
+		return errors.New("Generator trigger must be larger than 0")
+	}
+
+	return nil
+}
+
+func VerifyWebhook(webhook *core.Webhook) error {
+	u, err := url.Parse(webhook.URL)
+	if err != nil {
+		return err
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("Webhook URL must be an absolute http or https URL")
+	}
+
+	for _, kind := range webhook.Kinds {
+		switch kind {
+		case core.PROCESS_KIND, core.PROCESSGRAPH_KIND, core.EXECUTOR_KIND, core.CRON_KIND:
+		default:
+			return errors.New("Invalid webhook kind <" + kind + ">")
+		}
+	}
+
+	return nil
+}
+
+// VerifyWebhookAddress resolves the host of a webhook URL and returns an error if it resolves to a loopback,
+// link-local or private address, so that webhooks cannot be used to reach services in the network of the server
+func VerifyWebhookAddress(webhookURL string) error {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return err
+	}
+
+	ips, err := net.LookupIP(u.Hostname())
+	if err != nil {
+		return errors.New("Failed to resolve webhook host <" + u.Hostname() + ">")
+	}
+
+	for _, ip := range ips {
+		if isInternalIP(ip) {
+			return errors.New("Webhook URL must not resolve to a loopback, link-local or private address")
+		}
+	}
+
+	return nil
+}
+
+func isInternalIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified()
+}
+
+var secretNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+func VerifySecret(secret *core.Secret) error {
+	if !secretNameRegex.MatchString(secret.Name) {
+		return errors.New("Invalid secret name <" + secret.Name + ">, must only contain letters, digits, '_', '.' and '-'")
+	}
+
+	if secret.Value == "" {
+		return errors.New("Secret value cannot be empty")
+	}
+
+	if len(secret.ExecutorTypes) == 0 {
+		return errors.New("Secret must be granted to at least one executor type")
+	}
+
+	for _, executorType := range secret.ExecutorTypes {
+		if executorType == "" {
+			return errors.New("Secret executor type cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+var adminIDRegex = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
+func VerifyColonyAdmin(admin *core.ColonyAdmin) error {
+	if !adminIDRegex.MatchString(admin.AdminID) {
+		return errors.New("Invalid admin Id <" + admin.AdminID + ">, must be a 64 character hex encoded Id")
+	}
+
+	if admin.AdminID == admin.ColonyID {
+		return errors.New("The colony Id cannot be added as an admin, it is always allowed to administer the colony")
 	}
 
 	return nil
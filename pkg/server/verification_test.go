@@ -2,6 +2,7 @@ package server
 
 import (
 	"testing"
+	"time"
 
 	"github.com/colonyos/colonies/pkg/core"
 	"github.com/stretchr/testify/assert"
@@ -69,3 +70,281 @@ func TestVerifyWorkflowSpec(t *testing.T) {
 	err = VerifyWorkflowSpec(workflowSpec) // Should not work
 	assert.Nil(t, err)
 }
+
+func TestVerifyWorkflowSpecCondition(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+
+	funcSpec1 := core.CreateEmptyFunctionSpec()
+	funcSpec1.NodeName = "task1"
+	funcSpec2 := core.CreateEmptyFunctionSpec()
+	funcSpec2.NodeName = "task2"
+	funcSpec2.AddDependency("task1")
+	funcSpec2.Condition = "task1.output[0] > 0.5"
+
+	workflowSpec := core.CreateWorkflowSpec(colonyID)
+	workflowSpec.AddFunctionSpec(funcSpec1)
+	workflowSpec.AddFunctionSpec(funcSpec2)
+	assert.Nil(t, VerifyWorkflowSpec(workflowSpec))
+
+	workflowSpec.FunctionSpecs[1].Condition = "task1.output[0] >"
+	assert.NotNil(t, VerifyWorkflowSpec(workflowSpec)) // Invalid syntax
+
+	workflowSpec.FunctionSpecs[1].Condition = ""
+	workflowSpec.FunctionSpecs[0].Condition = "output[0] > 0.5"
+	assert.NotNil(t, VerifyWorkflowSpec(workflowSpec)) // Root nodes have no parents to evaluate
+}
+
+func TestVerifyWorkflowSpecMap(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+
+	funcSpec1 := core.CreateEmptyFunctionSpec()
+	funcSpec1.NodeName = "task1"
+	funcSpec2 := core.CreateEmptyFunctionSpec()
+	funcSpec2.NodeName = "task2"
+	funcSpec2.AddDependency("task1")
+	funcSpec2.Map = true
+	funcSpec2.MaxParallel = 2
+
+	workflowSpec := core.CreateWorkflowSpec(colonyID)
+	workflowSpec.AddFunctionSpec(funcSpec1)
+	workflowSpec.AddFunctionSpec(funcSpec2)
+	assert.Nil(t, VerifyWorkflowSpec(workflowSpec))
+
+	workflowSpec.FunctionSpecs[1].MaxParallel = -1
+	assert.NotNil(t, VerifyWorkflowSpec(workflowSpec))
+
+	workflowSpec.FunctionSpecs[1].MaxParallel = 0
+	workflowSpec.FunctionSpecs[0].Map = true
+	assert.NotNil(t, VerifyWorkflowSpec(workflowSpec)) // Nothing to map over
+}
+
+func TestVerifyWorkflowSpecInputs(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+
+	funcSpec1 := core.CreateEmptyFunctionSpec()
+	funcSpec1.NodeName = "train"
+	funcSpec2 := core.CreateEmptyFunctionSpec()
+	funcSpec2.NodeName = "evaluate"
+	funcSpec2.AddDependency("train")
+	funcSpec2.Inputs = map[string]string{"model": "train.out.model"}
+
+	workflowSpec := core.CreateWorkflowSpec(colonyID)
+	workflowSpec.AddFunctionSpec(funcSpec1)
+	workflowSpec.AddFunctionSpec(funcSpec2)
+	assert.Nil(t, VerifyWorkflowSpec(workflowSpec))
+
+	workflowSpec.FunctionSpecs[1].Inputs = map[string]string{"model": "train.model"}
+	assert.NotNil(t, VerifyWorkflowSpec(workflowSpec)) // Invalid binding
+
+	workflowSpec.FunctionSpecs[1].Inputs = map[string]string{"model": "evaluate.out.model"}
+	assert.NotNil(t, VerifyWorkflowSpec(workflowSpec)) // Not a dependency
+}
+
+func TestVerifyWorkflowSpecFailurePolicy(t *testing.T) {
+	funcSpec := core.CreateEmptyFunctionSpec()
+	funcSpec.NodeName = "task1"
+
+	workflowSpec := core.CreateWorkflowSpec(core.GenerateRandomID())
+	workflowSpec.AddFunctionSpec(funcSpec)
+	assert.Nil(t, VerifyWorkflowSpec(workflowSpec))
+
+	workflowSpec.FailurePolicy = core.CONTINUE
+	workflowSpec.Deadline = 60
+	assert.Nil(t, VerifyWorkflowSpec(workflowSpec))
+
+	workflowSpec.FailurePolicy = "ignore"
+	assert.NotNil(t, VerifyWorkflowSpec(workflowSpec))
+
+	workflowSpec.FailurePolicy = core.FAIL_FAST
+	workflowSpec.Deadline = -1
+	assert.NotNil(t, VerifyWorkflowSpec(workflowSpec))
+}
+
+func TestVerifyWorkflowSpecSubWorkflow(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+
+	subFuncSpec := core.CreateEmptyFunctionSpec()
+	subFuncSpec.NodeName = "sub_task1"
+
+	funcSpec := core.CreateEmptyFunctionSpec()
+	funcSpec.NodeName = "task1"
+	funcSpec.Workflow = core.CreateWorkflowSpec(colonyID)
+
+	workflowSpec := core.CreateWorkflowSpec(colonyID)
+	workflowSpec.AddFunctionSpec(funcSpec)
+	assert.NotNil(t, VerifyWorkflowSpec(workflowSpec)) // Empty sub-workflow
+
+	workflowSpec.FunctionSpecs[0].Workflow.AddFunctionSpec(subFuncSpec)
+	assert.Nil(t, VerifyWorkflowSpec(workflowSpec))
+
+	workflowSpec.FunctionSpecs[0].Workflow.FunctionSpecs[0].AddDependency("unknown")
+	assert.NotNil(t, VerifyWorkflowSpec(workflowSpec)) // Invalid dependency in sub-workflow
+}
+
+func TestVerifyWorkflowSpecSubWorkflowTemplate(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+
+	funcSpec := core.CreateEmptyFunctionSpec()
+	funcSpec.NodeName = "task1"
+	funcSpec.Workflow = core.CreateWorkflowSpecFromTemplate(colonyID, "sub", 0, nil)
+
+	workflowSpec := core.CreateWorkflowSpec(colonyID)
+	workflowSpec.AddFunctionSpec(funcSpec)
+	assert.Nil(t, VerifyWorkflowSpec(workflowSpec)) // Instantiated when the sub-workflow is started
+
+	subFuncSpec := core.CreateEmptyFunctionSpec()
+	subFuncSpec.NodeName = "sub_task1"
+	workflowSpec.FunctionSpecs[0].Workflow.AddFunctionSpec(subFuncSpec)
+	assert.NotNil(t, VerifyWorkflowSpec(workflowSpec)) // Both a template and function specs
+}
+
+func TestVerifyWorkflowTemplate(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+
+	funcSpec := core.CreateEmptyFunctionSpec()
+	funcSpec.NodeName = "task1"
+	workflowSpec := core.CreateWorkflowSpec(colonyID)
+	workflowSpec.AddFunctionSpec(funcSpec)
+
+	template := core.CreateWorkflowTemplate(colonyID, "test_template", []core.TemplateParameter{{Name: "param"}}, workflowSpec)
+	assert.Nil(t, VerifyWorkflowTemplate(template))
+
+	template.Name = ""
+	assert.NotNil(t, VerifyWorkflowTemplate(template))
+	template.Name = "test_template"
+
+	template.Parameters = append(template.Parameters, core.TemplateParameter{Name: "param"})
+	assert.NotNil(t, VerifyWorkflowTemplate(template)) // Duplicate parameter
+	template.Parameters = template.Parameters[:1]
+
+	template.Workflow = core.CreateWorkflowSpec(colonyID)
+	assert.NotNil(t, VerifyWorkflowTemplate(template)) // No function specs
+
+	template.Workflow = core.CreateWorkflowSpecFromTemplate(colonyID, "other", 0, nil)
+	assert.NotNil(t, VerifyWorkflowTemplate(template)) // References another template
+}
+
+func TestValidateWorkflowSpecProblems(t *testing.T) {
+	//  task1 -> task2 -> task3 -> task2 (cycle), task4 depends on task3
+	colonyID := core.GenerateRandomID()
+
+	funcSpec1 := core.CreateEmptyFunctionSpec()
+	funcSpec1.NodeName = "task1"
+	funcSpec2 := core.CreateEmptyFunctionSpec()
+	funcSpec2.NodeName = "task2"
+	funcSpec2.AddDependency("task1")
+	funcSpec3 := core.CreateEmptyFunctionSpec()
+	funcSpec3.NodeName = "task3"
+	funcSpec3.AddDependency("task2")
+
+	workflowSpec := core.CreateWorkflowSpec(colonyID)
+	workflowSpec.AddFunctionSpec(funcSpec1)
+	workflowSpec.AddFunctionSpec(funcSpec2)
+	workflowSpec.AddFunctionSpec(funcSpec3)
+	assert.Len(t, ValidateWorkflowSpec(workflowSpec), 0)
+
+	workflowSpec.FunctionSpecs[1].AddDependency("task3")
+	funcSpec4 := core.CreateEmptyFunctionSpec()
+	funcSpec4.NodeName = "task4"
+	funcSpec4.AddDependency("task3")
+	workflowSpec.AddFunctionSpec(funcSpec4)
+	problems := ValidateWorkflowSpec(workflowSpec)
+	assert.Len(t, problems, 2)
+	assert.Contains(t, problems[0], "dependency cycle")
+	assert.Contains(t, problems[1], "<task4> is unreachable")
+
+	funcSpec5 := core.CreateEmptyFunctionSpec()
+	funcSpec5.NodeName = "task1"
+	workflowSpec.AddFunctionSpec(funcSpec5)
+	funcSpec6 := core.CreateEmptyFunctionSpec()
+	funcSpec6.AddDependency("unknown")
+	workflowSpec.AddFunctionSpec(funcSpec6)
+	problems = ValidateWorkflowSpec(workflowSpec)
+	assert.Len(t, problems, 5) // Duplicate, no node name, dangling dependency, cycle and unreachable node
+}
+
+func TestValidateWorkflowSpecReservedNodeName(t *testing.T) {
+	funcSpec1 := core.CreateEmptyFunctionSpec()
+	funcSpec1.NodeName = "output"
+	funcSpec2 := core.CreateEmptyFunctionSpec()
+	funcSpec2.NodeName = "task2"
+	funcSpec2.AddDependency("output")
+
+	workflowSpec := core.CreateWorkflowSpec(core.GenerateRandomID())
+	workflowSpec.AddFunctionSpec(funcSpec1)
+	workflowSpec.AddFunctionSpec(funcSpec2)
+	problems := ValidateWorkflowSpec(workflowSpec)
+	assert.Len(t, problems, 1)
+	assert.Contains(t, problems[0], "<output> is reserved")
+}
+
+func TestFindMissingExecutorTypes(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+
+	funcSpec1 := core.CreateEmptyFunctionSpec()
+	funcSpec1.NodeName = "task1"
+	funcSpec1.Conditions.ExecutorType = "test_executor_type"
+	funcSpec2 := core.CreateEmptyFunctionSpec()
+	funcSpec2.NodeName = "task2"
+	funcSpec2.Conditions.ExecutorType = "gpu_executor_type"
+	subFuncSpec := core.CreateEmptyFunctionSpec()
+	subFuncSpec.NodeName = "sub_task1"
+	subFuncSpec.Conditions.ExecutorType = "gpu_executor_type"
+	funcSpec3 := core.CreateEmptyFunctionSpec()
+	funcSpec3.NodeName = "task3"
+	funcSpec3.Workflow = core.CreateWorkflowSpec(colonyID)
+	funcSpec3.Workflow.AddFunctionSpec(subFuncSpec)
+
+	workflowSpec := core.CreateWorkflowSpec(colonyID)
+	workflowSpec.AddFunctionSpec(funcSpec1)
+	workflowSpec.AddFunctionSpec(funcSpec2)
+	workflowSpec.AddFunctionSpec(funcSpec3)
+
+	executor := core.CreateExecutor(core.GenerateRandomID(), "test_executor_type", "test_executor", colonyID, time.Now(), time.Now())
+	problems := findMissingExecutorTypes(workflowSpec, []*core.Executor{executor})
+	assert.Equal(t, []string{"no executor of type gpu_executor_type is registered in the colony"}, problems)
+}
+
+func TestVerifyWebhook(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+
+	assert.Nil(t, VerifyWebhook(core.CreateWebhook(colonyID, "https://example.com/hook", "secret", []string{core.PROCESS_KIND, core.EXECUTOR_KIND}, []string{core.FAILED_EVENT})))
+	assert.Nil(t, VerifyWebhook(core.CreateWebhook(colonyID, "http://localhost:8080/hook", "secret", nil, nil)))
+	assert.NotNil(t, VerifyWebhook(core.CreateWebhook(colonyID, "ftp://example.com/hook", "secret", nil, nil)))
+	assert.NotNil(t, VerifyWebhook(core.CreateWebhook(colonyID, "/hook", "secret", nil, nil)))
+	assert.NotNil(t, VerifyWebhook(core.CreateWebhook(colonyID, "https://example.com/hook", "secret", []string{"invalid_kind"}, nil)))
+}
+
+func TestVerifyWebhookAddress(t *testing.T) {
+	assert.Nil(t, VerifyWebhookAddress("https://93.184.216.34/hook"))
+	assert.NotNil(t, VerifyWebhookAddress("http://localhost:8080/hook"))
+	assert.NotNil(t, VerifyWebhookAddress("http://127.0.0.1:8080/hook"))
+	assert.NotNil(t, VerifyWebhookAddress("http://[::1]:8080/hook"))
+	assert.NotNil(t, VerifyWebhookAddress("http://169.254.169.254/latest/meta-data"))
+	assert.NotNil(t, VerifyWebhookAddress("http://10.0.0.1/hook"))
+	assert.NotNil(t, VerifyWebhookAddress("http://192.168.1.1/hook"))
+	assert.NotNil(t, VerifyWebhookAddress("http://0.0.0.0/hook"))
+}
+
+func TestVerifySecret(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+
+	assert.Nil(t, VerifySecret(core.CreateSecret(colonyID, "db-password", "secret", []string{"test_executor_type"})))
+	assert.Nil(t, VerifySecret(core.CreateSecret(colonyID, "aws.access_key", "secret", []string{"test_executor_type"})))
+	assert.NotNil(t, VerifySecret(core.CreateSecret(colonyID, "", "secret", []string{"test_executor_type"})))
+	assert.NotNil(t, VerifySecret(core.CreateSecret(colonyID, "-invalid", "secret", []string{"test_executor_type"})))
+	assert.NotNil(t, VerifySecret(core.CreateSecret(colonyID, "invalid name", "secret", []string{"test_executor_type"})))
+	assert.NotNil(t, VerifySecret(core.CreateSecret(colonyID, "db-password", "", []string{"test_executor_type"})))
+	assert.NotNil(t, VerifySecret(core.CreateSecret(colonyID, "db-password", "secret", []string{})))
+	assert.NotNil(t, VerifySecret(core.CreateSecret(colonyID, "db-password", "secret", []string{""})))
+}
+
+func TestVerifyColonyAdmin(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+
+	assert.Nil(t, VerifyColonyAdmin(core.CreateColonyAdmin(colonyID, core.GenerateRandomID(), "alice")))
+	assert.Nil(t, VerifyColonyAdmin(core.CreateColonyAdmin(colonyID, core.GenerateRandomID(), "")))
+	assert.NotNil(t, VerifyColonyAdmin(core.CreateColonyAdmin(colonyID, "", "alice")))
+	assert.NotNil(t, VerifyColonyAdmin(core.CreateColonyAdmin(colonyID, "invalid_id", "alice")))
+	assert.NotNil(t, VerifyColonyAdmin(core.CreateColonyAdmin(colonyID, colonyID, "alice")))
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+func (controller *coloniesController) addWebhook(webhook *core.Webhook) (*core.Webhook, error) {
+	cmd := &command{threaded: true, webhookReplyChan: make(chan *core.Webhook, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			err := controller.db.AddWebhook(webhook)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			addedWebhook, err := controller.db.GetWebhookByID(webhook.ID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.webhookReplyChan <- addedWebhook
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case addedWebhook := <-cmd.webhookReplyChan:
+		return addedWebhook, nil
+	}
+}
+
+func (controller *coloniesController) getWebhook(webhookID string) (*core.Webhook, error) {
+	cmd := &command{threaded: true, webhookReplyChan: make(chan *core.Webhook, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			webhook, err := controller.db.GetWebhookByID(webhookID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.webhookReplyChan <- webhook
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case webhook := <-cmd.webhookReplyChan:
+		return webhook, nil
+	}
+}
+
+func (controller *coloniesController) getWebhooks(colonyID string) ([]*core.Webhook, error) {
+	cmd := &command{threaded: true, webhooksReplyChan: make(chan []*core.Webhook, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			webhooks, err := controller.db.FindWebhooksByColonyID(colonyID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.webhooksReplyChan <- webhooks
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case webhooks := <-cmd.webhooksReplyChan:
+		return webhooks, nil
+	}
+}
+
+func (controller *coloniesController) deleteWebhook(webhookID string) error {
+	cmd := &command{threaded: true, errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			cmd.errorChan <- controller.db.DeleteWebhookByID(webhookID)
+		}}
+
+	controller.cmdQueue <- cmd
+	return <-cmd.errorChan
+}
+
+func (controller *coloniesController) getWebhookDeliveries(webhookID string, count int) ([]*core.WebhookDelivery, error) {
+	cmd := &command{threaded: true, webhookDeliveriesReplyChan: make(chan []*core.WebhookDelivery, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			if count > MAX_COUNT {
+				cmd.errorChan <- errors.New("Count is larger than MaxCount limit <" + strconv.Itoa(MAX_COUNT) + ">")
+				return
+			}
+			deliveries, err := controller.db.FindWebhookDeliveries(webhookID, count)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.webhookDeliveriesReplyChan <- deliveries
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case deliveries := <-cmd.webhookDeliveriesReplyChan:
+		return deliveries, nil
+	}
+}
@@ -0,0 +1,206 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/database"
+	log "github.com/sirupsen/logrus"
+)
+
+// webhookDispatcher posts colony events to the webhooks registered in the colony. Events are queued and delivered
+// in the background by a fixed number of delivery workers, so that a slow webhook never blocks the controller, and
+// every delivery is recorded in the delivery log of the webhook.
+type webhookDispatcher struct {
+	db           database.Database
+	httpClient   *http.Client
+	maxRetries   int
+	retryBackoff time.Duration
+	eventChan    chan *core.ColonyEvent
+	deliveryChan chan *webhookJob
+	stopChan     chan struct{}
+}
+
+// webhookJob is an event waiting to be delivered to a webhook
+type webhookJob struct {
+	webhook *core.Webhook
+	event   *core.ColonyEvent
+}
+
+func createWebhookDispatcher(db database.Database, allowPrivateWebhooks bool) *webhookDispatcher {
+	dispatcher := &webhookDispatcher{}
+	dispatcher.db = db
+	dispatcher.httpClient = createWebhookHTTPClient(allowPrivateWebhooks)
+	dispatcher.maxRetries = WEBHOOK_MAX_RETRIES
+	dispatcher.retryBackoff = WEBHOOK_RETRY_BACKOFF * time.Millisecond
+	dispatcher.eventChan = make(chan *core.ColonyEvent, WEBHOOK_QUEUE_SIZE)
+	dispatcher.deliveryChan = make(chan *webhookJob, WEBHOOK_DELIVERY_QUEUE_SIZE)
+	dispatcher.stopChan = make(chan struct{})
+
+	go dispatcher.worker()
+	for i := 0; i < WEBHOOK_WORKERS; i++ {
+		go dispatcher.deliveryWorker()
+	}
+
+	return dispatcher
+}
+
+// createWebhookHTTPClient creates a client that does not follow redirects. Unless private webhooks are allowed, the
+// address is checked when connecting, since the host of a webhook may resolve to another address than when the
+// webhook was added.
+func createWebhookHTTPClient(allowPrivateWebhooks bool) *http.Client {
+	dialer := &net.Dialer{Timeout: WEBHOOK_TIMEOUT * time.Second}
+	if !allowPrivateWebhooks {
+		dialer.Control = func(network string, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || isInternalIP(ip) {
+				return errors.New("Webhook address <" + host + "> is a loopback, link-local or private address")
+			}
+			return nil
+		}
+	}
+
+	return &http.Client{
+		Timeout:   WEBHOOK_TIMEOUT * time.Second,
+		Transport: &http.Transport{DialContext: dialer.DialContext, TLSHandshakeTimeout: WEBHOOK_TIMEOUT * time.Second},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		}}
+}
+
+// dispatch queues an event, the event is dropped if the queue is full
+func (dispatcher *webhookDispatcher) dispatch(event *core.ColonyEvent) {
+	select {
+	case dispatcher.eventChan <- event:
+	default:
+		log.WithFields(log.Fields{"ColonyId": event.ColonyID, "Kind": event.Kind, "Type": event.Type}).Warning("Webhook queue is full, dropping event")
+	}
+}
+
+func (dispatcher *webhookDispatcher) worker() {
+	for {
+		select {
+		case event := <-dispatcher.eventChan:
+			webhooks, err := dispatcher.db.FindWebhooksByColonyID(event.ColonyID)
+			if err != nil {
+				log.WithFields(log.Fields{"ColonyId": event.ColonyID, "Error": err}).Error("Failed to find webhooks")
+				continue
+			}
+			for _, webhook := range webhooks {
+				if webhook.Matches(event) {
+					dispatcher.enqueue(webhook, event)
+				}
+			}
+		case <-dispatcher.stopChan:
+			return
+		}
+	}
+}
+
+// enqueue queues a delivery of the event to the webhook, the delivery is dropped if the queue is full, i.e. all
+// delivery workers are busy with slow webhooks
+func (dispatcher *webhookDispatcher) enqueue(webhook *core.Webhook, event *core.ColonyEvent) {
+	select {
+	case dispatcher.deliveryChan <- &webhookJob{webhook: webhook, event: event}:
+	default:
+		log.WithFields(log.Fields{"WebhookId": webhook.ID, "ColonyId": event.ColonyID, "Kind": event.Kind, "Type": event.Type}).Warning("Webhook delivery queue is full, dropping delivery")
+	}
+}
+
+func (dispatcher *webhookDispatcher) deliveryWorker() {
+	for {
+		select {
+		case job := <-dispatcher.deliveryChan:
+			dispatcher.deliverAndRecord(job.webhook, job.event)
+		case <-dispatcher.stopChan:
+			return
+		}
+	}
+}
+
+func (dispatcher *webhookDispatcher) deliverAndRecord(webhook *core.Webhook, event *core.ColonyEvent) {
+	delivery := dispatcher.deliver(webhook, event)
+	err := dispatcher.db.AddWebhookDelivery(delivery)
+	if err != nil {
+		log.WithFields(log.Fields{"WebhookId": webhook.ID, "Error": err}).Error("Failed to add webhook delivery")
+	}
+}
+
+// deliver posts the event to the webhook, failed requests are retried with exponential backoff
+func (dispatcher *webhookDispatcher) deliver(webhook *core.Webhook, event *core.ColonyEvent) *core.WebhookDelivery {
+	delivery := core.CreateWebhookDelivery(webhook, event)
+
+	jsonString, err := event.ToJSON()
+	if err != nil {
+		delivery.Error = err.Error()
+		return delivery
+	}
+	payload := []byte(jsonString)
+
+	backoff := dispatcher.retryBackoff
+	for attempt := 1; attempt <= dispatcher.maxRetries+1; attempt++ {
+		delivery.Attempts = attempt
+		delivery.StatusCode, err = dispatcher.post(webhook, delivery.ID, event, payload)
+		if err == nil {
+			delivery.Success = true
+			delivery.Error = ""
+			break
+		}
+		delivery.Error = err.Error()
+
+		log.WithFields(log.Fields{"WebhookId": webhook.ID, "URL": webhook.URL, "Attempt": attempt, "Error": err}).Debug("Failed to deliver webhook")
+
+		if attempt > dispatcher.maxRetries {
+			break
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-dispatcher.stopChan:
+			delivery.Time = time.Now()
+			return delivery
+		}
+		backoff *= 2
+	}
+
+	delivery.Time = time.Now()
+
+	return delivery
+}
+
+func (dispatcher *webhookDispatcher) post(webhook *core.Webhook, deliveryID string, event *core.ColonyEvent, payload []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, webhook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(core.WEBHOOK_EVENT_HEADER, event.Kind+"."+event.Type)
+	req.Header.Set(core.WEBHOOK_DELIVERY_HEADER, deliveryID)
+	req.Header.Set(core.WEBHOOK_SIGNATURE_HEADER, core.SignWebhookPayload(webhook.Secret, payload))
+
+	resp, err := dispatcher.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, errors.New("Webhook replied with status code " + strconv.Itoa(resp.StatusCode))
+	}
+
+	return resp.StatusCode, nil
+}
+
+func (dispatcher *webhookDispatcher) stop() {
+	close(dispatcher.stopChan)
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestWebhookDispatcher(maxRetries int) *webhookDispatcher {
+	return &webhookDispatcher{
+		httpClient:   &http.Client{Timeout: time.Second},
+		maxRetries:   maxRetries,
+		retryBackoff: time.Millisecond,
+		eventChan:    make(chan *core.ColonyEvent, 1),
+		deliveryChan: make(chan *webhookJob, 1),
+		stopChan:     make(chan struct{})}
+}
+
+func TestWebhookDispatcherDeliver(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+	webhook := core.CreateWebhook(colonyID, "", "secret", []string{core.PROCESS_KIND}, []string{})
+	event := core.CreateColonyEvent(colonyID, core.PROCESS_KIND, core.FAILED_EVENT, core.GenerateRandomID(), 0, "")
+
+	var mutex sync.Mutex
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		payload, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.True(t, core.VerifyWebhookPayload("secret", payload, r.Header.Get(core.WEBHOOK_SIGNATURE_HEADER)))
+		assert.Equal(t, r.Header.Get(core.WEBHOOK_EVENT_HEADER), core.PROCESS_KIND+"."+core.FAILED_EVENT)
+
+		eventFromWebhook, err := core.ConvertJSONToColonyEvent(string(payload))
+		assert.Nil(t, err)
+		assert.True(t, event.Equals(eventFromWebhook))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	webhook.URL = server.URL
+
+	dispatcher := createTestWebhookDispatcher(5)
+	defer dispatcher.stop()
+
+	delivery := dispatcher.deliver(webhook, event)
+	assert.True(t, delivery.Success)
+	assert.Equal(t, delivery.Attempts, 3)
+	assert.Equal(t, delivery.StatusCode, http.StatusOK)
+	assert.Equal(t, delivery.Error, "")
+	assert.Equal(t, delivery.WebhookID, webhook.ID)
+	assert.Equal(t, delivery.EventID, event.ID)
+}
+
+func TestWebhookDispatcherDeliverFailed(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+	event := core.CreateColonyEvent(colonyID, core.PROCESS_KIND, core.FAILED_EVENT, core.GenerateRandomID(), 0, "")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+	webhook := core.CreateWebhook(colonyID, server.URL, "secret", []string{}, []string{})
+
+	dispatcher := createTestWebhookDispatcher(2)
+	defer dispatcher.stop()
+
+	delivery := dispatcher.deliver(webhook, event)
+	assert.False(t, delivery.Success)
+	assert.Equal(t, delivery.Attempts, 3)
+	assert.Equal(t, delivery.StatusCode, http.StatusBadGateway)
+	assert.NotEqual(t, delivery.Error, "")
+}
+
+func TestWebhookDispatcherQueueFull(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+	webhook := core.CreateWebhook(colonyID, "http://localhost", "secret", []string{}, []string{})
+	event1 := core.CreateColonyEvent(colonyID, core.PROCESS_KIND, core.FAILED_EVENT, core.GenerateRandomID(), 0, "")
+	event2 := core.CreateColonyEvent(colonyID, core.PROCESS_KIND, core.FAILED_EVENT, core.GenerateRandomID(), 0, "")
+
+	// No delivery workers are started, so the queue is never drained
+	dispatcher := createTestWebhookDispatcher(0)
+	defer dispatcher.stop()
+
+	dispatcher.enqueue(webhook, event1)
+	dispatcher.enqueue(webhook, event2) // Dropped, the queue only fits one delivery
+	assert.Len(t, dispatcher.deliveryChan, 1)
+
+	job := <-dispatcher.deliveryChan
+	assert.Equal(t, job.event.ID, event1.ID)
+}
+
+func TestWebhookDispatcherPrivateAddress(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+	event := core.CreateColonyEvent(colonyID, core.PROCESS_KIND, core.FAILED_EVENT, core.GenerateRandomID(), 0, "")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	webhook := core.CreateWebhook(colonyID, server.URL, "secret", []string{core.PROCESS_KIND}, []string{})
+
+	// The test server listens on a loopback address
+	dispatcher := createTestWebhookDispatcher(0)
+	dispatcher.httpClient = createWebhookHTTPClient(false)
+	defer dispatcher.stop()
+
+	delivery := dispatcher.deliver(webhook, event)
+	assert.False(t, delivery.Success)
+	assert.Equal(t, delivery.StatusCode, 0)
+
+	dispatcher.httpClient = createWebhookHTTPClient(true)
+	delivery = dispatcher.deliver(webhook, event)
+	assert.True(t, delivery.Success)
+}
+
+func TestWebhookDispatcherRedirect(t *testing.T) {
+	colonyID := core.GenerateRandomID()
+	event := core.CreateColonyEvent(colonyID, core.PROCESS_KIND, core.FAILED_EVENT, core.GenerateRandomID(), 0, "")
+
+	redirected := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirected" {
+			redirected = true
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		http.Redirect(w, r, "/redirected", http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+	webhook := core.CreateWebhook(colonyID, server.URL+"/hook", "secret", []string{core.PROCESS_KIND}, []string{})
+
+	dispatcher := createTestWebhookDispatcher(0)
+	dispatcher.httpClient = createWebhookHTTPClient(true)
+	defer dispatcher.stop()
+
+	// Redirects are not followed
+	delivery := dispatcher.deliver(webhook, event)
+	assert.False(t, delivery.Success)
+	assert.Equal(t, delivery.StatusCode, http.StatusTemporaryRedirect)
+	assert.False(t, redirected)
+}
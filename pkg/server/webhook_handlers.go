@@ -0,0 +1,203 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secret), nil
+}
+
+func (server *ColoniesServer) handleAddWebhookHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateAddWebhookMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to add webhook, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to add webhook, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+	if msg.Webhook == nil {
+		server.handleHTTPError(c, errors.New("Failed to add webhook, msg.Webhook is nil"), http.StatusBadRequest)
+		return
+	}
+
+	err = server.validator.RequireColonyOwner(recoveredID, msg.Webhook.ColonyID)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	err = VerifyWebhook(msg.Webhook)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	if !server.allowPrivateWebhooks {
+		err = VerifyWebhookAddress(msg.Webhook.URL)
+		if server.handleHTTPError(c, err, http.StatusBadRequest) {
+			return
+		}
+	}
+
+	// A secret is generated if the colony owner did not provide one, the secret is only returned when the
+	// webhook is added
+	if msg.Webhook.Secret == "" {
+		msg.Webhook.Secret, err = generateWebhookSecret()
+		if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+			return
+		}
+	}
+
+	msg.Webhook.ID = core.GenerateRandomID()
+	addedWebhook, err := server.controller.addWebhook(msg.Webhook)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if addedWebhook == nil {
+		server.handleHTTPError(c, errors.New("Failed to add webhook, addedWebhook is nil"), http.StatusInternalServerError)
+		return
+	}
+
+	jsonString, err = addedWebhook.ToJSON()
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"WebhookId": addedWebhook.ID, "URL": addedWebhook.URL}).Debug("Adding webhook")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
+func (server *ColoniesServer) handleGetWebhooksHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateGetWebhooksMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to get webhooks, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to get webhooks, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	err = server.validator.RequireColonyOwner(recoveredID, msg.ColonyID)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	webhooks, err := server.controller.getWebhooks(msg.ColonyID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	for _, webhook := range webhooks {
+		webhook.Secret = ""
+	}
+
+	jsonString, err = core.ConvertWebhookArrayToJSON(webhooks)
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ColonyId": msg.ColonyID}).Debug("Getting webhooks")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
+func (server *ColoniesServer) handleDeleteWebhookHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateDeleteWebhookMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to delete webhook, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to delete webhook, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	webhook, err := server.controller.getWebhook(msg.WebhookID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if webhook == nil {
+		server.handleHTTPError(c, core.CreateError(core.ERROR_NOT_FOUND, "Failed to delete webhook, webhook with Id <"+msg.WebhookID+"> not found"), http.StatusNotFound)
+		return
+	}
+
+	setAuditColony(c, webhook.ColonyID)
+	err = server.validator.RequireColonyOwner(recoveredID, webhook.ColonyID)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	err = server.controller.deleteWebhook(webhook.ID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	log.WithFields(log.Fields{"WebhookId": webhook.ID}).Debug("Deleting webhook")
+
+	server.sendEmptyHTTPReply(c, payloadType)
+}
+
+func (server *ColoniesServer) handleGetWebhookDeliveriesHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateGetWebhookDeliveriesMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to get webhook deliveries, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to get webhook deliveries, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	webhook, err := server.controller.getWebhook(msg.WebhookID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if webhook == nil {
+		server.handleHTTPError(c, core.CreateError(core.ERROR_NOT_FOUND, "Failed to get webhook deliveries, webhook with Id <"+msg.WebhookID+"> not found"), http.StatusNotFound)
+		return
+	}
+
+	err = server.validator.RequireColonyOwner(recoveredID, webhook.ColonyID)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	deliveries, err := server.controller.getWebhookDeliveries(webhook.ID, msg.Count)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	jsonString, err = core.ConvertWebhookDeliveryArrayToJSON(deliveries)
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"WebhookId": webhook.ID, "Count": msg.Count}).Debug("Getting webhook deliveries")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
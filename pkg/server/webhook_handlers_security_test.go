@@ -0,0 +1,78 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddWebhookSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	webhook := core.CreateWebhook(env.colony1ID, "https://example.com/hook", "", []string{}, []string{})
+	_, err := client.AddWebhook(webhook, env.executor1PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.AddWebhook(webhook, env.colony2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.AddWebhook(webhook, env.colony1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
+
+func TestGetWebhooksSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	webhook := core.CreateWebhook(env.colony1ID, "https://example.com/hook", "", []string{}, []string{})
+	addedWebhook, err := client.AddWebhook(webhook, env.colony1PrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.GetWebhooks(env.colony1ID, env.executor1PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.GetWebhooks(env.colony1ID, env.colony2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.GetWebhooks(env.colony1ID, env.colony1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	_, err = client.GetWebhookDeliveries(addedWebhook.ID, 10, env.executor1PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.GetWebhookDeliveries(addedWebhook.ID, 10, env.colony2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	_, err = client.GetWebhookDeliveries(addedWebhook.ID, 10, env.colony1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
+
+func TestDeleteWebhookSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	webhook := core.CreateWebhook(env.colony1ID, "https://example.com/hook", "", []string{}, []string{})
+	addedWebhook, err := client.AddWebhook(webhook, env.colony1PrvKey)
+	assert.Nil(t, err)
+
+	err = client.DeleteWebhook(addedWebhook.ID, env.executor1PrvKey)
+	assert.NotNil(t, err) // Should not work
+	err = client.DeleteWebhook(addedWebhook.ID, env.colony2PrvKey)
+	assert.NotNil(t, err) // Should not work
+	err = client.DeleteWebhook(addedWebhook.ID, env.colony1PrvKey)
+	assert.Nil(t, err) // Should work
+
+	server.Shutdown()
+	<-done
+}
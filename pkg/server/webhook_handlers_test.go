@@ -0,0 +1,112 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddWebhook(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	webhook := core.CreateWebhook(env.colonyID, "https://example.com/hook", "", []string{core.PROCESS_KIND}, []string{core.FAILED_EVENT})
+	addedWebhook, err := client.AddWebhook(webhook, env.colonyPrvKey)
+	assert.Nil(t, err)
+	assert.NotNil(t, addedWebhook)
+	assert.NotEqual(t, addedWebhook.Secret, "") // A secret should have been generated
+	assert.Equal(t, addedWebhook.URL, webhook.URL)
+
+	webhooks, err := client.GetWebhooks(env.colonyID, env.colonyPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, webhooks, 1)
+	assert.Equal(t, webhooks[0].ID, addedWebhook.ID)
+	assert.Equal(t, webhooks[0].Secret, "") // The secret should never be returned again
+
+	invalidWebhook := core.CreateWebhook(env.colonyID, "ftp://example.com/hook", "", []string{}, []string{})
+	_, err = client.AddWebhook(invalidWebhook, env.colonyPrvKey)
+	assert.NotNil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	webhook := core.CreateWebhook(env.colonyID, "https://example.com/hook", "secret", []string{}, []string{})
+	addedWebhook, err := client.AddWebhook(webhook, env.colonyPrvKey)
+	assert.Nil(t, err)
+
+	err = client.DeleteWebhook(addedWebhook.ID, env.colonyPrvKey)
+	assert.Nil(t, err)
+
+	webhooks, err := client.GetWebhooks(env.colonyID, env.colonyPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, webhooks, 0)
+
+	err = client.DeleteWebhook(addedWebhook.ID, env.colonyPrvKey)
+	assert.NotNil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	payloadChan := make(chan []byte, 10)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.True(t, core.VerifyWebhookPayload("secret", payload, r.Header.Get(core.WEBHOOK_SIGNATURE_HEADER)))
+		payloadChan <- payload
+	}))
+	defer receiver.Close()
+
+	webhook := core.CreateWebhook(env.colonyID, receiver.URL, "secret", []string{core.PROCESS_KIND}, []string{core.FAILED_EVENT})
+	addedWebhook, err := client.AddWebhook(webhook, env.colonyPrvKey)
+	assert.Nil(t, err)
+
+	funcSpec := utils.CreateTestFunctionSpec(env.colonyID)
+	addedProcess, err := client.Submit(funcSpec, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	err = client.Fail(addedProcess.ID, []string{"error"}, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	select {
+	case payload := <-payloadChan:
+		event, err := core.ConvertJSONToColonyEvent(string(payload))
+		assert.Nil(t, err)
+		assert.Equal(t, event.Kind, core.PROCESS_KIND)
+		assert.Equal(t, event.Type, core.FAILED_EVENT)
+		assert.Equal(t, event.TargetID, addedProcess.ID)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "Webhook was never called")
+	}
+
+	var deliveries []*core.WebhookDelivery
+	for i := 0; i < 50; i++ {
+		deliveries, err = client.GetWebhookDeliveries(addedWebhook.ID, 10, env.colonyPrvKey)
+		assert.Nil(t, err)
+		if len(deliveries) > 0 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	assert.Len(t, deliveries, 1)
+	assert.True(t, deliveries[0].Success)
+	assert.Equal(t, deliveries[0].Attempts, 1)
+
+	server.Shutdown()
+	<-done
+}
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/colonyos/colonies/pkg/core"
+)
+
+func (controller *coloniesController) addWorkflowTemplate(template *core.WorkflowTemplate) (*core.WorkflowTemplate, error) {
+	cmd := &command{workflowTemplateReplyChan: make(chan *core.WorkflowTemplate, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			err := controller.db.AddWorkflowTemplate(template)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			addedTemplate, err := controller.db.GetWorkflowTemplateByID(template.ID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.workflowTemplateReplyChan <- addedTemplate
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case addedTemplate := <-cmd.workflowTemplateReplyChan:
+		return addedTemplate, nil
+	}
+}
+
+func (controller *coloniesController) getWorkflowTemplate(colonyID string, name string, version int) (*core.WorkflowTemplate, error) {
+	cmd := &command{workflowTemplateReplyChan: make(chan *core.WorkflowTemplate, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			template, err := controller.db.GetWorkflowTemplate(colonyID, name, version)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.workflowTemplateReplyChan <- template
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case template := <-cmd.workflowTemplateReplyChan:
+		return template, nil
+	}
+}
+
+func (controller *coloniesController) getWorkflowTemplates(colonyID string) ([]*core.WorkflowTemplate, error) {
+	cmd := &command{workflowTemplatesReplyChan: make(chan []*core.WorkflowTemplate, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			templates, err := controller.db.FindWorkflowTemplatesByColonyID(colonyID)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.workflowTemplatesReplyChan <- templates
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case templates := <-cmd.workflowTemplatesReplyChan:
+		return templates, nil
+	}
+}
+
+func (controller *coloniesController) deleteWorkflowTemplate(colonyID string, name string, version int) error {
+	cmd := &command{errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			cmd.errorChan <- controller.db.DeleteWorkflowTemplate(colonyID, name, version)
+		}}
+
+	controller.cmdQueue <- cmd
+	return <-cmd.errorChan
+}
+
+// instantiateWorkflowTemplate creates a workflow spec from the workflow template referenced by
+// workflowSpec, the reference is pinned to the template version that was used
+func (controller *coloniesController) instantiateWorkflowTemplate(workflowSpec *core.WorkflowSpec) (*core.WorkflowSpec, error) {
+	cmd := &command{workflowSpecReplyChan: make(chan *core.WorkflowSpec, 1),
+		errorChan: make(chan error, 1),
+		handler: func(cmd *command) {
+			instantiatedWorkflowSpec, err := controller.resolveWorkflowTemplate(workflowSpec)
+			if err != nil {
+				cmd.errorChan <- err
+				return
+			}
+			cmd.workflowSpecReplyChan <- instantiatedWorkflowSpec
+		}}
+
+	controller.cmdQueue <- cmd
+	select {
+	case err := <-cmd.errorChan:
+		return nil, err
+	case instantiatedWorkflowSpec := <-cmd.workflowSpecReplyChan:
+		return instantiatedWorkflowSpec, nil
+	}
+}
+
+// resolveWorkflowTemplate returns workflowSpec as is if it does not reference a workflow template,
+// otherwise the referenced template is instantiated
+func (controller *coloniesController) resolveWorkflowTemplate(workflowSpec *core.WorkflowSpec) (*core.WorkflowSpec, error) {
+	ref := workflowSpec.Template
+	if ref == nil {
+		return workflowSpec, nil
+	}
+
+	template, err := controller.db.GetWorkflowTemplate(workflowSpec.ColonyID, ref.Name, ref.Version)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		if ref.Version == 0 {
+			return nil, errors.New("Failed to find workflow template <" + ref.Name + ">")
+		}
+		return nil, errors.New("Failed to find version " + strconv.Itoa(ref.Version) + " of workflow template <" + ref.Name + ">")
+	}
+
+	instantiatedWorkflowSpec, err := template.Instantiate(ref.Params)
+	if err != nil {
+		return nil, err
+	}
+	ref.Version = template.Version
+
+	// The deadline and failure policy of the referencing workflow spec override the ones in the template
+	if workflowSpec.Deadline > 0 {
+		instantiatedWorkflowSpec.Deadline = workflowSpec.Deadline
+	}
+	if workflowSpec.FailurePolicy != "" {
+		instantiatedWorkflowSpec.FailurePolicy = workflowSpec.FailurePolicy
+	}
+
+	return instantiatedWorkflowSpec, nil
+}
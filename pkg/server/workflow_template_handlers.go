@@ -0,0 +1,191 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/rpc"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+func (server *ColoniesServer) handleAddWorkflowTemplateHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateAddWorkflowTemplateMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to add workflow template, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to add workflow template, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+	if msg.WorkflowTemplate == nil {
+		server.handleHTTPError(c, errors.New("Failed to add workflow template, msg.WorkflowTemplate is nil"), http.StatusBadRequest)
+		return
+	}
+
+	err = server.validator.RequireExecutorMembership(recoveredID, msg.WorkflowTemplate.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	err = VerifyWorkflowTemplate(msg.WorkflowTemplate)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	msg.WorkflowTemplate.ID = core.GenerateRandomID()
+	addedTemplate, err := server.controller.addWorkflowTemplate(msg.WorkflowTemplate)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if addedTemplate == nil {
+		server.handleHTTPError(c, errors.New("Failed to add workflow template, addedTemplate is nil"), http.StatusInternalServerError)
+		return
+	}
+
+	jsonString, err = addedTemplate.ToJSON()
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"WorkflowTemplateId": addedTemplate.ID, "Name": addedTemplate.Name, "Version": addedTemplate.Version}).Debug("Adding workflow template")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
+func (server *ColoniesServer) handleGetWorkflowTemplateHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateGetWorkflowTemplateMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to get workflow template, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to get workflow template, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	err = server.validator.RequireExecutorMembership(recoveredID, msg.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	template, err := server.controller.getWorkflowTemplate(msg.ColonyID, msg.Name, msg.Version)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if template == nil {
+		server.handleHTTPError(c, errors.New("Failed to get workflow template, workflow template <"+msg.Name+"> not found"), http.StatusNotFound)
+		return
+	}
+
+	jsonString, err = template.ToJSON()
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ColonyId": msg.ColonyID, "Name": msg.Name, "Version": msg.Version}).Debug("Getting workflow template")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
+func (server *ColoniesServer) handleGetWorkflowTemplatesHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateGetWorkflowTemplatesMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to get workflow templates, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to get workflow templates, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	err = server.validator.RequireExecutorMembership(recoveredID, msg.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	templates, err := server.controller.getWorkflowTemplates(msg.ColonyID)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	jsonString, err = core.ConvertWorkflowTemplateArrayToJSON(templates)
+	if server.handleHTTPError(c, err, http.StatusInternalServerError) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ColonyId": msg.ColonyID}).Debug("Getting workflow templates")
+
+	server.sendHTTPReply(c, payloadType, jsonString)
+}
+
+func (server *ColoniesServer) handleDeleteWorkflowTemplateHTTPRequest(c *gin.Context, recoveredID string, payloadType string, jsonString string) {
+	msg, err := rpc.CreateDeleteWorkflowTemplateMsgFromJSON(jsonString)
+	if err != nil {
+		if server.handleHTTPError(c, errors.New("Failed to delete workflow template, invalid JSON"), http.StatusBadRequest) {
+			return
+		}
+	}
+
+	if msg.MsgType != payloadType {
+		server.handleHTTPError(c, errors.New("Failed to delete workflow template, msg.MsgType does not match payloadType"), http.StatusBadRequest)
+		return
+	}
+
+	err = server.validator.RequireExecutorMembership(recoveredID, msg.ColonyID, true)
+	if server.handleHTTPError(c, err, http.StatusForbidden) {
+		return
+	}
+
+	template, err := server.controller.getWorkflowTemplate(msg.ColonyID, msg.Name, msg.Version)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+	if template == nil {
+		server.handleHTTPError(c, errors.New("Failed to delete workflow template, workflow template <"+msg.Name+"> not found"), http.StatusNotFound)
+		return
+	}
+
+	err = server.controller.deleteWorkflowTemplate(msg.ColonyID, msg.Name, msg.Version)
+	if server.handleHTTPError(c, err, http.StatusBadRequest) {
+		return
+	}
+
+	log.WithFields(log.Fields{"ColonyId": msg.ColonyID, "Name": msg.Name, "Version": msg.Version}).Debug("Deleting workflow template")
+
+	server.sendEmptyHTTPReply(c, payloadType)
+}
+
+// pinWorkflowTemplate verifies that the workflow template referenced by a cron or generator workflow spec can be
+// instantiated, and pins the reference to a template version so that later versions do not change the workflow
+func (server *ColoniesServer) pinWorkflowTemplate(colonyID string, workflowSpecJSON string) (string, error) {
+	workflowSpec, err := core.ConvertJSONToWorkflowSpec(workflowSpecJSON)
+	if err != nil {
+		return "", err
+	}
+	if workflowSpec.Template == nil {
+		return workflowSpecJSON, nil
+	}
+
+	// Templates are stored per colony, it is not possible to reference a template in another colony
+	workflowSpec.ColonyID = colonyID
+	instantiatedWorkflowSpec, err := server.controller.instantiateWorkflowTemplate(workflowSpec)
+	if err != nil {
+		return "", err
+	}
+
+	err = VerifyWorkflowSpec(instantiatedWorkflowSpec)
+	if err != nil {
+		return "", err
+	}
+
+	return workflowSpec.ToJSON()
+}
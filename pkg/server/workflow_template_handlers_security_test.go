@@ -0,0 +1,82 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddWorkflowTemplateSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	template := createTestWorkflowTemplate(env.colony1ID, "test_executor_type")
+
+	_, err := client.AddWorkflowTemplate(template, env.executor2PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.AddWorkflowTemplate(template, env.colony1PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.AddWorkflowTemplate(template, env.colony2PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.AddWorkflowTemplate(template, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestGetWorkflowTemplateSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	template := createTestWorkflowTemplate(env.colony1ID, "test_executor_type")
+	_, err := client.AddWorkflowTemplate(template, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.GetWorkflowTemplate(env.colony1ID, template.Name, 0, env.executor2PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.GetWorkflowTemplate(env.colony1ID, template.Name, 0, env.colony1PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.GetWorkflowTemplate(env.colony1ID, template.Name, 0, env.colony2PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.GetWorkflowTemplate(env.colony1ID, template.Name, 0, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	_, err = client.GetWorkflowTemplates(env.colony1ID, env.executor2PrvKey)
+	assert.NotNil(t, err)
+	_, err = client.GetWorkflowTemplates(env.colony1ID, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestDeleteWorkflowTemplateSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// The setup looks like this:
+	//   executor1 is member of colony1
+	//   executor2 is member of colony2
+
+	template := createTestWorkflowTemplate(env.colony1ID, "test_executor_type")
+	_, err := client.AddWorkflowTemplate(template, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	err = client.DeleteWorkflowTemplate(env.colony1ID, template.Name, 0, env.executor2PrvKey)
+	assert.NotNil(t, err)
+	err = client.DeleteWorkflowTemplate(env.colony1ID, template.Name, 0, env.colony1PrvKey)
+	assert.NotNil(t, err)
+	err = client.DeleteWorkflowTemplate(env.colony1ID, template.Name, 0, env.colony2PrvKey)
+	assert.NotNil(t, err)
+	err = client.DeleteWorkflowTemplate(env.colony1ID, template.Name, 0, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	server.Shutdown()
+	<-done
+}
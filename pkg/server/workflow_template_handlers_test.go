@@ -0,0 +1,179 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/colonyos/colonies/pkg/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestWorkflowTemplate(colonyID string, executorType string) *core.WorkflowTemplate {
+	funcSpec1 := core.CreateEmptyFunctionSpec()
+	funcSpec1.NodeName = "train"
+	funcSpec1.FuncName = "train"
+	funcSpec1.Conditions.ExecutorType = executorType
+	funcSpec1.Args = []interface{}{"${dataset}"}
+	funcSpec1.Env = map[string]string{"EPOCHS": "${epochs}"}
+	funcSpec2 := core.CreateEmptyFunctionSpec()
+	funcSpec2.NodeName = "evaluate"
+	funcSpec2.FuncName = "evaluate"
+	funcSpec2.Conditions.ExecutorType = executorType
+	funcSpec2.AddDependency("train")
+
+	workflowSpec := core.CreateWorkflowSpec(colonyID)
+	workflowSpec.AddFunctionSpec(funcSpec1)
+	workflowSpec.AddFunctionSpec(funcSpec2)
+
+	parameters := []core.TemplateParameter{
+		{Name: "dataset", Required: true},
+		{Name: "epochs", Default: "10"},
+	}
+
+	return core.CreateWorkflowTemplate(colonyID, "train_model", parameters, workflowSpec)
+}
+
+func TestAddWorkflowTemplate(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	template := createTestWorkflowTemplate(env.colonyID, env.executor.Type)
+	addedTemplate, err := client.AddWorkflowTemplate(template, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.NotNil(t, addedTemplate)
+	assert.Equal(t, addedTemplate.Version, 1)
+
+	addedTemplate2, err := client.AddWorkflowTemplate(template, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, addedTemplate2.Version, 2)
+
+	// Templates without function specs are rejected
+	invalidTemplate := core.CreateWorkflowTemplate(env.colonyID, "invalid", nil, core.CreateWorkflowSpec(env.colonyID))
+	_, err = client.AddWorkflowTemplate(invalidTemplate, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestGetWorkflowTemplate(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	template := createTestWorkflowTemplate(env.colonyID, env.executor.Type)
+	addedTemplate1, err := client.AddWorkflowTemplate(template, env.executorPrvKey)
+	assert.Nil(t, err)
+	addedTemplate2, err := client.AddWorkflowTemplate(template, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	templateFromServer, err := client.GetWorkflowTemplate(env.colonyID, "train_model", 0, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.True(t, templateFromServer.Equals(addedTemplate2))
+
+	templateFromServer, err = client.GetWorkflowTemplate(env.colonyID, "train_model", 1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.True(t, templateFromServer.Equals(addedTemplate1))
+
+	_, err = client.GetWorkflowTemplate(env.colonyID, "does_not_exists", 0, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	templates, err := client.GetWorkflowTemplates(env.colonyID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, templates, 2)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestDeleteWorkflowTemplate(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	template := createTestWorkflowTemplate(env.colonyID, env.executor.Type)
+	_, err := client.AddWorkflowTemplate(template, env.executorPrvKey)
+	assert.Nil(t, err)
+	_, err = client.AddWorkflowTemplate(template, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	err = client.DeleteWorkflowTemplate(env.colonyID, "train_model", 1, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	templates, err := client.GetWorkflowTemplates(env.colonyID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, templates, 1)
+	assert.Equal(t, templates[0].Version, 2)
+
+	err = client.DeleteWorkflowTemplate(env.colonyID, "train_model", 0, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	templates, err = client.GetWorkflowTemplates(env.colonyID, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, templates, 0)
+
+	err = client.DeleteWorkflowTemplate(env.colonyID, "train_model", 0, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	server.Shutdown()
+	<-done
+}
+
+func TestSubmitWorkflowSpecFromTemplate(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	template := createTestWorkflowTemplate(env.colonyID, env.executor.Type)
+	_, err := client.AddWorkflowTemplate(template, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	// Missing required parameter
+	wf := core.CreateWorkflowSpecFromTemplate(env.colonyID, "train_model", 0, map[string]string{})
+	_, err = client.SubmitWorkflowSpec(wf, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	// Unknown template
+	wf = core.CreateWorkflowSpecFromTemplate(env.colonyID, "does_not_exists", 0, map[string]string{})
+	_, err = client.SubmitWorkflowSpec(wf, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	wf = core.CreateWorkflowSpecFromTemplate(env.colonyID, "train_model", 0, map[string]string{"dataset": "mnist"})
+	graph, err := client.SubmitWorkflowSpec(wf, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Len(t, graph.ProcessIDs, 2)
+
+	assignedProcess, err := client.Assign(env.colonyID, -1, env.executorPrvKey)
+	assert.Nil(t, err)
+	assert.Equal(t, assignedProcess.FunctionSpec.NodeName, "train")
+	assert.Equal(t, assignedProcess.FunctionSpec.Args, []interface{}{"mnist"})
+	assert.Equal(t, assignedProcess.FunctionSpec.Env["EPOCHS"], "10")
+
+	server.Shutdown()
+	<-done
+}
+
+func TestAddCronFromWorkflowTemplate(t *testing.T) {
+	env, client, server, _, done := setupTestEnv2(t)
+
+	template := createTestWorkflowTemplate(env.colonyID, env.executor.Type)
+	_, err := client.AddWorkflowTemplate(template, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	wf := core.CreateWorkflowSpecFromTemplate(env.colonyID, "train_model", 0, map[string]string{"dataset": "mnist"})
+	workflowSpecJSON, err := wf.ToJSON()
+	assert.Nil(t, err)
+
+	cron := core.CreateCron(env.colonyID, "test_cron", "", 100, false, workflowSpecJSON)
+	addedCron, err := client.AddCron(cron, env.executorPrvKey)
+	assert.Nil(t, err)
+
+	// The cron is pinned to the latest version of the template when added
+	cronWorkflowSpec, err := core.ConvertJSONToWorkflowSpec(addedCron.WorkflowSpec)
+	assert.Nil(t, err)
+	assert.NotNil(t, cronWorkflowSpec.Template)
+	assert.Equal(t, cronWorkflowSpec.Template.Version, 1)
+
+	// A cron referencing a template with missing parameters is rejected
+	wf = core.CreateWorkflowSpecFromTemplate(env.colonyID, "train_model", 0, map[string]string{})
+	workflowSpecJSON, err = wf.ToJSON()
+	assert.Nil(t, err)
+	cron = core.CreateCron(env.colonyID, "test_cron2", "", 100, false, workflowSpecJSON)
+	_, err = client.AddCron(cron, env.executorPrvKey)
+	assert.NotNil(t, err)
+
+	server.Shutdown()
+	<-done
+}
@@ -166,6 +166,38 @@ func (server *ColoniesServer) handleWSRequest(c *gin.Context) {
 				}
 				return
 			}
+
+		case rpc.SubscribeColonyEventsPayloadType:
+			msg, err := rpc.CreateSubscribeColonyEventsMsgFromJSON(rpcMsg.DecodePayload())
+			if server.handleHTTPError(c, err, http.StatusBadRequest) {
+				return
+			}
+			if msg.MsgType != rpcMsg.PayloadType {
+				err := server.sendWSErrorMsg(errors.New("Failed to subscribe to colony events, msg.msgType does not match rpcMsg.PayloadType"), http.StatusForbidden, wsConn, wsMsgType)
+				if err != nil {
+					log.WithFields(log.Fields{"Error": err}).Error("Failed to subscribe to colony events, failed to call server.sendWSErrorMsg()")
+				}
+				return
+			}
+
+			err = server.validator.RequireExecutorMembership(recoveredID, msg.ColonyID, true)
+			if err != nil {
+				err := server.sendWSErrorMsg(err, http.StatusForbidden, wsConn, wsMsgType)
+				if err != nil {
+					log.WithFields(log.Fields{"Error": err}).Error("Failed to subscribe to colony events, failed to call server.sendWSErrorMsg()")
+				}
+				return
+			}
+
+			colonyEventsSubcription := createColonyEventsSubscription(wsConn, wsMsgType, msg.ColonyID, msg.Kinds, msg.Types, msg.Since, msg.Timeout)
+			err = server.controller.subscribeColonyEvents(recoveredID, colonyEventsSubcription)
+			if err != nil {
+				err := server.sendWSErrorMsg(err, http.StatusForbidden, wsConn, wsMsgType)
+				if err != nil {
+					log.WithFields(log.Fields{"Error": err}).Error("Failed to subscribe to colony events")
+				}
+				return
+			}
 		}
 	}
 }
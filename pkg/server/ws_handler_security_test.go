@@ -63,3 +63,21 @@ func TestSubscribeChangeStateProcessSecurity(t *testing.T) {
 	server.Shutdown()
 	<-done
 }
+
+func TestSubscribeColonyEventsSecurity(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	// Executor 2 is not a member of colony 1
+	subscription, err := client.SubscribeColonyEvents(env.colony1ID, nil, nil, 0, 100, env.executor2PrvKey)
+	assert.Nil(t, err)
+
+	select {
+	case <-subscription.EventChan:
+		assert.Fail(t, "Should not receive any events")
+	case err := <-subscription.ErrChan:
+		assert.NotNil(t, err)
+	}
+
+	server.Shutdown()
+	<-done
+}
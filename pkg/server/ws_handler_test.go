@@ -165,3 +165,107 @@ func TestSubscribeChangeStateProcess2(t *testing.T) {
 	server.Shutdown()
 	<-done
 }
+
+func TestSubscribeColonyEvents(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	subscription, err := client.SubscribeColonyEvents(env.colony1ID, []string{core.PROCESS_KIND, core.EXECUTOR_KIND}, nil, 0, 100, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	time.Sleep(1 * time.Second)
+
+	// The subscription starts from the current sequence number, it can be resumed before any event is received
+	assert.Greater(t, subscription.LastSequence(), int64(0))
+
+	funcSpec := utils.CreateTestFunctionSpec(env.colony1ID)
+	addedProcess, err := client.Submit(funcSpec, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	executor, _, err := utils.CreateTestExecutorWithKey(env.colony1ID)
+	assert.Nil(t, err)
+	_, err = client.AddExecutor(executor, env.colony1PrvKey)
+	assert.Nil(t, err)
+
+	for _, expected := range []struct{ kind, eventType, targetID string }{
+		{core.PROCESS_KIND, core.SUBMITTED_EVENT, addedProcess.ID},
+		{core.EXECUTOR_KIND, core.ADDED_EVENT, executor.ID},
+	} {
+		select {
+		case event := <-subscription.EventChan:
+			assert.Equal(t, env.colony1ID, event.ColonyID)
+			assert.Equal(t, expected.kind, event.Kind)
+			assert.Equal(t, expected.eventType, event.Type)
+			assert.Equal(t, expected.targetID, event.TargetID)
+		case err := <-subscription.ErrChan:
+			assert.Fail(t, err.Error())
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "Timeout waiting for colony event")
+		}
+	}
+
+	server.Shutdown()
+	<-done
+}
+
+func TestResumeSubscribeColonyEvents(t *testing.T) {
+	env, client, server, _, done := setupTestEnv1(t)
+
+	subscription, err := client.SubscribeColonyEvents(env.colony1ID, []string{core.PROCESS_KIND}, []string{core.SUBMITTED_EVENT}, 0, 100, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	time.Sleep(1 * time.Second)
+
+	addedProcess1, err := client.Submit(utils.CreateTestFunctionSpec(env.colony1ID), env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	select {
+	case event := <-subscription.EventChan:
+		assert.Equal(t, addedProcess1.ID, event.TargetID)
+		assert.Greater(t, event.Sequence, int64(0))
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "Timeout waiting for colony event")
+	}
+
+	since := subscription.LastSequence()
+	assert.Greater(t, since, int64(0))
+	subscription.Close()
+
+	// These events are signalled while not subscribing
+	addedProcess2, err := client.Submit(utils.CreateTestFunctionSpec(env.colony1ID), env.executor1PrvKey)
+	assert.Nil(t, err)
+	addedProcess3, err := client.Submit(utils.CreateTestFunctionSpec(env.colony1ID), env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	subscription, err = client.SubscribeColonyEvents(env.colony1ID, []string{core.PROCESS_KIND}, []string{core.SUBMITTED_EVENT}, since, 100, env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	var prevSequence int64
+	for _, expectedTargetID := range []string{addedProcess2.ID, addedProcess3.ID} {
+		select {
+		case event := <-subscription.EventChan:
+			assert.Equal(t, expectedTargetID, event.TargetID)
+			assert.Greater(t, event.Sequence, prevSequence)
+			prevSequence = event.Sequence
+		case err := <-subscription.ErrChan:
+			assert.Fail(t, err.Error())
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "Timeout waiting for replayed colony event")
+		}
+	}
+
+	// New events are received after the replayed events
+	addedProcess4, err := client.Submit(utils.CreateTestFunctionSpec(env.colony1ID), env.executor1PrvKey)
+	assert.Nil(t, err)
+
+	select {
+	case event := <-subscription.EventChan:
+		assert.Equal(t, addedProcess4.ID, event.TargetID)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "Timeout waiting for colony event")
+	}
+
+	subscription.Close()
+
+	server.Shutdown()
+	<-done
+}
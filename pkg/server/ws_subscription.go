@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/colonyos/colonies/pkg/core"
+	"github.com/colonyos/colonies/pkg/database"
 	"github.com/colonyos/colonies/pkg/rpc"
 	"github.com/gorilla/websocket"
 	log "github.com/sirupsen/logrus"
@@ -17,10 +18,15 @@ type subscription struct {
 	executorType string
 	state        int
 	processID    string
+	colonyID     string
+	kinds        []string
+	eventTypes   []string
+	since        int64
 }
 
 type wsSubscriptionController struct {
 	eventHandler *eventHandler
+	db           database.Database
 }
 
 // Used by ColoniesServer
@@ -51,10 +57,21 @@ func createProcessSubscription(wsConn *websocket.Conn, wsMsgType int, processID
 		state:        state}
 }
 
+func createColonyEventsSubscription(wsConn *websocket.Conn, wsMsgType int, colonyID string, kinds []string, eventTypes []string, since int64, timeout int) *subscription {
+	return &subscription{wsConn: wsConn,
+		wsMsgType:  wsMsgType,
+		timeout:    timeout,
+		colonyID:   colonyID,
+		kinds:      kinds,
+		eventTypes: eventTypes,
+		since:      since}
+}
+
 // Used by coloniesController
-func createWSSubscriptionController(eventHandler *eventHandler) *wsSubscriptionController {
+func createWSSubscriptionController(eventHandler *eventHandler, db database.Database) *wsSubscriptionController {
 	wsSubCtrl := &wsSubscriptionController{}
 	wsSubCtrl.eventHandler = eventHandler
+	wsSubCtrl.db = db
 
 	return wsSubCtrl
 }
@@ -64,6 +81,10 @@ func (wsSubCtrl *wsSubscriptionController) sendProcessToWS(executorID string,
 	wsConn *websocket.Conn,
 	wsMsgType int,
 	cancel func()) {
+	// Only executors can subscribe to processes, env values are only sent to the executor the process is assigned to
+	if process.AssignedExecutorID != executorID {
+		process = core.RedactEnv(process)
+	}
 	jsonString, err := process.ToJSON()
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -144,3 +165,131 @@ func (wsSubCtrl *wsSubscriptionController) addProcessSubscriber(executorID strin
 		wsSubCtrl.sendProcessToWS(executorID, process, subscription.wsConn, subscription.wsMsgType, func() {})
 	}
 }
+
+func (wsSubCtrl *wsSubscriptionController) sendColonyEventToWS(executorID string,
+	event *core.ColonyEvent,
+	wsConn *websocket.Conn,
+	wsMsgType int,
+	cancel func()) {
+	jsonString, err := event.ToJSON()
+	if err != nil {
+		log.WithFields(log.Fields{"ExecutorID": executorID, "ColonyID": event.ColonyID, "Error": err}).
+			Error("Failed to create ColonyEvent JSON when subscribing to colony events")
+		cancel()
+		return
+	}
+	rpcReplyMsg, err := rpc.CreateRPCReplyMsg(rpc.SubscribeColonyEventsPayloadType, jsonString)
+	if err != nil {
+		log.WithFields(log.Fields{"ExecutorID": executorID, "ColonyID": event.ColonyID, "Error": err}).
+			Error("Failed to create RPCReplyMsg when subscribing to colony events")
+		cancel()
+		return
+	}
+	rpcReplyJSONString, err := rpcReplyMsg.ToJSON()
+	if err != nil {
+		log.WithFields(log.Fields{"ExecutorID": executorID, "ColonyID": event.ColonyID, "Error": err}).
+			Error("Failed to create RPCReplyMsg JSON when subscribing to colony events")
+		cancel()
+		return
+	}
+	err = wsConn.WriteMessage(wsMsgType, []byte(rpcReplyJSONString))
+	if err != nil {
+		log.WithFields(log.Fields{"ExecutorID": executorID, "ColonyID": event.ColonyID, "Error": err}).
+			Error("Failed to write RPCReplyMsg JSON to WS when subscribing to colony events")
+		cancel()
+	}
+}
+
+// replayColonyEvents sends stored events with a sequence number greater than subscription.since to the subscriber,
+// the Ids of the sent events are returned
+func (wsSubCtrl *wsSubscriptionController) replayColonyEvents(executorID string, subscription *subscription, ctx context.Context, cancel func()) (map[string]bool, error) {
+	replayed := make(map[string]bool)
+	since := subscription.since
+	for {
+		events, err := wsSubCtrl.db.FindColonyEventsSince(subscription.colonyID, since, COLONY_EVENT_REPLAY_BATCH)
+		if err != nil {
+			return replayed, err
+		}
+
+		for _, event := range events {
+			since = event.Sequence
+			if !event.Matches(subscription.kinds, subscription.eventTypes) {
+				continue
+			}
+			wsSubCtrl.sendColonyEventToWS(executorID, event, subscription.wsConn, subscription.wsMsgType, cancel)
+			if ctx.Err() != nil {
+				return replayed, ctx.Err()
+			}
+			replayed[event.ID] = true
+		}
+
+		if len(events) < COLONY_EVENT_REPLAY_BATCH {
+			return replayed, nil
+		}
+	}
+}
+
+// Used by coloniesController
+func (wsSubCtrl *wsSubscriptionController) addColonyEventsSubscriber(executorID string, subscription *subscription) {
+	go func() {
+		ctx, cancelCtx := context.WithTimeout(context.Background(), time.Duration(subscription.timeout)*time.Second)
+		defer cancelCtx()
+
+		eventChan, errChan := wsSubCtrl.eventHandler.subscribeColonyEvents(subscription.colonyID, subscription.kinds, subscription.eventTypes, ctx)
+
+		// The subscriber is told which cursor the subscription starts from, a new subscription can then be resumed
+		// without missing any events even if the connection is lost before the first event is received
+		cursor := subscription.since
+		if cursor == 0 {
+			var err error
+			cursor, err = wsSubCtrl.db.GetColonyEventSequence()
+			if err != nil {
+				log.WithFields(log.Fields{
+					"ExecutorID": executorID,
+					"ColonyID":   subscription.colonyID,
+					"Error":      err}).
+					Error("Failed to get colony event sequence")
+				cancelCtx()
+			}
+		}
+		subscribedEvent := core.CreateColonyEvent(subscription.colonyID, "", core.SUBSCRIBED_EVENT, "", 0, executorID)
+		subscribedEvent.Sequence = cursor
+		wsSubCtrl.sendColonyEventToWS(executorID, subscribedEvent, subscription.wsConn, subscription.wsMsgType, func() { cancelCtx() })
+
+		// Stored events are replayed after the listener has been registered so that no events are missed in between,
+		// events that are both replayed and received by the listener are only sent once
+		replayed := make(map[string]bool)
+		if subscription.since > 0 {
+			var err error
+			replayed, err = wsSubCtrl.replayColonyEvents(executorID, subscription, ctx, func() { cancelCtx() })
+			if err != nil {
+				log.WithFields(log.Fields{
+					"ExecutorID": executorID,
+					"ColonyID":   subscription.colonyID,
+					"Since":      subscription.since,
+					"Error":      err}).
+					Error("Failed to replay colony events")
+				cancelCtx()
+			}
+		}
+
+		for {
+			select {
+			case err := <-errChan:
+				log.WithFields(log.Fields{
+					"ExecutorID": executorID,
+					"ColonyID":   subscription.colonyID,
+					"Error":      err}).
+					Debug("Colony events subscriber timed out")
+				subscription.wsConn.Close()
+				return
+			case event := <-eventChan:
+				if replayed[event.ID] {
+					delete(replayed, event.ID)
+					continue
+				}
+				wsSubCtrl.sendColonyEventToWS(executorID, event, subscription.wsConn, subscription.wsMsgType, func() { cancelCtx() })
+			}
+		}
+	}()
+}
@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStorage stores blobs as files in a directory on the local filesystem
+type LocalStorage struct {
+	rootDir string
+}
+
+func CreateLocalStorage(rootDir string) (*LocalStorage, error) {
+	err := os.MkdirAll(rootDir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalStorage{rootDir: rootDir}, nil
+}
+
+func (storage *LocalStorage) path(key string) (string, error) {
+	path := filepath.Join(storage.rootDir, filepath.FromSlash(key))
+	if key == "" || !strings.HasPrefix(path, filepath.Clean(storage.rootDir)+string(os.PathSeparator)) {
+		return "", errors.New("Invalid storage key <" + key + ">")
+	}
+
+	return path, nil
+}
+
+// Put writes the blob to a temporary file which is renamed when all data has been written, a blob is thus
+// never visible before it is complete
+func (storage *LocalStorage) Put(key string, reader io.Reader) error {
+	path, err := storage.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	_, err = io.Copy(tmpFile, reader)
+	if err != nil {
+		tmpFile.Close()
+		return err
+	}
+
+	err = tmpFile.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), path)
+}
+
+func (storage *LocalStorage) Get(key string) (io.ReadCloser, error) {
+	path, err := storage.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.Open(path)
+}
+
+func (storage *LocalStorage) Delete(key string) error {
+	path, err := storage.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (storage *LocalStorage) DeleteAll(prefix string) error {
+	path, err := storage.path(prefix)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(path)
+}
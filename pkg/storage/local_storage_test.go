@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStorage(t *testing.T) {
+	rootDir, err := os.MkdirTemp("/tmp", "storagetest")
+	assert.Nil(t, err)
+	defer os.RemoveAll(rootDir)
+
+	storage, err := CreateLocalStorage(rootDir)
+	assert.Nil(t, err)
+
+	err = storage.Put("colony1/artifact1", strings.NewReader("testdata1"))
+	assert.Nil(t, err)
+	err = storage.Put("colony1/artifact2", strings.NewReader("testdata2"))
+	assert.Nil(t, err)
+
+	reader, err := storage.Get("colony1/artifact1")
+	assert.Nil(t, err)
+	data, err := io.ReadAll(reader)
+	assert.Nil(t, err)
+	reader.Close()
+	assert.Equal(t, string(data), "testdata1")
+
+	err = storage.Delete("colony1/artifact1")
+	assert.Nil(t, err)
+	_, err = storage.Get("colony1/artifact1")
+	assert.NotNil(t, err)
+
+	// Deleting a blob that does not exist is not an error
+	err = storage.Delete("colony1/artifact1")
+	assert.Nil(t, err)
+
+	err = storage.DeleteAll("colony1")
+	assert.Nil(t, err)
+	_, err = storage.Get("colony1/artifact2")
+	assert.NotNil(t, err)
+}
+
+func TestLocalStorageInvalidKey(t *testing.T) {
+	rootDir, err := os.MkdirTemp("/tmp", "storagetest")
+	assert.Nil(t, err)
+	defer os.RemoveAll(rootDir)
+
+	storage, err := CreateLocalStorage(rootDir)
+	assert.Nil(t, err)
+
+	err = storage.Put("../outside", strings.NewReader("testdata"))
+	assert.NotNil(t, err)
+	_, err = storage.Get("../../etc/passwd")
+	assert.NotNil(t, err)
+	err = storage.DeleteAll("")
+	assert.NotNil(t, err)
+	err = storage.DeleteAll(".")
+	assert.NotNil(t, err)
+}
+
+func TestHash(t *testing.T) {
+	hash, size, err := Hash(strings.NewReader("testdata"))
+	assert.Nil(t, err)
+	assert.Equal(t, size, int64(8))
+	assert.Equal(t, hash, "810ff2fb242a5dee4220f2cb0e6a519891fb67f2f828a6cab4ef8894633b1f50")
+}
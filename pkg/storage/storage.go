@@ -0,0 +1,27 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+)
+
+// Storage is a blob store used to keep the content of artifacts, keys are slash separated paths, e.g.
+// <colonyid>/<artifactid>, making it possible to implement a backend on top of an S3-compatible object store
+type Storage interface {
+	Put(key string, reader io.Reader) error
+	Get(key string) (io.ReadCloser, error)
+	Delete(key string) error
+	DeleteAll(prefix string) error
+}
+
+// Hash returns the hex encoded SHA-256 hash and the size of the data read from reader
+func Hash(reader io.Reader) (string, int64, error) {
+	hasher := sha256.New()
+	size, err := io.Copy(hasher, reader)
+	if err != nil {
+		return "", -1, err
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), size, nil
+}
@@ -91,8 +91,11 @@ func Decompress(src io.Reader, dst string) error {
 		if err != nil {
 			return err
 		}
-		target := header.Name
-		target = filepath.Join(dst, header.Name)
+		target := filepath.Join(dst, header.Name)
+		rel, err := filepath.Rel(filepath.Clean(dst), target)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(os.PathSeparator)) {
+			return fmt.Errorf("error: invalid file path %s in archive", header.Name)
+		}
 		switch header.Typeflag {
 		case tar.TypeDir:
 			if _, err := os.Stat(target); err != nil {
@@ -1,7 +1,9 @@
 package utils
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"io"
 	"io/ioutil"
 	"os"
@@ -54,3 +56,56 @@ func TestCompression(t *testing.T) {
 	err = os.Remove("/tmp/bundle.tar.gz")
 	assert.Nil(t, err)
 }
+
+func TestDecompressInvalidPath(t *testing.T) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(zw)
+	data := []byte("testdata")
+	err := tw.WriteHeader(&tar.Header{Name: "../outside", Mode: 0600, Size: int64(len(data)), Typeflag: tar.TypeReg})
+	assert.Nil(t, err)
+	_, err = tw.Write(data)
+	assert.Nil(t, err)
+	assert.Nil(t, tw.Close())
+	assert.Nil(t, zw.Close())
+
+	dst, err := ioutil.TempDir("/tmp/", "bundletestuncompress")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dst)
+
+	err = Decompress(&buf, dst)
+	assert.NotNil(t, err)
+	_, err = os.Stat(filepath.Join(dst, "../outside"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDecompressCurrentDir(t *testing.T) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(zw)
+	data := []byte("testdata")
+	err := tw.WriteHeader(&tar.Header{Name: "bundle/", Mode: 0755, Typeflag: tar.TypeDir})
+	assert.Nil(t, err)
+	err = tw.WriteHeader(&tar.Header{Name: "bundle/data", Mode: 0600, Size: int64(len(data)), Typeflag: tar.TypeReg})
+	assert.Nil(t, err)
+	_, err = tw.Write(data)
+	assert.Nil(t, err)
+	assert.Nil(t, tw.Close())
+	assert.Nil(t, zw.Close())
+
+	dst, err := ioutil.TempDir("/tmp/", "bundletestuncompress")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dst)
+
+	wd, err := os.Getwd()
+	assert.Nil(t, err)
+	assert.Nil(t, os.Chdir(dst))
+	defer os.Chdir(wd)
+
+	err = Decompress(bytes.NewReader(buf.Bytes()), ".")
+	assert.Nil(t, err)
+
+	content, err := os.ReadFile(filepath.Join(dst, "bundle", "data"))
+	assert.Nil(t, err)
+	assert.Equal(t, string(content), "testdata")
+}